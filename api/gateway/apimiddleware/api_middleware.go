@@ -188,6 +188,10 @@ func handlePostRequestForEndpoint(endpoint *Endpoint, w http.ResponseWriter, req
 }
 
 func handleDeleteRequestForEndpoint(endpoint *Endpoint, req *http.Request) ErrorJson {
+	// DELETE requests of endpoints without a request container carry all their parameters in the URL.
+	if endpoint.DeleteRequest == nil {
+		return nil
+	}
 	if errJson := DeserializeRequestBodyIntoContainer(req.Body, endpoint.DeleteRequest); errJson != nil {
 		return errJson
 	}
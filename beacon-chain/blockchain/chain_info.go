@@ -4,9 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -52,8 +53,10 @@ type HeadFetcher interface {
 	HeadETH1Data() *ethpb.Eth1Data
 	HeadPublicKeyToValidatorIndex(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (types.ValidatorIndex, bool)
 	HeadValidatorIndexToPublicKey(ctx context.Context, index types.ValidatorIndex) ([fieldparams.BLSPubkeyLength]byte, error)
-	ProtoArrayStore() *protoarray.Store
+	ForkChoicer() forkchoice.ForkChoicer
 	ChainHeads() ([][32]byte, []types.Slot)
+	IsOptimistic(ctx context.Context) (bool, error)
+	IsOptimisticForRoot(ctx context.Context, root [32]byte) (bool, error)
 	HeadSyncCommitteeFetcher
 	HeadDomainFetcher
 }
@@ -221,9 +224,9 @@ func (s *Service) HeadETH1Data() *ethpb.Eth1Data {
 	return s.head.state.Eth1Data()
 }
 
-// ProtoArrayStore returns the proto array store object.
-func (s *Service) ProtoArrayStore() *protoarray.Store {
-	return s.cfg.ForkChoiceStore.Store()
+// ForkChoicer returns the fork choice store of the service.
+func (s *Service) ForkChoicer() forkchoice.ForkChoicer {
+	return s.cfg.ForkChoiceStore
 }
 
 // GenesisTime returns the genesis time of beacon chain.
@@ -271,25 +274,46 @@ func (s *Service) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, er
 // ChainHeads returns all possible chain heads (leaves of fork choice tree).
 // Heads roots and heads slots are returned.
 func (s *Service) ChainHeads() ([][32]byte, []types.Slot) {
-	nodes := s.ProtoArrayStore().Nodes()
+	nodes := s.cfg.ForkChoiceStore.ForkChoiceNodes()
 
 	// Deliberate choice to not preallocate space for below.
 	// Heads cant be more than 2-3 in the worst case where pre-allocation will be 64 to begin with.
 	headsRoots := make([][32]byte, 0)
 	headsSlots := make([]types.Slot, 0)
 
-	nonExistentNode := ^uint64(0)
 	for _, node := range nodes {
 		// Possible heads have no children.
-		if node.BestDescendant() == nonExistentNode && node.BestChild() == nonExistentNode {
-			headsRoots = append(headsRoots, node.Root())
-			headsSlots = append(headsSlots, node.Slot())
+		if node.BestDescendant == params.BeaconConfig().ZeroHash && node.BestChild == params.BeaconConfig().ZeroHash {
+			headsRoots = append(headsRoots, node.Root)
+			headsSlots = append(headsSlots, node.Slot)
 		}
 	}
 
 	return headsRoots, headsSlots
 }
 
+// IsOptimistic returns true if the current head has been imported optimistically, meaning its
+// execution payload has not been validated by the execution engine yet.
+func (s *Service) IsOptimistic(ctx context.Context) (bool, error) {
+	s.headLock.RLock()
+	headRoot := s.headRoot()
+	s.headLock.RUnlock()
+
+	return s.IsOptimisticForRoot(ctx, headRoot)
+}
+
+// IsOptimisticForRoot returns true if the block of the given root has been imported optimistically.
+// Blocks which have been finalized are never optimistic.
+func (s *Service) IsOptimisticForRoot(ctx context.Context, root [32]byte) (bool, error) {
+	if s.cfg.ForkChoiceStore.HasNode(root) {
+		return s.cfg.ForkChoiceStore.IsOptimistic(ctx, root)
+	}
+	if s.cfg.BeaconDB.IsFinalizedBlock(ctx, root) {
+		return false, nil
+	}
+	return false, errors.Errorf("block %#x is not in fork choice store", bytesutil.Trunc(root[:]))
+}
+
 // HeadPublicKeyToValidatorIndex returns the validator index of the `pubkey` in current head state.
 func (s *Service) HeadPublicKeyToValidatorIndex(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (types.ValidatorIndex, bool) {
 	s.headLock.RLock()
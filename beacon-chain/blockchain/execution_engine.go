@@ -0,0 +1,304 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// errInvalidPayload is returned when the execution engine declares a payload invalid.
+	errInvalidPayload = errors.New("received an INVALID payload from execution engine")
+	// errNotOptimisticCandidate is returned when the execution engine is syncing and the block
+	// does not fulfill the conditions to be imported optimistically.
+	errNotOptimisticCandidate = errors.New("block is not suitable for optimistic sync")
+)
+
+// notifyNewPayload signals the execution engine of a new beacon block's execution payload.
+// It returns true if the payload has been fully validated by the execution engine, and false
+// if the engine is still syncing so that the block has to be imported optimistically.
+// Blocks without an execution payload are considered valid.
+//
+// The parentIsExecutionBlock flag reports whether the block's parent carries an execution payload,
+// which together with the age of the block determines whether the block is an optimistic candidate.
+func (s *Service) notifyNewPayload(
+	ctx context.Context,
+	postState state.BeaconState,
+	blk block.SignedBeaconBlock,
+	parentIsExecutionBlock bool,
+) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.notifyNewPayload")
+	defer span.End()
+
+	if postState.Version() < version.Bellatrix || blk.Version() < version.Bellatrix {
+		return true, nil
+	}
+	body := blk.Block().Body()
+	enabled, err := blocks.ExecutionEnabled(postState, body)
+	if err != nil {
+		return false, errors.Wrap(err, "could not determine if execution is enabled")
+	}
+	if !enabled {
+		return true, nil
+	}
+	if s.cfg.ExecutionEngineCaller == nil {
+		return false, errors.New("no execution engine caller configured")
+	}
+	payload, err := body.ExecutionPayload()
+	if err != nil {
+		return false, errors.Wrap(err, "could not get execution payload")
+	}
+
+	lastValidHash, err := s.cfg.ExecutionEngineCaller.NewPayload(ctx, payload)
+	switch {
+	case err == nil:
+		newPayloadValidNodeCount.Inc()
+		return true, nil
+	case errors.Is(err, powchain.ErrAcceptedSyncingPayloadStatus):
+		if !parentIsExecutionBlock && blk.Block().Slot()+params.BeaconConfig().SafeSlotsToImportOptimistically > s.CurrentSlot() {
+			return false, errNotOptimisticCandidate
+		}
+		newPayloadOptimisticNodeCount.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":             blk.Block().Slot(),
+			"payloadBlockHash": fmt.Sprintf("%#x", bytesutil.Trunc(payload.BlockHash)),
+		}).Info("Called new payload with optimistic block")
+		return false, nil
+	case errors.Is(err, powchain.ErrInvalidPayloadStatus):
+		newPayloadInvalidNodeCount.Inc()
+		// Without a latest valid hash the invalidity of the block's ancestors can not be determined.
+		if len(lastValidHash) > 0 {
+			parentRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+			if err := s.invalidateOptimisticAncestors(ctx, parentRoot, lastValidHash); err != nil {
+				return false, err
+			}
+		}
+		return false, errInvalidPayload
+	default:
+		return false, errors.Wrap(err, "could not validate execution payload from execution engine")
+	}
+}
+
+// notifyForkchoiceUpdate signals the execution engine of the current head, safe and finalized
+// execution blocks. It returns true if the execution engine declared the head's payload invalid,
+// in which case the head and its invalid ancestors have been invalidated in fork choice.
+//
+// If a validator connected to this node proposes in the next slot, the payload attributes are sent
+// along so that the execution engine starts building the payload ahead of the proposal.
+func (s *Service) notifyForkchoiceUpdate(
+	ctx context.Context,
+	headState state.BeaconState,
+	headBlk block.SignedBeaconBlock,
+	headRoot [32]byte,
+	finalizedRoot [32]byte,
+) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.notifyForkchoiceUpdate")
+	defer span.End()
+
+	headPayloadHash, err := payloadBlockHash(headBlk)
+	if err != nil {
+		return false, err
+	}
+	// Nothing to notify before the merge transition block is the head.
+	if headPayloadHash == params.BeaconConfig().ZeroHash {
+		return false, nil
+	}
+	if s.cfg.ExecutionEngineCaller == nil {
+		return false, errors.New("no execution engine caller configured")
+	}
+	finalizedPayloadHash, err := s.payloadBlockHashByRoot(ctx, finalizedRoot)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get finalized execution block hash")
+	}
+
+	fcs := &enginev1.ForkchoiceState{
+		HeadBlockHash:      headPayloadHash[:],
+		SafeBlockHash:      headPayloadHash[:],
+		FinalizedBlockHash: finalizedPayloadHash[:],
+	}
+	nextSlot := s.CurrentSlot() + 1
+	hasAttr, attr, proposerIdx, err := s.getPayloadAttribute(ctx, headState, nextSlot)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get payload attribute")
+	}
+	payloadID, lastValidHash, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, attr)
+	switch {
+	case err == nil:
+		forkchoiceUpdatedValidNodeCount.Inc()
+		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, headRoot); err != nil {
+			return false, errors.Wrap(err, "could not set block to valid")
+		}
+		if hasAttr && payloadID != nil {
+			s.cfg.ProposerSlotIndexCache.SetProposerAndPayloadIDs(nextSlot, proposerIdx, headRoot, *payloadID)
+		}
+		return false, nil
+	case errors.Is(err, powchain.ErrAcceptedSyncingPayloadStatus):
+		forkchoiceUpdatedOptimisticNodeCount.Inc()
+		log.WithFields(logrus.Fields{
+			"headSlot":             headBlk.Block().Slot(),
+			"headPayloadBlockHash": fmt.Sprintf("%#x", bytesutil.Trunc(headPayloadHash[:])),
+		}).Info("Called fork choice updated with optimistic block")
+		return false, nil
+	case errors.Is(err, powchain.ErrInvalidPayloadStatus):
+		forkchoiceUpdatedInvalidNodeCount.Inc()
+		if err := s.invalidateOptimisticAncestors(ctx, headRoot, lastValidHash); err != nil {
+			return false, err
+		}
+		return true, nil
+	default:
+		return false, errors.Wrap(err, "could not notify forkchoice update to execution engine")
+	}
+}
+
+// getPayloadAttribute returns the payload attributes of the given slot if a validator connected to
+// this node is scheduled to propose in it, along with the index of that proposer. The attributes are
+// derived from the input head state, and the payload fee recipient is the one registered by the proposer,
+// or else the one configured in the node.
+func (s *Service) getPayloadAttribute(
+	ctx context.Context,
+	st state.BeaconState,
+	slot types.Slot,
+) (bool, *enginev1.PayloadAttributes, types.ValidatorIndex, error) {
+	if s.cfg.ProposerSlotIndexCache == nil {
+		return false, nil, 0, nil
+	}
+	proposerIdx, _, _, ok := s.cfg.ProposerSlotIndexCache.GetProposerPayloadIDs(slot)
+	if !ok {
+		return false, nil, 0, nil
+	}
+	if st == nil || st.IsNil() {
+		return false, nil, 0, errors.New("nil head state")
+	}
+	// The randao mix of the head state's epoch is carried over to the next epochs
+	// until a block is processed, so it is the one the payload builds upon.
+	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
+	if err != nil {
+		return false, nil, 0, err
+	}
+	t, err := slots.ToTime(uint64(s.genesisTime.Unix()), slot)
+	if err != nil {
+		return false, nil, 0, err
+	}
+	return true, &enginev1.PayloadAttributes{
+		Timestamp:             uint64(t.Unix()),
+		Random:                random,
+		SuggestedFeeRecipient: s.cfg.FeeRecipientCache.FeeRecipientOrDefault(proposerIdx).Bytes(),
+	}, proposerIdx, nil
+}
+
+// invalidateOptimisticAncestors walks back from the input root through the optimistically imported
+// blocks, until it reaches the block whose payload matches the latest valid hash reported by the
+// execution engine. The blocks after it, along with all their descendants, are invalidated in fork choice.
+// If no latest valid hash is provided, only the block of the input root is invalidated.
+func (s *Service) invalidateOptimisticAncestors(ctx context.Context, root [32]byte, lastValidHash []byte) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.invalidateOptimisticAncestors")
+	defer span.End()
+
+	var firstInvalidRoot [32]byte
+	found := false
+	for s.cfg.ForkChoiceStore.HasNode(root) {
+		optimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(ctx, root)
+		if err != nil {
+			return err
+		}
+		if !optimistic {
+			break
+		}
+		blk, err := s.getBlock(ctx, root)
+		if err != nil {
+			return err
+		}
+		payloadHash, err := payloadBlockHash(blk)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(payloadHash[:], lastValidHash) {
+			break
+		}
+		firstInvalidRoot = root
+		found = true
+		if len(lastValidHash) == 0 {
+			break
+		}
+		root = bytesutil.ToBytes32(blk.Block().ParentRoot())
+	}
+	if !found {
+		return nil
+	}
+
+	invalidRoots, err := s.cfg.ForkChoiceStore.SetOptimisticToInvalid(ctx, firstInvalidRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not set block to invalid")
+	}
+	log.WithFields(logrus.Fields{
+		"firstInvalidRoot": fmt.Sprintf("%#x", bytesutil.Trunc(firstInvalidRoot[:])),
+		"invalidCount":     len(invalidRoots),
+	}).Warn("Invalidated blocks with invalid execution payloads")
+	return nil
+}
+
+// payloadBlockHashByRoot returns the execution block hash of the payload in the block
+// of the input root, or the zero hash if the block has no execution payload.
+func (s *Service) payloadBlockHashByRoot(ctx context.Context, root [32]byte) ([32]byte, error) {
+	if root == params.BeaconConfig().ZeroHash {
+		return params.BeaconConfig().ZeroHash, nil
+	}
+	blk, err := s.getBlock(ctx, root)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return payloadBlockHash(blk)
+}
+
+// getBlock retrieves a block by its root from the initial sync blocks cache or the DB.
+func (s *Service) getBlock(ctx context.Context, root [32]byte) (block.SignedBeaconBlock, error) {
+	if s.hasInitSyncBlock(root) {
+		return s.getInitSyncBlock(root), nil
+	}
+	blk, err := s.cfg.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block from db")
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Errorf("block %#x not found", bytesutil.Trunc(root[:]))
+	}
+	return blk, nil
+}
+
+// payloadBlockHash returns the execution block hash of the input block's payload, or the
+// zero hash if the block has no execution payload.
+func payloadBlockHash(blk block.SignedBeaconBlock) ([32]byte, error) {
+	if blk.Version() < version.Bellatrix {
+		return params.BeaconConfig().ZeroHash, nil
+	}
+	payload, err := blk.Block().Body().ExecutionPayload()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get execution payload")
+	}
+	return bytesutil.ToBytes32(payload.BlockHash), nil
+}
+
+// isExecutionState returns true if the latest execution payload header of the input state is
+// not empty, meaning the block the state was derived from carries an execution payload.
+func isExecutionState(st state.BeaconState) (bool, error) {
+	if st.Version() < version.Bellatrix {
+		return false, nil
+	}
+	return blocks.MergeComplete(st)
+}
@@ -0,0 +1,387 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+func TestService_notifyNewPayload(t *testing.T) {
+	ctx := context.Background()
+
+	phase0State, _ := util.DeterministicGenesisState(t, 1)
+	preMergeState, _ := util.DeterministicGenesisStateMerge(t, 1)
+	postMergeState, _ := util.DeterministicGenesisStateMerge(t, 1)
+	require.NoError(t, postMergeState.SetLatestExecutionPayloadHeader(&ethpb.ExecutionPayloadHeader{
+		ParentHash:       make([]byte, 32),
+		FeeRecipient:     make([]byte, 20),
+		StateRoot:        make([]byte, 32),
+		ReceiptRoot:      make([]byte, 32),
+		LogsBloom:        make([]byte, 256),
+		Random:           make([]byte, 32),
+		BaseFeePerGas:    make([]byte, 32),
+		BlockHash:        bytesutil.PadTo([]byte{'a'}, 32),
+		TransactionsRoot: make([]byte, 32),
+	}))
+
+	phase0Blk, err := wrapper.WrappedSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	emptyMergeBlk, err := wrapper.WrappedMergeSignedBeaconBlock(util.NewBeaconBlockMerge())
+	require.NoError(t, err)
+	b := util.NewBeaconBlockMerge()
+	b.Block.Slot = 1
+	b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{'a'}, 32)
+	mergeBlk, err := wrapper.WrappedMergeSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                   string
+		postState              state.BeaconState
+		blk                    block.SignedBeaconBlock
+		parentIsExecutionBlock bool
+		newPayloadErr          error
+		wantValid              bool
+		wantErr                error
+		wantEngineCalled       bool
+	}{
+		{
+			name:      "phase 0 block",
+			postState: phase0State,
+			blk:       phase0Blk,
+			wantValid: true,
+		},
+		{
+			name:      "execution not enabled",
+			postState: preMergeState,
+			blk:       emptyMergeBlk,
+			wantValid: true,
+		},
+		{
+			name:             "valid payload",
+			postState:        postMergeState,
+			blk:              mergeBlk,
+			wantValid:        true,
+			wantEngineCalled: true,
+		},
+		{
+			name:                   "syncing engine imports optimistically",
+			postState:              postMergeState,
+			blk:                    mergeBlk,
+			parentIsExecutionBlock: true,
+			newPayloadErr:          powchain.ErrAcceptedSyncingPayloadStatus,
+			wantEngineCalled:       true,
+		},
+		{
+			name:             "syncing engine with recent merge transition block",
+			postState:        postMergeState,
+			blk:              mergeBlk,
+			newPayloadErr:    powchain.ErrAcceptedSyncingPayloadStatus,
+			wantErr:          errNotOptimisticCandidate,
+			wantEngineCalled: true,
+		},
+		{
+			name:             "invalid payload",
+			postState:        postMergeState,
+			blk:              mergeBlk,
+			newPayloadErr:    powchain.ErrInvalidPayloadStatus,
+			wantErr:          errInvalidPayload,
+			wantEngineCalled: true,
+		},
+		{
+			name:             "invalid block hash",
+			postState:        postMergeState,
+			blk:              mergeBlk,
+			newPayloadErr:    powchain.ErrInvalidBlockHashPayloadStatus,
+			wantErr:          powchain.ErrInvalidBlockHashPayloadStatus,
+			wantEngineCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &mockPOW.EngineClient{ErrNewPayload: tt.newPayloadErr}
+			beaconDB := testDB.SetupDB(t)
+			service, err := NewService(ctx,
+				WithDatabase(beaconDB),
+				WithStateGen(stategen.New(beaconDB)),
+				WithForkChoiceStore(protoarray.New(0, 0, [32]byte{})),
+				WithExecutionEngineCaller(engine),
+			)
+			require.NoError(t, err)
+			service.genesisTime = time.Now()
+
+			valid, err := service.notifyNewPayload(ctx, tt.postState, tt.blk, tt.parentIsExecutionBlock)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantValid, valid)
+			assert.Equal(t, tt.wantEngineCalled, len(engine.NewPayloads) == 1)
+		})
+	}
+}
+
+// saveOptimisticChain saves a chain of Bellatrix blocks on top of the genesis block to the DB and
+// inserts them optimistically into fork choice. The payload of the i-th block has the block hash {i+1}.
+func saveOptimisticChain(t *testing.T, service *Service, length int) [][32]byte {
+	ctx := context.Background()
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.cfg.BeaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, genesisRoot))
+
+	roots := make([][32]byte, 0, length)
+	parentRoot := genesisRoot
+	for i := 0; i < length; i++ {
+		b := util.NewBeaconBlockMerge()
+		b.Block.Slot = types.Slot(i + 1)
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{byte(i + 1)}, 32)
+		wsb, err := wrapper.WrappedMergeSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, service.cfg.BeaconDB.SaveBlock(ctx, wsb))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, b.Block.Slot, root, parentRoot, [32]byte{}, 0, 0))
+		roots = append(roots, root)
+		parentRoot = root
+	}
+	return roots
+}
+
+func TestService_notifyForkchoiceUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		forkchoiceErr   error
+		lastValidHash   []byte
+		wantInvalidated bool
+		wantOptimistic  []bool
+		wantErr         string
+	}{
+		{
+			name:           "valid head",
+			wantOptimistic: []bool{false, false, false},
+		},
+		{
+			name:           "syncing engine",
+			forkchoiceErr:  powchain.ErrAcceptedSyncingPayloadStatus,
+			wantOptimistic: []bool{true, true, true},
+		},
+		{
+			name:            "invalid head with latest valid ancestor",
+			forkchoiceErr:   powchain.ErrInvalidPayloadStatus,
+			lastValidHash:   bytesutil.PadTo([]byte{1}, 32),
+			wantInvalidated: true,
+			wantOptimistic:  []bool{true, false, false},
+		},
+		{
+			name:            "invalid head without latest valid hash",
+			forkchoiceErr:   powchain.ErrInvalidPayloadStatus,
+			wantInvalidated: true,
+			wantOptimistic:  []bool{true, true, false},
+		},
+		{
+			name:          "engine error",
+			forkchoiceErr: errors.New("engine down"),
+			wantErr:       "could not notify forkchoice update to execution engine: engine down",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &mockPOW.EngineClient{ErrForkchoiceUpdated: tt.forkchoiceErr, ForkChoiceUpdatedResp: tt.lastValidHash}
+			beaconDB := testDB.SetupDB(t)
+			service, err := NewService(ctx,
+				WithDatabase(beaconDB),
+				WithStateGen(stategen.New(beaconDB)),
+				WithForkChoiceStore(protoarray.New(0, 0, [32]byte{})),
+				WithExecutionEngineCaller(engine),
+			)
+			require.NoError(t, err)
+			roots := saveOptimisticChain(t, service, 3)
+			headRoot := roots[len(roots)-1]
+			headBlk, err := service.getBlock(ctx, headRoot)
+			require.NoError(t, err)
+
+			invalidated, err := service.notifyForkchoiceUpdate(ctx, nil, headBlk, headRoot, params.BeaconConfig().ZeroHash)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInvalidated, invalidated)
+			require.Equal(t, 1, len(engine.ForkchoiceUpdatedStates))
+			assert.DeepEqual(t, bytesutil.PadTo([]byte{3}, 32), engine.ForkchoiceUpdatedStates[0].HeadBlockHash)
+			assert.DeepEqual(t, params.BeaconConfig().ZeroHash[:], engine.ForkchoiceUpdatedStates[0].FinalizedBlockHash)
+
+			for i, root := range roots {
+				optimistic, err := service.cfg.ForkChoiceStore.IsOptimistic(ctx, root)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantOptimistic[i], optimistic, "Unexpected optimistic status of block %d", i)
+			}
+		})
+	}
+}
+
+func TestService_notifyForkchoiceUpdate_PreMergeHead(t *testing.T) {
+	ctx := context.Background()
+	engine := &mockPOW.EngineClient{}
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx,
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithForkChoiceStore(protoarray.New(0, 0, [32]byte{})),
+		WithExecutionEngineCaller(engine),
+	)
+	require.NoError(t, err)
+
+	blk, err := wrapper.WrappedMergeSignedBeaconBlock(util.NewBeaconBlockMerge())
+	require.NoError(t, err)
+	invalidated, err := service.notifyForkchoiceUpdate(ctx, nil, blk, [32]byte{'a'}, params.BeaconConfig().ZeroHash)
+	require.NoError(t, err)
+	assert.Equal(t, false, invalidated)
+	assert.Equal(t, 0, len(engine.ForkchoiceUpdatedStates))
+}
+
+func TestService_notifyForkchoiceUpdate_PayloadAttributes(t *testing.T) {
+	ctx := context.Background()
+	feeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	cfg := params.BeaconConfig().Copy()
+	cfg.FeeRecipient = feeRecipient
+	params.OverrideBeaconConfig(cfg)
+	defer params.UseMainnetConfig()
+
+	pid := enginev1.PayloadIDBytes{1, 2, 3}
+	engine := &mockPOW.EngineClient{PayloadIDBytes: &pid}
+	proposerCache := cache.NewProposerPayloadIDsCache()
+	feeRecipientCache := cache.NewFeeRecipientCache()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx,
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithForkChoiceStore(protoarray.New(0, 0, [32]byte{})),
+		WithExecutionEngineCaller(engine),
+		WithProposerIdsCache(proposerCache),
+		WithFeeRecipientCache(feeRecipientCache),
+	)
+	require.NoError(t, err)
+	service.genesisTime = time.Now()
+	roots := saveOptimisticChain(t, service, 1)
+	headRoot := roots[0]
+	headBlk, err := service.getBlock(ctx, headRoot)
+	require.NoError(t, err)
+	headState, _ := util.DeterministicGenesisStateMerge(t, 1)
+
+	// No validator connected to the node proposes in the next slot.
+	_, err = service.notifyForkchoiceUpdate(ctx, headState, headBlk, headRoot, params.BeaconConfig().ZeroHash)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(engine.PayloadAttributes))
+	assert.Equal(t, true, engine.PayloadAttributes[0] == nil)
+
+	nextSlot := service.CurrentSlot() + 1
+	proposerCache.SetProposerAndPayloadIDs(nextSlot, 5, [32]byte{}, [8]byte{})
+	_, err = service.notifyForkchoiceUpdate(ctx, headState, headBlk, headRoot, params.BeaconConfig().ZeroHash)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(engine.PayloadAttributes))
+	attr := engine.PayloadAttributes[1]
+	require.NotNil(t, attr)
+	assert.DeepEqual(t, feeRecipient.Bytes(), attr.SuggestedFeeRecipient)
+	wantTime, err := slots.ToTime(uint64(service.genesisTime.Unix()), nextSlot)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(wantTime.Unix()), attr.Timestamp)
+	mix, err := helpers.RandaoMix(headState, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, mix, attr.Random)
+
+	// The payload ID is kept for the proposal along with the head it builds upon.
+	vIdx, root, payloadID, ok := proposerCache.GetProposerPayloadIDs(nextSlot)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.ValidatorIndex(5), vIdx)
+	assert.Equal(t, headRoot, root)
+	assert.Equal(t, [8]byte(pid), payloadID)
+
+	// The fee recipient registered by the proposer takes precedence over the configured one.
+	registered := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	feeRecipientCache.SetFeeRecipient(5, registered)
+	_, err = service.notifyForkchoiceUpdate(ctx, headState, headBlk, headRoot, params.BeaconConfig().ZeroHash)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(engine.PayloadAttributes))
+	require.NotNil(t, engine.PayloadAttributes[2])
+	assert.DeepEqual(t, registered.Bytes(), engine.PayloadAttributes[2].SuggestedFeeRecipient)
+}
+
+func TestService_notifyNewPayload_InvalidatesOptimisticAncestors(t *testing.T) {
+	ctx := context.Background()
+	engine := &mockPOW.EngineClient{
+		ErrNewPayload:  powchain.ErrInvalidPayloadStatus,
+		NewPayloadResp: bytesutil.PadTo([]byte{1}, 32),
+	}
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx,
+		WithDatabase(beaconDB),
+		WithStateGen(stategen.New(beaconDB)),
+		WithForkChoiceStore(protoarray.New(0, 0, [32]byte{})),
+		WithExecutionEngineCaller(engine),
+	)
+	require.NoError(t, err)
+	roots := saveOptimisticChain(t, service, 3)
+
+	postState, _ := util.DeterministicGenesisStateMerge(t, 1)
+	require.NoError(t, postState.SetLatestExecutionPayloadHeader(&ethpb.ExecutionPayloadHeader{
+		ParentHash:       make([]byte, 32),
+		FeeRecipient:     make([]byte, 20),
+		StateRoot:        make([]byte, 32),
+		ReceiptRoot:      make([]byte, 32),
+		LogsBloom:        make([]byte, 256),
+		Random:           make([]byte, 32),
+		BaseFeePerGas:    make([]byte, 32),
+		BlockHash:        bytesutil.PadTo([]byte{4}, 32),
+		TransactionsRoot: make([]byte, 32),
+	}))
+	b := util.NewBeaconBlockMerge()
+	b.Block.Slot = 4
+	b.Block.ParentRoot = roots[2][:]
+	b.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{4}, 32)
+	blk, err := wrapper.WrappedMergeSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	_, err = service.notifyNewPayload(ctx, postState, blk, true)
+	require.ErrorIs(t, err, errInvalidPayload)
+
+	// The first block is the latest valid ancestor, its descendants are no longer viable for head.
+	optimistic, err := service.cfg.ForkChoiceStore.IsOptimistic(ctx, roots[0])
+	require.NoError(t, err)
+	assert.Equal(t, true, optimistic)
+	for _, root := range roots[1:] {
+		optimistic, err := service.cfg.ForkChoiceStore.IsOptimistic(ctx, root)
+		require.NoError(t, err)
+		assert.Equal(t, false, optimistic)
+		_, err = service.cfg.ForkChoiceStore.SetOptimisticToInvalid(ctx, root)
+		require.NoError(t, err)
+	}
+}
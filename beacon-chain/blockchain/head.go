@@ -10,7 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
@@ -62,7 +62,7 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 		if err != nil {
 			return err
 		}
-		s.cfg.ForkChoiceStore = protoarray.New(j.Epoch, f.Epoch, bytesutil.ToBytes32(f.Root))
+		s.cfg.ForkChoiceStore = forkchoice.New(j.Epoch, f.Epoch, bytesutil.ToBytes32(f.Root))
 		if err := s.insertBlockToForkChoiceStore(ctx, jb.Block(), headStartRoot, f, j); err != nil {
 			return err
 		}
@@ -77,6 +77,48 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 	return s.saveHead(ctx, headRoot)
 }
 
+// This notifies the execution engine of the current head. If the execution engine
+// declares the head's payload invalid, the invalid blocks are removed from consideration
+// for head and the head is updated once more before notifying the engine again.
+func (s *Service) notifyForkchoiceUpdateForHead(ctx context.Context, balances []uint64) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.notifyForkchoiceUpdateForHead")
+	defer span.End()
+
+	finalizedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(s.finalizedCheckpt.Root))
+	for i := 0; i < 2; i++ {
+		s.headLock.RLock()
+		if s.head == nil || s.head.block == nil {
+			s.headLock.RUnlock()
+			return nil
+		}
+		headBlock := s.head.block
+		headRoot := s.head.root
+		headState := s.head.state
+		s.headLock.RUnlock()
+
+		invalidated, err := s.notifyForkchoiceUpdate(ctx, headState, headBlock, headRoot, finalizedRoot)
+		if err != nil || !invalidated {
+			return err
+		}
+		// The invalid blocks are no longer viable for head, so the new head is computed and
+		// the execution engine is notified of it once more.
+		if err := s.updateHead(ctx, balances); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateHeadWithBalances updates the beacon chain head using the balances of the
+// current justified checkpoint.
+func (s *Service) UpdateHeadWithBalances(ctx context.Context) error {
+	balances, err := s.justifiedBalances.get(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root))
+	if err != nil {
+		return errors.Wrapf(err, "could not get justified balances for root %#x", s.justifiedCheckpt.Root)
+	}
+	return s.updateHead(ctx, balances)
+}
+
 // This saves head info to the local service cache, it also saves the
 // new head root to the DB.
 func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
@@ -45,7 +45,11 @@ func (s *Service) TreeHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	nodes := s.cfg.ForkChoiceStore.Nodes()
+	nodes := s.cfg.ForkChoiceStore.ForkChoiceNodes()
+	indices := make(map[[32]byte]int, len(nodes))
+	for i, n := range nodes {
+		indices[n.Root] = i
+	}
 
 	graph := dot.NewGraph(dot.Directed)
 	graph.Attr("rankdir", "RL")
@@ -56,21 +60,21 @@ func (s *Service) TreeHandler(w http.ResponseWriter, r *http.Request) {
 
 	for i := len(nodes) - 1; i >= 0; i-- {
 		// Construct label for each node.
-		slot := fmt.Sprintf("%d", nodes[i].Slot())
-		weight := fmt.Sprintf("%d", nodes[i].Weight()/1e9) // Convert unit Gwei to unit ETH.
-		votes := fmt.Sprintf("%d", nodes[i].Weight()/1e9/avgBalance)
+		slot := fmt.Sprintf("%d", nodes[i].Slot)
+		weight := fmt.Sprintf("%d", nodes[i].Weight/1e9) // Convert unit Gwei to unit ETH.
+		votes := fmt.Sprintf("%d", nodes[i].Weight/1e9/avgBalance)
 		index := fmt.Sprintf("%d", i)
-		g := nodes[i].Graffiti()
-		graffiti := hex.EncodeToString(g[:8])
+		graffiti := hex.EncodeToString(nodes[i].Graffiti[:8])
 		label := "slot: " + slot + "\n votes: " + votes + "\n weight: " + weight + "\n graffiti: " + graffiti
+		_, hasParent := indices[nodes[i].ParentRoot]
 		var dotN dot.Node
-		if nodes[i].Parent() != ^uint64(0) {
+		if hasParent {
 			dotN = graph.Node(index).Box().Attr("label", label)
 		}
 
-		if nodes[i].Slot() == s.HeadSlot() &&
-			nodes[i].BestDescendant() == ^uint64(0) &&
-			nodes[i].Parent() != ^uint64(0) {
+		if nodes[i].Slot == s.HeadSlot() &&
+			nodes[i].BestDescendant == params.BeaconConfig().ZeroHash &&
+			hasParent {
 			dotN = dotN.Attr("color", "green")
 		}
 
@@ -78,8 +82,8 @@ func (s *Service) TreeHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	for i := len(nodes) - 1; i >= 0; i-- {
-		if nodes[i].Parent() != ^uint64(0) && nodes[i].Parent() < uint64(len(dotNodes)) {
-			graph.Edge(*dotNodes[i], *dotNodes[nodes[i].Parent()])
+		if p, ok := indices[nodes[i].ParentRoot]; ok {
+			graph.Edge(*dotNodes[i], *dotNodes[p])
 		}
 	}
 
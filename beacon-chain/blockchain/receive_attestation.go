@@ -136,19 +136,20 @@ func (s *Service) spawnProcessAttestationsRoutine(stateFeed *event.Feed) {
 			case <-s.ctx.Done():
 				return
 			case <-st.C():
-				// Continue when there's no fork choice attestation, there's nothing to process and update head.
-				// This covers the condition when the node is still initial syncing to the head of the chain.
-				if s.cfg.AttPool.ForkchoiceAttestationCount() == 0 {
+				// The proposer boost only applies during the slot of the boosted block.
+				boosted := s.cfg.ForkChoiceStore.ProposerBoost() != params.BeaconConfig().ZeroHash
+				if err := s.cfg.ForkChoiceStore.ResetBoostedProposerRoot(s.ctx); err != nil {
+					log.WithError(err).Error("Could not reset proposer boost root")
+				}
+				// Continue when there's no fork choice attestation and no proposer boost to remove, there's
+				// nothing to process and update head. This covers the condition when the node is still
+				// initial syncing to the head of the chain.
+				if s.cfg.AttPool.ForkchoiceAttestationCount() == 0 && !boosted {
 					continue
 				}
 				s.processAttestations(s.ctx)
 
-				balances, err := s.justifiedBalances.get(s.ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root))
-				if err != nil {
-					log.WithError(err).Errorf("Unable to get justified balances for root %v", s.justifiedCheckpt.Root)
-					continue
-				}
-				if err := s.updateHead(s.ctx, balances); err != nil {
+				if err := s.UpdateHeadWithBalances(s.ctx); err != nil {
 					log.WithError(err).Warn("Resolving fork due to new attestation")
 				}
 			}
@@ -20,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	f "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
@@ -73,6 +72,9 @@ type Service struct {
 type config struct {
 	BeaconBlockBuf          int
 	ChainStartFetcher       powchain.ChainStartFetcher
+	ExecutionEngineCaller   powchain.EngineCaller
+	ProposerSlotIndexCache  *cache.ProposerPayloadIDsCache
+	FeeRecipientCache       *cache.FeeRecipientCache
 	BeaconDB                db.HeadAccessDatabase
 	DepositCache            *depositcache.DepositCache
 	AttPool                 attestations.Pool
@@ -125,7 +127,7 @@ func (s *Service) Start() {
 	saved := s.cfg.FinalizedStateAtStartUp
 
 	if saved != nil && !saved.IsNil() {
-		if err := s.startFromSavedState(saved); err != nil {
+		if err := s.StartFromSavedState(saved); err != nil {
 			log.Fatal(err)
 		}
 	} else {
@@ -161,7 +163,8 @@ func (s *Service) Status() error {
 	return nil
 }
 
-func (s *Service) startFromSavedState(saved state.BeaconState) error {
+// StartFromSavedState initializes the blockchain using a previously saved finalized checkpoint.
+func (s *Service) StartFromSavedState(saved state.BeaconState) error {
 	log.Info("Blockchain data already exists in DB, initializing...")
 	s.genesisTime = time.Unix(int64(saved.GenesisTime()), 0)
 	s.cfg.AttService.SetGenesisTime(saved.GenesisTime())
@@ -192,8 +195,22 @@ func (s *Service) startFromSavedState(saved state.BeaconState) error {
 	s.prevFinalizedCheckpt = ethpb.CopyCheckpoint(finalized)
 	s.finalizedCheckpt = ethpb.CopyCheckpoint(finalized)
 
-	store := protoarray.New(justified.Epoch, finalized.Epoch, bytesutil.ToBytes32(finalized.Root))
+	store := f.New(justified.Epoch, finalized.Epoch, bytesutil.ToBytes32(finalized.Root))
 	s.cfg.ForkChoiceStore = store
+	// The finalized block is the root of the fork choice store, every block inserted afterwards descends from it.
+	fRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalized.Root))
+	fBlock, err := s.cfg.BeaconDB.Block(s.ctx, fRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if fBlock != nil && !fBlock.IsNil() {
+		if err := store.ProcessBlock(s.ctx,
+			fBlock.Block().Slot(), fRoot, params.BeaconConfig().ZeroHash, bytesutil.ToBytes32(fBlock.Block().Body().Graffiti()),
+			justified.Epoch,
+			finalized.Epoch); err != nil {
+			return errors.Wrap(err, "could not insert finalized block to fork choice store")
+		}
+	}
 
 	ss, err := slots.EpochStart(s.finalizedCheckpt.Epoch)
 	if err != nil {
@@ -209,6 +226,18 @@ func (s *Service) startFromSavedState(saved state.BeaconState) error {
 			return errors.Wrap(err, "could not fill in fork choice store missing blocks")
 		}
 	}
+	// Blocks without an execution payload need no validation by the execution engine. This includes
+	// the head when it is the finalized block, the only block in fork choice after a restart.
+	headPayloadHash, err := payloadBlockHash(s.headBlock())
+	if err != nil {
+		return err
+	}
+	headRoot := s.headRoot()
+	if headPayloadHash == params.BeaconConfig().ZeroHash && s.cfg.ForkChoiceStore.HasNode(headRoot) {
+		if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(s.ctx, headRoot); err != nil {
+			return errors.Wrap(err, "could not set pre-merge blocks to valid in fork choice")
+		}
+	}
 
 	// not attempting to save initial sync blocks here, because there shouldn't be any until
 	// after the statefeed.Initialized event is fired (below)
@@ -443,6 +472,7 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState state.Beacon
 	}
 
 	s.originBlockRoot = genesisBlkRoot
+	s.genesisTime = time.Unix(int64(genesisState.GenesisTime()), 0)
 	s.cfg.StateGen.SaveFinalizedState(0 /*slot*/, genesisBlkRoot, genesisState)
 
 	// Finalized checkpoint at genesis is a zero hash.
@@ -463,11 +493,19 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState state.Beacon
 		genesisCheckpoint.Epoch); err != nil {
 		log.Fatalf("Could not process genesis block for fork choice: %v", err)
 	}
+	if err := s.cfg.ForkChoiceStore.SetOptimisticToValid(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not set genesis block to valid in fork choice")
+	}
 
 	s.setHead(genesisBlkRoot, genesisBlk, genesisState)
 	return nil
 }
 
+// SetGenesisTime sets the genesis time of beacon chain.
+func (s *Service) SetGenesisTime(t time.Time) {
+	s.genesisTime = t
+}
+
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
 // 1.) Check fork choice store.
 // 2.) Check DB.
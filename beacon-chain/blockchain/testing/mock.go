@@ -18,7 +18,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
@@ -49,7 +49,7 @@ type ChainService struct {
 	blockNotifier               blockfeed.Notifier
 	opNotifier                  opfeed.Notifier
 	ValidAttestation            bool
-	ForkChoiceStore             *protoarray.Store
+	ForkChoiceStore             forkchoice.ForkChoicer
 	VerifyBlkDescendantErr      error
 	Slot                        *types.Slot // Pointer because 0 is a useful value, so checking against it can be incorrect.
 	SyncCommitteeIndices        []types.CommitteeIndex
@@ -59,6 +59,8 @@ type ChainService struct {
 	PublicKey                   [fieldparams.BLSPubkeyLength]byte
 	SyncCommitteePubkeys        [][]byte
 	InitSyncBlockRoots          map[[32]byte]bool
+	Optimistic                  bool
+	OptimisticRoots             map[[32]byte]bool
 }
 
 // StateNotifier mocks the same method in the chain service.
@@ -318,8 +320,8 @@ func (s *ChainService) HeadETH1Data() *ethpb.Eth1Data {
 	return s.ETH1Data
 }
 
-// ProtoArrayStore mocks the same method in the chain service.
-func (s *ChainService) ProtoArrayStore() *protoarray.Store {
+// ForkChoicer mocks the same method in the chain service.
+func (s *ChainService) ForkChoicer() forkchoice.ForkChoicer {
 	return s.ForkChoiceStore
 }
 
@@ -404,6 +406,16 @@ func (_ *ChainService) ChainHeads() ([][32]byte, []types.Slot) {
 		[]types.Slot{0, 1}
 }
 
+// IsOptimistic mocks IsOptimistic and returns `Optimistic`.
+func (s *ChainService) IsOptimistic(_ context.Context) (bool, error) {
+	return s.Optimistic, nil
+}
+
+// IsOptimisticForRoot mocks IsOptimisticForRoot and returns whether the root is in `OptimisticRoots`.
+func (s *ChainService) IsOptimisticForRoot(_ context.Context, root [32]byte) (bool, error) {
+	return s.OptimisticRoots[root], nil
+}
+
 // HeadPublicKeyToValidatorIndex mocks HeadPublicKeyToValidatorIndex and always return 0 and true.
 func (_ *ChainService) HeadPublicKeyToValidatorIndex(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) (types.ValidatorIndex, bool) {
 	return 0, true
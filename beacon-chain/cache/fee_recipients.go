@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/patrickmn/go-cache"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+)
+
+// feeRecipientExpiryEpochs is the number of epochs for which a registered fee recipient is kept.
+// Validator clients register their fee recipients every epoch, so a fee recipient registered in
+// an epoch remains in use through that epoch and the two following ones.
+const feeRecipientExpiryEpochs = 3
+
+// FeeRecipientCache tracks the fee recipients registered by the validators connected to this node,
+// which are used in the execution payloads of the blocks they propose. Entries expire if they are
+// not registered again.
+type FeeRecipientCache struct {
+	recipients *cache.Cache
+}
+
+// NewFeeRecipientCache creates a new fee recipient cache for storing/accessing the fee
+// recipients of validator indices.
+func NewFeeRecipientCache() *FeeRecipientCache {
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	return newFeeRecipientCache(feeRecipientExpiryEpochs*epochDuration, epochDuration)
+}
+
+func newFeeRecipientCache(expiration, cleanupInterval time.Duration) *FeeRecipientCache {
+	return &FeeRecipientCache{recipients: cache.New(expiration, cleanupInterval)}
+}
+
+// SetFeeRecipient registers the fee recipient of the given validator index, resetting its expiry.
+func (c *FeeRecipientCache) SetFeeRecipient(vIdx types.ValidatorIndex, feeRecipient common.Address) {
+	c.recipients.SetDefault(feeRecipientKey(vIdx), feeRecipient)
+}
+
+// FeeRecipient returns the fee recipient registered for the given validator index. It returns
+// false if none is registered or the registration has expired.
+func (c *FeeRecipientCache) FeeRecipient(vIdx types.ValidatorIndex) (common.Address, bool) {
+	val, ok := c.recipients.Get(feeRecipientKey(vIdx))
+	if !ok {
+		return common.Address{}, false
+	}
+	feeRecipient, ok := val.(common.Address)
+	return feeRecipient, ok
+}
+
+// FeeRecipientOrDefault returns the fee recipient registered for the given validator index, or
+// the fee recipient configured in the node if none is registered. It is safe to call on a nil cache.
+func (c *FeeRecipientCache) FeeRecipientOrDefault(vIdx types.ValidatorIndex) common.Address {
+	if c != nil {
+		if feeRecipient, ok := c.FeeRecipient(vIdx); ok {
+			return feeRecipient
+		}
+	}
+	return params.BeaconConfig().FeeRecipient
+}
+
+func feeRecipientKey(vIdx types.ValidatorIndex) string {
+	return strconv.FormatUint(uint64(vIdx), 10)
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestFeeRecipientCache_SetAndGet(t *testing.T) {
+	c := NewFeeRecipientCache()
+	_, ok := c.FeeRecipient(1)
+	require.Equal(t, false, ok)
+
+	feeRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	c.SetFeeRecipient(1, feeRecipient)
+	got, ok := c.FeeRecipient(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, feeRecipient, got)
+	_, ok = c.FeeRecipient(2)
+	require.Equal(t, false, ok)
+
+	// Overwrites the previous fee recipient of the index.
+	feeRecipient = common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	c.SetFeeRecipient(1, feeRecipient)
+	got, ok = c.FeeRecipient(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, feeRecipient, got)
+}
+
+func TestFeeRecipientCache_Expiry(t *testing.T) {
+	c := newFeeRecipientCache(10*time.Millisecond, time.Millisecond)
+	c.SetFeeRecipient(1, common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A"))
+	time.Sleep(20 * time.Millisecond)
+	_, ok := c.FeeRecipient(1)
+	require.Equal(t, false, ok)
+}
+
+func TestFeeRecipientCache_FeeRecipientOrDefault(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.FeeRecipient = common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	params.OverrideBeaconConfig(cfg)
+
+	var nilCache *FeeRecipientCache
+	require.Equal(t, cfg.FeeRecipient, nilCache.FeeRecipientOrDefault(1))
+	c := NewFeeRecipientCache()
+	require.Equal(t, cfg.FeeRecipient, c.FeeRecipientOrDefault(1))
+	feeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	c.SetFeeRecipient(1, feeRecipient)
+	require.Equal(t, feeRecipient, c.FeeRecipientOrDefault(1))
+}
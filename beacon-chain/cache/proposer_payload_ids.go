@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// proposerPayloadID is the cached proposal of a slot. It holds the proposer index, along
+// with the payload ID returned by the execution engine for the given head root.
+type proposerPayloadID struct {
+	proposerIndex types.ValidatorIndex
+	headRoot      [32]byte
+	payloadID     [8]byte
+}
+
+// ProposerPayloadIDsCache tracks the slots in which validators connected to this node
+// are scheduled to propose, along with the payload IDs prepared for those proposals.
+type ProposerPayloadIDsCache struct {
+	slotToProposerAndPayloadIDs map[types.Slot]*proposerPayloadID
+	sync.RWMutex
+}
+
+// NewProposerPayloadIDsCache creates a new proposer payload IDs cache for storing/accessing
+// the proposer index and payload ID of upcoming proposal slots.
+func NewProposerPayloadIDsCache() *ProposerPayloadIDsCache {
+	return &ProposerPayloadIDsCache{
+		slotToProposerAndPayloadIDs: make(map[types.Slot]*proposerPayloadID),
+	}
+}
+
+// GetProposerPayloadIDs returns the proposer index, the head root and the payload ID
+// of the given slot. It returns false if no proposer is tracked for the slot.
+func (c *ProposerPayloadIDsCache) GetProposerPayloadIDs(slot types.Slot) (types.ValidatorIndex, [32]byte, [8]byte, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	p, ok := c.slotToProposerAndPayloadIDs[slot]
+	if !ok {
+		return 0, [32]byte{}, [8]byte{}, false
+	}
+	return p.proposerIndex, p.headRoot, p.payloadID, true
+}
+
+// SetProposerAndPayloadIDs sets the proposer index, the head root and the payload ID of the given slot.
+func (c *ProposerPayloadIDsCache) SetProposerAndPayloadIDs(slot types.Slot, vIdx types.ValidatorIndex, headRoot [32]byte, pid [8]byte) {
+	c.Lock()
+	defer c.Unlock()
+	c.slotToProposerAndPayloadIDs[slot] = &proposerPayloadID{
+		proposerIndex: vIdx,
+		headRoot:      headRoot,
+		payloadID:     pid,
+	}
+}
+
+// PrunePayloadIDs removes the entries of every slot before the given slot.
+func (c *ProposerPayloadIDsCache) PrunePayloadIDs(slot types.Slot) {
+	c.Lock()
+	defer c.Unlock()
+	for s := range c.slotToProposerAndPayloadIDs {
+		if s < slot {
+			delete(c.slotToProposerAndPayloadIDs, s)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestProposerPayloadIDsCache_SetAndGet(t *testing.T) {
+	c := NewProposerPayloadIDsCache()
+	_, _, _, ok := c.GetProposerPayloadIDs(1)
+	require.Equal(t, false, ok)
+
+	c.SetProposerAndPayloadIDs(1, 2, [32]byte{'a'}, [8]byte{'b'})
+	vIdx, root, pid, ok := c.GetProposerPayloadIDs(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, types.ValidatorIndex(2), vIdx)
+	require.Equal(t, [32]byte{'a'}, root)
+	require.Equal(t, [8]byte{'b'}, pid)
+
+	// Overwrites the previous entry of the slot.
+	c.SetProposerAndPayloadIDs(1, 3, [32]byte{'c'}, [8]byte{'d'})
+	vIdx, root, pid, ok = c.GetProposerPayloadIDs(1)
+	require.Equal(t, true, ok)
+	require.Equal(t, types.ValidatorIndex(3), vIdx)
+	require.Equal(t, [32]byte{'c'}, root)
+	require.Equal(t, [8]byte{'d'}, pid)
+}
+
+func TestProposerPayloadIDsCache_Prune(t *testing.T) {
+	c := NewProposerPayloadIDsCache()
+	for i := types.Slot(0); i < 10; i++ {
+		c.SetProposerAndPayloadIDs(i, types.ValidatorIndex(i), [32]byte{}, [8]byte{})
+	}
+	c.PrunePayloadIDs(5)
+	for i := types.Slot(0); i < 10; i++ {
+		_, _, _, ok := c.GetProposerPayloadIDs(i)
+		require.Equal(t, i >= 5, ok)
+	}
+}
@@ -0,0 +1,126 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// statePath is the path of the beacon API endpoint serving beacon states.
+	statePath = "/eth/v2/debug/beacon/states/%s"
+	// blockPath is the path of the beacon API endpoint serving signed beacon blocks.
+	blockPath = "/eth/v2/beacon/blocks/%s"
+	// sszMediaType is the media type requested to obtain ssz encoded responses from the beacon API.
+	sszMediaType = "application/octet-stream"
+	// finalizedStateID identifies the finalized state in beacon API requests.
+	finalizedStateID = "finalized"
+)
+
+// APIInitializer initializes the database with the checkpoint sync state and block downloaded
+// from the beacon API of a trusted beacon node.
+type APIInitializer struct {
+	baseURL string
+	client  *http.Client
+	ws      *ethpb.Checkpoint
+}
+
+var _ Initializer = (*APIInitializer)(nil)
+
+// NewAPIInitializer creates an initializer of the database downloading the checkpoint sync state
+// and block from the beacon node at the given URL. The state and block of the weak subjectivity
+// checkpoint are downloaded if it is not nil, else the finalized state and block are used.
+func NewAPIInitializer(beaconNodeURL string, ws *ethpb.Checkpoint) (*APIInitializer, error) {
+	u, err := url.Parse(beaconNodeURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse checkpoint sync url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("checkpoint sync url must use http or https, got %q", u.Scheme)
+	}
+	return &APIInitializer{
+		baseURL: strings.TrimSuffix(u.String(), "/"),
+		client:  &http.Client{},
+		ws:      ws,
+	}, nil
+}
+
+// Initialize saves the checkpoint sync state and block downloaded from the beacon node as
+// the origin of the chain, unless the database already holds a chain.
+func (ai *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
+	initialized, err := isInitialized(ctx, d)
+	if err != nil {
+		return err
+	}
+	if initialized {
+		log.Info("Database already holds a chain, skipping checkpoint sync")
+		return nil
+	}
+
+	stateID := finalizedStateID
+	if ai.ws != nil {
+		slot, err := slots.EpochStart(ai.ws.Epoch)
+		if err != nil {
+			return err
+		}
+		stateID = strconv.FormatUint(uint64(slot), 10)
+	}
+	log.WithFields(logrus.Fields{
+		"url":     ai.baseURL,
+		"stateID": stateID,
+	}).Info("Downloading checkpoint sync state")
+	serState, err := ai.get(ctx, fmt.Sprintf(statePath, stateID))
+	if err != nil {
+		return errors.Wrap(err, "could not download checkpoint state")
+	}
+	st, err := detect.UnmarshalState(serState)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	blockRoot, err := latestBlockRoot(ctx, st)
+	if err != nil {
+		return err
+	}
+	serBlock, err := ai.get(ctx, fmt.Sprintf(blockPath, hexutil.Encode(blockRoot[:])))
+	if err != nil {
+		return errors.Wrap(err, "could not download checkpoint block")
+	}
+	return saveOrigin(ctx, d, st, serState, serBlock, ai.ws)
+}
+
+// get requests the ssz encoded response of the beacon API endpoint at the given path.
+func (ai *APIInitializer) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ai.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", sszMediaType)
+	resp, err := ai.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code %d from %s", resp.StatusCode, path)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != sszMediaType {
+		return nil, errors.Errorf("unexpected content type %q from %s", contentType, path)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,123 @@
+// Package checkpoint initializes the database of a beacon node with a finalized state and
+// the block it was built upon, so that the node syncs from that checkpoint instead of genesis.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Initializer obtains the checkpoint sync state and block from a source, and saves them
+// as the origin of the chain in the database.
+type Initializer interface {
+	Initialize(ctx context.Context, d db.Database) error
+}
+
+// isInitialized returns true if the database already holds a chain beyond genesis, in which
+// case the node resumes from it rather than from the checkpoint.
+func isInitialized(ctx context.Context, d db.Database) (bool, error) {
+	_, err := d.OriginBlockRoot(ctx)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, db.ErrNotFound) {
+		return false, errors.Wrap(err, "could not get origin block root")
+	}
+	head, err := d.HeadBlock(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get head block")
+	}
+	return head != nil && !head.IsNil() && head.Block().Slot() > 0, nil
+}
+
+// saveOrigin verifies the checkpoint sync state and block, and saves them as the origin of the chain.
+func saveOrigin(ctx context.Context, d db.Database, st state.BeaconState, serState, serBlock []byte, ws *ethpb.Checkpoint) error {
+	blk, err := detect.UnmarshalBlock(serBlock)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	blockRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	latestRoot, err := latestBlockRoot(ctx, st)
+	if err != nil {
+		return err
+	}
+	if blockRoot != latestRoot {
+		return errors.Errorf("checkpoint block root %#x is not the latest block root %#x of the checkpoint state", blockRoot, latestRoot)
+	}
+	if err := verifyWeakSubjectivityCheckpoint(st, blockRoot, ws); err != nil {
+		return err
+	}
+	if err := verifyGenesis(ctx, d, st); err != nil {
+		return err
+	}
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return errors.Wrap(err, "could not save checkpoint sync origin")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      st.Slot(),
+		"blockRoot": bytesutil.Trunc(blockRoot[:]),
+	}).Info("Initialized database from checkpoint sync data")
+	return nil
+}
+
+// latestBlockRoot returns the root of the latest block processed by the given state. The state
+// root of the latest block header is only filled in by the next slot's processing.
+func latestBlockRoot(ctx context.Context, st state.BeaconState) ([32]byte, error) {
+	header := ethpb.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	if header == nil {
+		return [32]byte{}, errors.New("checkpoint state has no latest block header")
+	}
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
+
+// verifyWeakSubjectivityCheckpoint checks that the checkpoint block is the block of the weak
+// subjectivity checkpoint, and that the checkpoint state is at the checkpoint's epoch.
+func verifyWeakSubjectivityCheckpoint(st state.BeaconState, blockRoot [32]byte, ws *ethpb.Checkpoint) error {
+	if ws == nil {
+		return nil
+	}
+	if blockRoot != bytesutil.ToBytes32(ws.Root) {
+		return errors.Errorf("checkpoint block root %#x does not match the weak subjectivity checkpoint root %#x", blockRoot, ws.Root)
+	}
+	if epoch := slots.ToEpoch(st.Slot()); epoch != ws.Epoch {
+		return errors.Errorf("checkpoint state epoch %d does not match the weak subjectivity checkpoint epoch %d", epoch, ws.Epoch)
+	}
+	return nil
+}
+
+// verifyGenesis checks that the checkpoint state belongs to the chain of the genesis state
+// known to the database, if any.
+func verifyGenesis(ctx context.Context, d db.Database, st state.BeaconState) error {
+	gs, err := d.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if gs == nil || gs.IsNil() {
+		return nil
+	}
+	if !bytes.Equal(gs.GenesisValidatorRoot(), st.GenesisValidatorRoot()) {
+		return errors.Errorf("checkpoint state genesis validators root %#x does not match the genesis validators root %#x",
+			st.GenesisValidatorRoot(), gs.GenesisValidatorRoot())
+	}
+	return nil
+}
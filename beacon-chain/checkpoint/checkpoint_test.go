@@ -0,0 +1,218 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// checkpointData returns the ssz encoded state and block of a checkpoint at the given epoch,
+// along with the checkpoint's block root.
+func checkpointData(t *testing.T, epoch types.Epoch) ([]byte, []byte, [32]byte) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	// A config without embedded genesis state.
+	cfg.ConfigName = "checkpoint-test"
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 4)
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+		Epoch:           0,
+	}))
+	slot := types.Slot(epoch) * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(slot))
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'a'}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serState, serBlock, blockRoot
+}
+
+func writeCheckpointFiles(t *testing.T, serState, serBlock []byte) (string, string) {
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, file.WriteFile(statePath, serState))
+	require.NoError(t, file.WriteFile(blockPath, serBlock))
+	return statePath, blockPath
+}
+
+func TestFileInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, blockRoot := checkpointData(t, 2)
+	statePath, blockPath := writeCheckpointFiles(t, serState, serBlock)
+	d := dbtest.SetupDB(t)
+
+	fi, err := NewFileInitializer(statePath, blockPath, &ethpb.Checkpoint{Epoch: 2, Root: blockRoot[:]})
+	require.NoError(t, err)
+	require.NoError(t, fi.Initialize(ctx, d))
+	originRoot, err := d.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+
+	// The database is not initialized again once it holds a chain.
+	other, err := NewFileInitializer(statePath, statePath, nil)
+	require.NoError(t, err)
+	require.NoError(t, other.Initialize(ctx, d))
+}
+
+func TestFileInitializer_Initialize_Invalid(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, blockRoot := checkpointData(t, 2)
+	_, otherBlock, _ := checkpointData(t, 3)
+
+	tests := []struct {
+		name     string
+		serBlock []byte
+		ws       *ethpb.Checkpoint
+		wantErr  string
+	}{
+		{
+			name:     "block is not the latest block of the state",
+			serBlock: otherBlock,
+			wantErr:  "is not the latest block root",
+		},
+		{
+			name:     "weak subjectivity checkpoint root mismatch",
+			serBlock: serBlock,
+			ws:       &ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'b'}, 32)},
+			wantErr:  "does not match the weak subjectivity checkpoint root",
+		},
+		{
+			name:     "weak subjectivity checkpoint epoch mismatch",
+			serBlock: serBlock,
+			ws:       &ethpb.Checkpoint{Epoch: 3, Root: blockRoot[:]},
+			wantErr:  "does not match the weak subjectivity checkpoint epoch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statePath, blockPath := writeCheckpointFiles(t, serState, tt.serBlock)
+			d := dbtest.SetupDB(t)
+			fi, err := NewFileInitializer(statePath, blockPath, tt.ws)
+			require.NoError(t, err)
+			require.ErrorContains(t, tt.wantErr, fi.Initialize(ctx, d))
+			_, err = d.OriginBlockRoot(ctx)
+			require.ErrorContains(t, "not found", err)
+		})
+	}
+}
+
+func TestFileInitializer_Initialize_GenesisMismatch(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, _ := checkpointData(t, 2)
+	statePath, blockPath := writeCheckpointFiles(t, serState, serBlock)
+	d := dbtest.SetupDB(t)
+	gs, _ := util.DeterministicGenesisState(t, 8)
+	require.NoError(t, gs.SetGenesisValidatorRoot(bytesutil.PadTo([]byte{'c'}, 32)))
+	require.NoError(t, d.SaveGenesisData(ctx, gs))
+
+	fi, err := NewFileInitializer(statePath, blockPath, nil)
+	require.NoError(t, err)
+	require.ErrorContains(t, "does not match the genesis validators root", fi.Initialize(ctx, d))
+}
+
+func TestNewFileInitializer_MissingFile(t *testing.T) {
+	_, err := NewFileInitializer(filepath.Join(t.TempDir(), "state.ssz"), "block.ssz", nil)
+	require.ErrorContains(t, "does not exist", err)
+}
+
+func TestAPIInitializer_Initialize(t *testing.T) {
+	ctx := context.Background()
+	serState, serBlock, blockRoot := checkpointData(t, 2)
+
+	tests := []struct {
+		name    string
+		ws      *ethpb.Checkpoint
+		stateID string
+	}{
+		{
+			name:    "finalized checkpoint",
+			stateID: "finalized",
+		},
+		{
+			name:    "weak subjectivity checkpoint",
+			ws:      &ethpb.Checkpoint{Epoch: 2, Root: blockRoot[:]},
+			stateID: strconv.Itoa(int(2 * params.BeaconConfig().SlotsPerEpoch)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			serve := func(path string, body []byte) {
+				mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, sszMediaType, r.Header.Get("Accept"))
+					w.Header().Set("Content-Type", sszMediaType)
+					_, err := w.Write(body)
+					require.NoError(t, err)
+				})
+			}
+			serve(fmt.Sprintf(statePath, tt.stateID), serState)
+			serve(fmt.Sprintf(blockPath, hexutil.Encode(blockRoot[:])), serBlock)
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			d := dbtest.SetupDB(t)
+			ai, err := NewAPIInitializer(srv.URL+"/", tt.ws)
+			require.NoError(t, err)
+			require.NoError(t, ai.Initialize(ctx, d))
+			originRoot, err := d.OriginBlockRoot(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, blockRoot, originRoot)
+		})
+	}
+}
+
+func TestAPIInitializer_Initialize_UnexpectedResponse(t *testing.T) {
+	ctx := context.Background()
+	_, _, blockRoot := checkpointData(t, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ai, err := NewAPIInitializer(srv.URL, &ethpb.Checkpoint{Epoch: 2, Root: blockRoot[:]})
+	require.NoError(t, err)
+	require.ErrorContains(t, "unexpected status code 404", ai.Initialize(ctx, dbtest.SetupDB(t)))
+}
+
+func TestNewAPIInitializer_InvalidURL(t *testing.T) {
+	_, err := NewAPIInitializer("localhost:3500", nil)
+	require.ErrorContains(t, "must use http or https", err)
+}
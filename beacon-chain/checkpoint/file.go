@@ -0,0 +1,63 @@
+package checkpoint
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// FileInitializer initializes the database with the checkpoint sync state and block
+// read from ssz files.
+type FileInitializer struct {
+	statePath string
+	blockPath string
+	ws        *ethpb.Checkpoint
+}
+
+var _ Initializer = (*FileInitializer)(nil)
+
+// NewFileInitializer creates an initializer of the database reading the checkpoint sync state
+// and block from the given files. The files are verified against the weak subjectivity
+// checkpoint if it is not nil.
+func NewFileInitializer(statePath, blockPath string, ws *ethpb.Checkpoint) (*FileInitializer, error) {
+	for _, path := range []string{statePath, blockPath} {
+		if !file.FileExists(path) {
+			return nil, errors.Errorf("checkpoint sync file %s does not exist", path)
+		}
+	}
+	return &FileInitializer{
+		statePath: statePath,
+		blockPath: blockPath,
+		ws:        ws,
+	}, nil
+}
+
+// Initialize saves the checkpoint sync state and block read from files as the origin of the
+// chain, unless the database already holds a chain.
+func (fi *FileInitializer) Initialize(ctx context.Context, d db.Database) error {
+	initialized, err := isInitialized(ctx, d)
+	if err != nil {
+		return err
+	}
+	if initialized {
+		log.Info("Database already holds a chain, skipping checkpoint sync")
+		return nil
+	}
+	serState, err := file.ReadFileAsBytes(fi.statePath)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint state file")
+	}
+	serBlock, err := file.ReadFileAsBytes(fi.blockPath)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint block file")
+	}
+	st, err := detect.UnmarshalState(serState)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	return saveOrigin(ctx, d, st, serState, serBlock, fi.ws)
+}
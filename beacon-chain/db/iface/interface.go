@@ -13,6 +13,7 @@ import (
 	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 )
@@ -48,9 +49,14 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*ethpb.ETH1ChainData, error)
+	// Light client operations.
+	LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpbv2.LightClientUpdate, error)
+	// Peer reputation operations.
+	PeerReputations(ctx context.Context) ([]*ethpb.PeerReputation, error)
 
 	// origin checkpoint sync support
 	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -61,6 +67,9 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block block.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []block.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveFinalizedBlockRoots(ctx context.Context, blks []block.SignedBeaconBlock, childRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
@@ -75,10 +84,15 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *ethpb.ETH1ChainData) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error
+	// Peer reputation operations.
+	SavePeerReputations(ctx context.Context, reputations []*ethpb.PeerReputation) error
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
+	PruneHistory(ctx context.Context, beforeSlot types.Slot, limit int) (int, uint64, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -95,7 +109,7 @@ type HeadAccessDatabase interface {
 	EnsureEmbeddedGenesis(ctx context.Context) error
 
 	// initialization method needed for origin checkpoint sync
-	SaveOrigin(ctx context.Context, state io.Reader, block io.Reader) error
+	SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 }
 
 // SlasherDatabase interface for persisting data related to detecting slashable offenses on Ethereum.
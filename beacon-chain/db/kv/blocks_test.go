@@ -220,6 +220,19 @@ func TestStore_GenesisBlock(t *testing.T) {
 	assert.Equal(t, true, proto.Equal(genesisBlock, retrievedBlock.Proto()), "Wanted: %v, received: %v", genesisBlock, retrievedBlock)
 }
 
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	_, err := db.BackfillBlockRoot(ctx)
+	require.ErrorIs(t, err, ErrNotFoundBackfillBlockRoot)
+
+	want := bytesutil.ToBytes32([]byte("backfill"))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, want))
+	got, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
 func TestStore_BlocksCRUD_NoCache(t *testing.T) {
 	for _, tt := range blockTests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -9,6 +9,9 @@ var ErrNotFound = errors.New("not found in db")
 // ErrNotFoundOriginBlockRoot is an error specifically for the origin block root getter
 var ErrNotFoundOriginBlockRoot = WrapDBError(ErrNotFound, "OriginBlockRoot")
 
+// ErrNotFoundBackfillBlockRoot is an error specifically for the backfill block root getter
+var ErrNotFoundBackfillBlockRoot = WrapDBError(ErrNotFound, "BackfillBlockRoot")
+
 // WrapDBError wraps an error in a DBError. See commentary on DBError for more context.
 func WrapDBError(e error, outer string) error {
 	return DBError{
@@ -46,6 +46,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originBlockRootKey)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -104,6 +105,12 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 			return err
 		}
 
+		// The ancestors of the checkpoint sync origin block are not in the database,
+		// so the walk stops once the origin block is indexed.
+		if originRoot != nil && bytes.Equal(root, originRoot) {
+			break
+		}
+
 		// Found parent, loop exit condition.
 		if parentBytes := bkt.Get(block.ParentRoot()); parentBytes != nil {
 			parent := &ethpb.FinalizedBlockRootContainer{}
@@ -154,6 +161,42 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	return bkt.Put(previousFinalizedCheckpointKey, enc)
 }
 
+// SaveFinalizedBlockRoots indexes a chain of blocks below the lowest block of the finalized block
+// roots index as finalized and canonical, such as the blocks backfilled below the checkpoint sync
+// origin block. The blocks must be sorted by slot, each block being the parent of the next one, and
+// childRoot is the root of the block whose parent is the last of the given blocks.
+func (s *Store) SaveFinalizedBlockRoots(ctx context.Context, blks []block.SignedBeaconBlock, childRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveFinalizedBlockRoots")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for i := len(blks) - 1; i >= 0; i-- {
+			if err := helpers.BeaconBlockIsNil(blks[i]); err != nil {
+				return err
+			}
+			blk := blks[i].Block()
+			root, err := blk.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			enc, err := encode(ctx, &ethpb.FinalizedBlockRootContainer{
+				ParentRoot: blk.ParentRoot(),
+				ChildRoot:  childRoot[:],
+			})
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(root[:], enc); err != nil {
+				tracing.AnnotateError(span, err)
+				return err
+			}
+			childRoot = root
+		}
+		return nil
+	})
+}
+
 // IsFinalizedBlock returns true if the block root is present in the finalized block root index.
 // A beacon block root contained exists in this index if it is considered finalized and canonical.
 // Note: beacon blocks from the latest finalized epoch return true, whether or not they are
@@ -189,6 +189,41 @@ func TestStore_IsFinalizedChildBlock(t *testing.T) {
 	})
 }
 
+func TestStore_SaveFinalizedBlockRoots(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*2, genesisBlockRoot)
+	backfilled, origin := blks[:slotsPerEpoch], blks[slotsPerEpoch]
+	originRoot := bytesutil.ToBytes32(sszRootOrDie(t, origin))
+	require.NoError(t, db.SaveBlocks(ctx, blks[slotsPerEpoch:]))
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	// A state is required to save checkpoint
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: originRoot[:], Epoch: 1}))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot))
+
+	require.NoError(t, db.SaveBlocks(ctx, backfilled))
+	for _, blk := range backfilled {
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(sszRootOrDie(t, blk))))
+	}
+	require.NoError(t, db.SaveFinalizedBlockRoots(ctx, backfilled, originRoot))
+	for i, blk := range backfilled {
+		root := bytesutil.ToBytes32(sszRootOrDie(t, blk))
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+		child, err := db.FinalizedChildBlock(ctx, root)
+		require.NoError(t, err)
+		want := origin
+		if i < len(backfilled)-1 {
+			want = backfilled[i+1]
+		}
+		assert.DeepEqual(t, sszRootOrDie(t, want), sszRootOrDie(t, child))
+	}
+}
+
 func sszRootOrDie(t *testing.T, block block.SignedBeaconBlock) []byte {
 	root, err := block.Block().HashTreeRoot()
 	require.NoError(t, err)
@@ -0,0 +1,67 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate saves the best light client update of a sync committee period,
+// overwriting any update previously saved for that period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	if update == nil {
+		err := errors.New("cannot save nil light client update")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(lightClientBucket)
+		return bkt.Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// LightClientUpdates retrieves the saved light client updates of at most count sync committee
+// periods, starting at the given period. Periods without a saved update are skipped.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpbv2.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	updates := make([]*ethpbv2.LightClientUpdate, 0)
+	if count == 0 {
+		return updates, nil
+	}
+	endPeriod := startPeriod + count - 1
+	if endPeriod < startPeriod {
+		return nil, errors.New("light client update period range overflows")
+	}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil; k, v = c.Next() {
+			if bytesutil.BytesToUint64BigEndian(k) > endPeriod {
+				break
+			}
+			update := &ethpbv2.LightClientUpdate{}
+			if err := decode(ctx, v, update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return updates, err
+}
@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func lightClientUpdate(slot types.Slot) *ethpbv2.LightClientUpdate {
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	updates, err := db.LightClientUpdates(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, lightClientUpdate(8192)))
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 2, lightClientUpdate(16384)))
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 4, lightClientUpdate(32768)))
+	// A better update replaces the saved update of its period.
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 2, lightClientUpdate(16390)))
+
+	updates, err = db.LightClientUpdates(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(updates))
+	assert.Equal(t, types.Slot(8192), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(16390), updates[1].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(32768), updates[2].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, types.Slot(16390), updates[0].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	require.ErrorContains(t, "cannot save nil", db.SaveLightClientUpdate(ctx, 5, nil))
+}
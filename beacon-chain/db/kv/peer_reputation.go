@@ -0,0 +1,68 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SavePeerReputations saves the reputations of peers, replacing all the previously saved ones.
+func (s *Store) SavePeerReputations(ctx context.Context, reputations []*ethpb.PeerReputation) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePeerReputations")
+	defer span.End()
+
+	encoded := make([][]byte, len(reputations))
+	for i, r := range reputations {
+		if r == nil || r.PeerId == "" {
+			err := errors.New("cannot save peer reputation without peer id")
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		enc, err := encode(ctx, r)
+		if err != nil {
+			return err
+		}
+		encoded[i] = enc
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(peerReputationsBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(peerReputationsBucket)
+		if err != nil {
+			return err
+		}
+		for i, r := range reputations {
+			if err := bkt.Put([]byte(r.PeerId), encoded[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// PeerReputations retrieves the saved reputations of peers.
+func (s *Store) PeerReputations(ctx context.Context) ([]*ethpb.PeerReputation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerReputations")
+	defer span.End()
+
+	reputations := make([]*ethpb.PeerReputation, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(peerReputationsBucket).ForEach(func(_, v []byte) error {
+			r := &ethpb.PeerReputation{}
+			if err := decode(ctx, v, r); err != nil {
+				return err
+			}
+			reputations = append(reputations, r)
+			return nil
+		})
+	})
+	tracing.AnnotateError(span, err)
+	return reputations, err
+}
@@ -0,0 +1,40 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_PeerReputations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	reputations, err := db.PeerReputations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(reputations))
+
+	require.NoError(t, db.SavePeerReputations(ctx, []*ethpb.PeerReputation{
+		{PeerId: "peer1", BadResponses: 2, SavedAt: 100},
+		{PeerId: "peer2", ProcessedBlocks: 64, Address: "/ip4/127.0.0.1/tcp/13000", SavedAt: 100},
+	}))
+	reputations, err = db.PeerReputations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(reputations))
+	assert.Equal(t, "peer1", reputations[0].PeerId)
+	assert.Equal(t, uint64(2), reputations[0].BadResponses)
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/13000", reputations[1].Address)
+
+	// Saving replaces all the previously saved reputations.
+	require.NoError(t, db.SavePeerReputations(ctx, []*ethpb.PeerReputation{{PeerId: "peer3", GossipScore: -1.5}}))
+	reputations, err = db.PeerReputations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, "peer3", reputations[0].PeerId)
+	assert.Equal(t, -1.5, reputations[0].GossipScore)
+
+	require.ErrorContains(t, "without peer id", db.SavePeerReputations(ctx, []*ethpb.PeerReputation{{}}))
+}
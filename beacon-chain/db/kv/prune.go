@@ -0,0 +1,258 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneHistory deletes up to limit finalized blocks with a slot below beforeSlot, along with their
+// state summaries, states, indices and finalized block root index entries, and the validator
+// entries no longer referenced by any remaining state. The genesis, origin, backfill, finalized and
+// head blocks are never deleted. Neither is the highest saved state below beforeSlot and its block,
+// so that states above beforeSlot can still be regenerated. It returns the number of deleted
+// blocks and the number of bytes of the deleted keys and values.
+func (s *Store) PruneHistory(ctx context.Context, beforeSlot types.Slot, limit int) (int, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	if limit <= 0 {
+		return 0, 0, errors.New("limit must be positive")
+	}
+	validatorHashesMigrated, err := s.isStateValidatorMigrationOver()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var pruned int
+	var reclaimed uint64
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		blkBkt := tx.Bucket(blocksBucket)
+		// Values returned by bolt are only valid until the next write, so the roots are copied.
+		keep := [][]byte{
+			bytesutil.SafeCopyBytes(blkBkt.Get(genesisBlockRootKey)),
+			bytesutil.SafeCopyBytes(blkBkt.Get(originBlockRootKey)),
+			bytesutil.SafeCopyBytes(blkBkt.Get(backfillBlockRootKey)),
+			bytesutil.SafeCopyBytes(blkBkt.Get(headBlockRootKey)),
+		}
+		finalizedSlot := types.Slot(0)
+		if enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey); enc != nil {
+			checkpoint := &ethpb.Checkpoint{}
+			if err := decode(ctx, enc, checkpoint); err != nil {
+				return err
+			}
+			keep = append(keep, checkpoint.Root)
+			if finalizedSlot, err = slots.EpochStart(checkpoint.Epoch); err != nil {
+				return err
+			}
+		}
+		if beforeSlot > finalizedSlot {
+			beforeSlot = finalizedSlot
+		}
+
+		// Keep the highest saved state at or below the pruning slot, states above it are
+		// regenerated by replaying blocks on top of it.
+		stateCursor := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		k, v := stateCursor.Seek(bytesutil.SlotToBytesBigEndian(beforeSlot + 1))
+		if k == nil {
+			k, v = stateCursor.Last()
+		} else {
+			k, v = stateCursor.Prev()
+		}
+		if k == nil {
+			return nil
+		}
+		if slot := bytesutil.BytesToSlotBigEndian(k); slot < beforeSlot {
+			beforeSlot = slot
+		}
+		for i := 0; i+32 <= len(v); i += 32 {
+			keep = append(keep, bytesutil.SafeCopyBytes(v[i:i+32]))
+		}
+
+		roots := make([][]byte, 0, limit)
+		blockSlots := make([]types.Slot, 0, limit)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		for k, v := c.First(); k != nil && len(roots) < limit; k, v = c.Next() {
+			slot := bytesutil.BytesToSlotBigEndian(k)
+			if slot >= beforeSlot {
+				break
+			}
+			for i := 0; i+32 <= len(v) && len(roots) < limit; i += 32 {
+				if containsRoot(keep, v[i:i+32]) {
+					continue
+				}
+				roots = append(roots, bytesutil.SafeCopyBytes(v[i:i+32]))
+				blockSlots = append(blockSlots, slot)
+			}
+		}
+
+		// The validator entries of the deleted states are shared with other states, so they are
+		// collected and only deleted once no remaining state references them.
+		validatorHashes := make(map[string]bool)
+		for i, root := range roots {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			n, err := s.pruneBlockRoot(ctx, tx, root, blockSlots[i], validatorHashesMigrated, validatorHashes)
+			if err != nil {
+				return errors.Wrapf(err, "could not prune block root %#x", root)
+			}
+			pruned++
+			reclaimed += n
+		}
+		n, err := deleteUnreferencedValidators(ctx, tx, validatorHashes)
+		if err != nil {
+			return errors.Wrap(err, "could not delete validator entries")
+		}
+		reclaimed += n
+		return nil
+	})
+	return pruned, reclaimed, err
+}
+
+// pruneBlockRoot deletes the block, state summary, state and finalized index entry of a block
+// root, and returns the number of bytes of the deleted keys and values. The validator entry hashes
+// of the deleted state are added to validatorHashes.
+func (s *Store) pruneBlockRoot(ctx context.Context, tx *bolt.Tx, root []byte, blockSlot types.Slot, validatorHashesMigrated bool, validatorHashes map[string]bool) (uint64, error) {
+	var reclaimed uint64
+
+	// The slot of the state is looked up through the state summary, so the state goes first.
+	stateBkt := tx.Bucket(stateBucket)
+	if enc := stateBkt.Get(root); enc != nil {
+		reclaimed += uint64(len(root) + len(enc))
+		slot, err := s.slotByBlockRoot(ctx, tx, root)
+		if err != nil {
+			return 0, err
+		}
+		if err := deleteValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, slot), root, tx); err != nil {
+			return 0, errors.Wrap(err, "could not delete root for state indices")
+		}
+		if validatorHashesMigrated {
+			n, err := s.deleteValidatorHashes(tx, root, validatorHashes)
+			if err != nil {
+				return 0, err
+			}
+			reclaimed += n
+		}
+		if err := stateBkt.Delete(root); err != nil {
+			return 0, err
+		}
+	}
+
+	summaryBkt := tx.Bucket(stateSummaryBucket)
+	if enc := summaryBkt.Get(root); enc != nil {
+		reclaimed += uint64(len(root) + len(enc))
+		if err := summaryBkt.Delete(root); err != nil {
+			return 0, err
+		}
+	}
+	s.stateSummaryCache.delete(bytesutil.ToBytes32(root))
+
+	blkBkt := tx.Bucket(blocksBucket)
+	indicesByBucket := map[string][]byte{string(blockSlotIndicesBucket): bytesutil.SlotToBytesBigEndian(blockSlot)}
+	if enc := blkBkt.Get(root); enc != nil {
+		reclaimed += uint64(len(root) + len(enc))
+		blk, err := unmarshalBlock(ctx, enc)
+		if err != nil {
+			return 0, err
+		}
+		indicesByBucket = createBlockIndicesFromBlock(ctx, blk.Block())
+	}
+	if err := deleteValueForIndices(ctx, indicesByBucket, root, tx); err != nil {
+		return 0, errors.Wrap(err, "could not delete root for block indices")
+	}
+	s.blockCache.Del(string(root))
+	if err := blkBkt.Delete(root); err != nil {
+		return 0, err
+	}
+
+	finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+	if enc := finalizedBkt.Get(root); enc != nil {
+		reclaimed += uint64(len(root) + len(enc))
+		if err := finalizedBkt.Delete(root); err != nil {
+			return 0, err
+		}
+	}
+	return reclaimed, nil
+}
+
+// deleteValidatorHashes deletes the validator entry hashes of the state of a block root, adds them
+// to validatorHashes and evicts the validator entries from the cache. The validator entries
+// themselves may be shared with other states, so they are kept. It returns the number of bytes of
+// the deleted key and value.
+func (s *Store) deleteValidatorHashes(tx *bolt.Tx, root []byte, validatorHashes map[string]bool) (uint64, error) {
+	idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+	compressedValidatorHashes := idxBkt.Get(root)
+	if compressedValidatorHashes == nil {
+		return 0, nil
+	}
+	reclaimed := uint64(len(root) + len(compressedValidatorHashes))
+	hashes, err := snappy.Decode(nil, compressedValidatorHashes)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to uncompress validator keys")
+	}
+	if len(hashes)%hashLength != 0 {
+		return 0, errors.Errorf("invalid validator keys length: %d", len(hashes))
+	}
+	for i := 0; i < len(hashes); i += hashLength {
+		s.validatorEntryCache.Del(hashes[i : i+hashLength])
+		validatorEntryCacheDelete.Inc()
+		validatorHashes[string(hashes[i:i+hashLength])] = true
+	}
+	return reclaimed, idxBkt.Delete(root)
+}
+
+// deleteUnreferencedValidators deletes the validator entries of validatorHashes that are not
+// referenced by any remaining state, and returns the number of bytes of the deleted keys and values.
+func deleteUnreferencedValidators(ctx context.Context, tx *bolt.Tx, validatorHashes map[string]bool) (uint64, error) {
+	if len(validatorHashes) == 0 {
+		return 0, nil
+	}
+	err := tx.Bucket(blockRootValidatorHashesBucket).ForEach(func(_, v []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hashes, err := snappy.Decode(nil, v)
+		if err != nil {
+			return errors.Wrap(err, "failed to uncompress validator keys")
+		}
+		for i := 0; i+hashLength <= len(hashes); i += hashLength {
+			delete(validatorHashes, string(hashes[i:i+hashLength]))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var reclaimed uint64
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	for hash := range validatorHashes {
+		enc := valBkt.Get([]byte(hash))
+		if enc == nil {
+			continue
+		}
+		reclaimed += uint64(len(hash) + len(enc))
+		if err := valBkt.Delete([]byte(hash)); err != nil {
+			return 0, err
+		}
+	}
+	return reclaimed, nil
+}
+
+func containsRoot(roots [][]byte, root []byte) bool {
+	for _, r := range roots {
+		if bytes.Equal(r, root) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+// savePruneTestChain saves a chain of blocks with a state summary at every slot up to the given
+// slot, and a state at every epoch boundary. It returns the block roots indexed by slot.
+func savePruneTestChain(t *testing.T, db *Store, lastSlot types.Slot) [][32]byte {
+	ctx := context.Background()
+	roots := make([][32]byte, 0, lastSlot+1)
+	parentRoot := make([]byte, 32)
+	for i := types.Slot(0); i <= lastSlot; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = parentRoot
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: i, Root: r[:]}))
+		if i == 0 {
+			require.NoError(t, db.SaveGenesisBlockRoot(ctx, r))
+		}
+		if i%params.BeaconConfig().SlotsPerEpoch == 0 {
+			st, err := util.NewBeaconState()
+			require.NoError(t, err)
+			require.NoError(t, st.SetSlot(i))
+			require.NoError(t, db.SaveState(ctx, st, r))
+		}
+		roots = append(roots, r)
+		parentRoot = r[:]
+	}
+	return roots
+}
+
+func TestStore_PruneHistory(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := savePruneTestChain(t, db, 4*slotsPerEpoch)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: roots[3*slotsPerEpoch][:]}))
+
+	// The state at the second epoch boundary is the highest state below the pruning slot.
+	beforeSlot := 2*slotsPerEpoch + 1
+	pruned, reclaimed, err := db.PruneHistory(ctx, beforeSlot, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, int(2*slotsPerEpoch-1), pruned)
+	assert.NotEqual(t, uint64(0), reclaimed)
+
+	for i, r := range roots {
+		slot := types.Slot(i)
+		kept := slot == 0 || slot >= 2*slotsPerEpoch
+		assert.Equal(t, kept, db.HasBlock(ctx, r), "unexpected block at slot %d", slot)
+		assert.Equal(t, kept, db.HasStateSummary(ctx, r), "unexpected state summary at slot %d", slot)
+		if slot%slotsPerEpoch == 0 {
+			assert.Equal(t, kept, db.HasState(ctx, r), "unexpected state at slot %d", slot)
+		}
+	}
+	blockRoots, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(2*slotsPerEpoch-1))
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{roots[0]}, blockRoots)
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[1]))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[2*slotsPerEpoch]))
+
+	// Nothing is left to prune.
+	pruned, reclaimed, err = db.PruneHistory(ctx, beforeSlot, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned)
+	assert.Equal(t, uint64(0), reclaimed)
+}
+
+func TestStore_PruneHistory_Limit(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := savePruneTestChain(t, db, 2*slotsPerEpoch)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[2*slotsPerEpoch][:]}))
+
+	pruned, _, err := db.PruneHistory(ctx, 2*slotsPerEpoch, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 10, pruned)
+	for i := 1; i <= 10; i++ {
+		assert.Equal(t, false, db.HasBlock(ctx, roots[i]))
+	}
+	assert.Equal(t, true, db.HasBlock(ctx, roots[11]))
+
+	_, _, err = db.PruneHistory(ctx, 2*slotsPerEpoch, 0)
+	require.ErrorContains(t, "limit must be positive", err)
+}
+
+func TestStore_PruneHistory_KeepsUnfinalized(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := savePruneTestChain(t, db, 3*slotsPerEpoch)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[slotsPerEpoch][:]}))
+
+	// The pruning slot is capped at the start of the finalized epoch.
+	pruned, _, err := db.PruneHistory(ctx, 3*slotsPerEpoch, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, int(slotsPerEpoch-1), pruned)
+	for i := slotsPerEpoch; i <= 3*slotsPerEpoch; i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]))
+	}
+}
+
+func TestStore_PruneHistory_ValidatorEntries(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetCfg()
+
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := savePruneTestChain(t, db, 2*slotsPerEpoch)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[2*slotsPerEpoch][:]}))
+
+	// The pruned state shares its first validator with the kept state.
+	vals := validators(3)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slotsPerEpoch))
+	require.NoError(t, st.SetValidators(vals[:2]))
+	require.NoError(t, db.SaveState(ctx, st, roots[slotsPerEpoch]))
+	st, err = util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(2*slotsPerEpoch))
+	require.NoError(t, st.SetValidators([]*ethpb.Validator{vals[0], vals[2]}))
+	require.NoError(t, db.SaveState(ctx, st, roots[2*slotsPerEpoch]))
+
+	_, reclaimed, err := db.PruneHistory(ctx, 2*slotsPerEpoch, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, false, db.HasState(ctx, roots[slotsPerEpoch]))
+
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		valBkt := tx.Bucket(stateValidatorsBucket)
+		for i, kept := range []bool{true, false, true} {
+			hash, err := vals[i].HashTreeRoot()
+			require.NoError(t, err)
+			assert.Equal(t, kept, valBkt.Get(hash[:]) != nil, "unexpected validator entry %d", i)
+		}
+		return nil
+	}))
+
+	// Pruning again reclaims nothing, so only bytes actually deleted were reported the first time.
+	_, reclaimedAgain, err := db.PruneHistory(ctx, 2*slotsPerEpoch, 1000)
+	require.NoError(t, err)
+	assert.NotEqual(t, uint64(0), reclaimed)
+	assert.Equal(t, uint64(0), reclaimedAgain)
+}
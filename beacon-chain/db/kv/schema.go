@@ -18,6 +18,8 @@ var (
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
 	stateValidatorsBucket   = []byte("state-validators")
+	lightClientBucket       = []byte("light-client-updates")
+	peerReputationsBucket   = []byte("peer-reputations")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -50,6 +52,8 @@ var (
 	bellatrixKey = []byte("merge")
 	// block root included in the beacon state used by weak subjectivity initial sync
 	originBlockRootKey = []byte("origin-block-root")
+	// lowest block root of the chain backfilled below the origin block root
+	backfillBlockRootKey = []byte("backfill-block-root")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
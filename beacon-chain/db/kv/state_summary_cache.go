@@ -72,3 +72,10 @@ func (c *stateSummaryCache) clear() {
 	defer c.initSyncStateSummariesLock.Unlock()
 	c.initSyncStateSummaries = make(map[[32]byte]*ethpb.StateSummary)
 }
+
+// delete removes a state summary from the initial sync state summaries cache.
+func (c *stateSummaryCache) delete(r [32]byte) {
+	c.initSyncStateSummariesLock.Lock()
+	defer c.initSyncStateSummariesLock.Unlock()
+	delete(c.initSyncStateSummaries, r)
+}
@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStore_SaveOrigin(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	db := setupDB(t)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 4)
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+	}))
+	slot := params.BeaconConfig().SlotsPerEpoch * 2
+	require.NoError(t, st.SetSlot(slot))
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = slot
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+
+	originRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, headRoot)
+	assert.Equal(t, true, db.HasState(ctx, blockRoot))
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 2, Root: blockRoot[:]}, finalized)
+}
+
+func TestStore_SaveOrigin_InvalidState(t *testing.T) {
+	db := setupDB(t)
+	serBlock, err := util.NewBeaconBlockAltair().MarshalSSZ()
+	require.NoError(t, err)
+	err = db.SaveOrigin(context.Background(), []byte{1, 2, 3}, serBlock)
+	require.ErrorContains(t, "could not unmarshal checkpoint state", err)
+	_, err = db.OriginBlockRoot(context.Background())
+	require.ErrorIs(t, err, ErrNotFoundOriginBlockRoot)
+}
@@ -0,0 +1,21 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruner_blocks_total",
+		Help: "Count of finalized blocks pruned from the database.",
+	})
+	reclaimedBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pruner_reclaimed_bytes_total",
+		Help: "Size in bytes of the blocks, states and indices pruned from the database.",
+	})
+	pruneSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruner_prune_slot",
+		Help: "The slot below which finalized history was last pruned.",
+	})
+)
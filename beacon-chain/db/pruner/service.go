@@ -0,0 +1,160 @@
+// Package pruner caps the disk usage of a beacon node which does not need the full history of
+// the chain, by deleting finalized blocks and states older than a retention period from the
+// database in the background. The retention period is never shorter than the weak subjectivity
+// period, so the node can still serve the blocks its peers need to sync from a checkpoint.
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+const (
+	// batchSize is the number of blocks deleted in a single database transaction.
+	batchSize = 256
+	// batchInterval is how long the service waits between batches, so that pruning does not
+	// hold up other writes to the database.
+	batchInterval = 100 * time.Millisecond
+)
+
+// Config to set up the pruner service.
+type Config struct {
+	DB              db.NoHeadAccessDatabase
+	Chain           blockchain.ChainInfoFetcher
+	RetentionEpochs types.Epoch
+}
+
+// Service prunes finalized history from the database once per epoch.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService configures the pruner service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start the pruner service.
+func (s *Service) Start() {
+	log.WithField("retentionEpochs", s.cfg.RetentionEpochs).Info("Pruning finalized history from the database")
+	go s.run()
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// run prunes the database once per epoch until the service is stopped.
+func (s *Service) run() {
+	ticker := time.NewTicker(time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := s.prune(s.ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.WithError(err).Error("Could not prune finalized history")
+		}
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes finalized history below the prune slot in batches, and reports the space reclaimed.
+func (s *Service) prune(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "pruner.prune")
+	defer span.End()
+
+	beforeSlot, err := s.pruneSlot(ctx)
+	if err != nil {
+		return err
+	}
+	if beforeSlot == 0 {
+		return nil
+	}
+	var blocks int
+	var reclaimed uint64
+	for {
+		n, b, err := s.cfg.DB.PruneHistory(ctx, beforeSlot, batchSize)
+		if err != nil {
+			return errors.Wrap(err, "could not prune history")
+		}
+		blocks += n
+		reclaimed += b
+		prunedBlocks.Add(float64(n))
+		reclaimedBytes.Add(float64(b))
+		if n < batchSize {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(batchInterval):
+		}
+	}
+	pruneSlot.Set(float64(beforeSlot))
+	if blocks > 0 {
+		log.WithFields(logrus.Fields{
+			"slot":           beforeSlot,
+			"blocks":         blocks,
+			"reclaimedBytes": reclaimed,
+		}).Info("Pruned finalized history")
+	}
+	return nil
+}
+
+// pruneSlot returns the slot below which history is pruned: the start of the epoch which is the
+// retention period, or the weak subjectivity period if it is longer, before the finalized epoch.
+// It returns 0 if there is nothing to prune.
+func (s *Service) pruneSlot(ctx context.Context) (types.Slot, error) {
+	finalized := s.cfg.Chain.FinalizedCheckpt()
+	if finalized == nil {
+		return 0, nil
+	}
+	st, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get head state")
+	}
+	if st == nil || st.IsNil() {
+		return 0, nil
+	}
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute weak subjectivity period")
+	}
+	retention := s.cfg.RetentionEpochs
+	if wsPeriod > retention {
+		retention = wsPeriod
+	}
+	if finalized.Epoch <= retention {
+		return 0, nil
+	}
+	return slots.EpochStart(finalized.Epoch - retention)
+}
@@ -0,0 +1,110 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+func headState(t *testing.T) (state.BeaconState, types.Epoch) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsPeriod, err := helpers.ComputeWeakSubjectivityPeriod(context.Background(), st)
+	require.NoError(t, err)
+	return st, wsPeriod
+}
+
+func TestService_pruneSlot(t *testing.T) {
+	ctx := context.Background()
+	st, wsPeriod := headState(t)
+
+	tests := []struct {
+		name      string
+		finalized types.Epoch
+		retention types.Epoch
+		want      types.Epoch
+	}{
+		{name: "finalized within weak subjectivity period", finalized: wsPeriod, want: 0},
+		{name: "weak subjectivity period", finalized: wsPeriod + 10, retention: 5, want: 10},
+		{name: "finalized within retention period", finalized: wsPeriod + 10, retention: wsPeriod + 10, want: 0},
+		{name: "retention period", finalized: wsPeriod + 10, retention: wsPeriod + 5, want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService(ctx, &Config{
+				Chain: &mock.ChainService{
+					State:               st,
+					FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: tt.finalized},
+				},
+				RetentionEpochs: tt.retention,
+			})
+			got, err := s.pruneSlot(ctx)
+			require.NoError(t, err)
+			want, err := slots.EpochStart(tt.want)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestService_prune(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	st, wsPeriod := headState(t)
+	finalized := wsPeriod + 10
+	keptSlot, err := slots.EpochStart(10)
+	require.NoError(t, err)
+	finalizedSlot, err := slots.EpochStart(finalized)
+	require.NoError(t, err)
+
+	// Save a chain of blocks with states at genesis, at the prune slot and at the finalized checkpoint.
+	roots := make([][32]byte, 0)
+	parentRoot := make([]byte, 32)
+	for _, slot := range []types.Slot{0, 1, 2, keptSlot, finalizedSlot} {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parentRoot
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: r[:]}))
+		if slot == 0 {
+			require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, r))
+		}
+		if slot == 0 || slot == keptSlot || slot == finalizedSlot {
+			blockState, err := util.NewBeaconState()
+			require.NoError(t, err)
+			require.NoError(t, blockState.SetSlot(slot))
+			require.NoError(t, beaconDB.SaveState(ctx, blockState, r))
+		}
+		roots = append(roots, r)
+		parentRoot = r[:]
+	}
+	cp := &ethpb.Checkpoint{Epoch: finalized, Root: roots[4][:]}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, cp))
+
+	s := NewService(ctx, &Config{
+		DB: beaconDB,
+		Chain: &mock.ChainService{
+			State:               st,
+			FinalizedCheckPoint: cp,
+		},
+	})
+	require.NoError(t, s.prune(ctx))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[0]))
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[1]))
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[2]))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[3]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[3]))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[4]))
+}
@@ -0,0 +1,8 @@
+/*
+Package doublylinkedtree implements LMD GHOST fork choice on a tree of block nodes, where every
+node links to its parent and to its children. Weights are computed by recursively summing the
+balances voting for each subtree, and the proposer boost score is added to timely blocks as
+outlined in the consensus specs:
+https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/fork-choice.md
+*/
+package doublylinkedtree
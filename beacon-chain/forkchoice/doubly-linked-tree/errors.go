@@ -0,0 +1,10 @@
+package doublylinkedtree
+
+import "errors"
+
+var errUnknownFinalizedRoot = errors.New("unknown finalized root")
+var errUnknownJustifiedRoot = errors.New("unknown justified root")
+var errUnknownNodeRoot = errors.New("unknown block root")
+var errInvalidParentRoot = errors.New("block without a known parent can not be inserted in a non empty tree")
+var errInvalidOptimisticStatus = errors.New("invalid optimistic status")
+var errNoActiveValidators = errors.New("no active validators")
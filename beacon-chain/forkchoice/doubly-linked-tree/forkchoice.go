@@ -0,0 +1,280 @@
+package doublylinkedtree
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"go.opencensus.io/trace"
+)
+
+// This defines the minimal number of block nodes that can be in the tree
+// before getting pruned upon new finalization.
+const defaultPruneThreshold = 256
+
+// New initializes a new fork choice store.
+func New(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) *ForkChoice {
+	s := &Store{
+		justifiedEpoch: justifiedEpoch,
+		finalizedEpoch: finalizedEpoch,
+		finalizedRoot:  finalizedRoot,
+		nodeByRoot:     make(map[[32]byte]*Node),
+		canonicalNodes: make(map[[32]byte]bool),
+		pruneThreshold: defaultPruneThreshold,
+	}
+
+	b := make([]uint64, 0)
+	v := make([]Vote, 0)
+
+	return &ForkChoice{store: s, balances: b, votes: v}
+}
+
+// Head returns the head root from fork choice store.
+// It firsts updates the balances voting for each node and then recalculates the weights
+// and the best descendants of the tree from the leaves to the root.
+func (f *ForkChoice) Head(
+	ctx context.Context,
+	justifiedEpoch types.Epoch,
+	justifiedRoot [32]byte,
+	justifiedStateBalances []uint64,
+	finalizedEpoch types.Epoch,
+) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.Head")
+	defer span.End()
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
+	calledHeadCount.Inc()
+
+	f.store.nodesLock.Lock()
+	defer f.store.nodesLock.Unlock()
+
+	f.updateBalances(justifiedStateBalances)
+
+	if err := f.store.applyProposerBoostScore(justifiedStateBalances); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not apply proposer boost score")
+	}
+
+	f.store.justifiedEpoch = justifiedEpoch
+	f.store.finalizedEpoch = finalizedEpoch
+
+	if f.store.treeRootNode == nil {
+		return [32]byte{}, errUnknownJustifiedRoot
+	}
+	if err := f.store.treeRootNode.applyWeightChanges(ctx); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not apply weight changes")
+	}
+	if err := f.store.treeRootNode.updateBestDescendant(ctx, justifiedEpoch, finalizedEpoch); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not update best descendant")
+	}
+
+	return f.store.head(ctx, justifiedRoot)
+}
+
+// updateBalances moves the balance of every validator whose vote or balance has changed
+// from the node it previously voted for to the node it currently votes for.
+func (f *ForkChoice) updateBalances(newBalances []uint64) {
+	for index, vote := range f.votes {
+		// Skip if validator has never voted for current root and next root (ie. if the
+		// votes are zero hash aka genesis block), there's nothing to compute.
+		if vote.currentRoot == params.BeaconConfig().ZeroHash && vote.nextRoot == params.BeaconConfig().ZeroHash {
+			continue
+		}
+
+		// If the validator index did not exist in the old or new balances, the balance is just 0.
+		oldBalance := uint64(0)
+		newBalance := uint64(0)
+		if index < len(f.balances) {
+			oldBalance = f.balances[index]
+		}
+		if index < len(newBalances) {
+			newBalance = newBalances[index]
+		}
+
+		// Update the balances only if the validator's balance or vote has changed.
+		// Votes for nodes which are not in the store are ignored.
+		if vote.currentRoot != vote.nextRoot || oldBalance != newBalance {
+			if nextNode, ok := f.store.nodeByRoot[vote.nextRoot]; ok && nextNode != nil {
+				nextNode.balance += newBalance
+			}
+			if currentNode, ok := f.store.nodeByRoot[vote.currentRoot]; ok && currentNode != nil {
+				// A node's balance can not be negative.
+				if currentNode.balance < oldBalance {
+					currentNode.balance = 0
+				} else {
+					currentNode.balance -= oldBalance
+				}
+			}
+		}
+
+		// Rotate the validator vote.
+		f.votes[index].currentRoot = vote.nextRoot
+	}
+	f.balances = newBalances
+}
+
+// ProcessAttestation processes attestation for vote accounting, it iterates around validator indices
+// and update their votes accordingly.
+func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []uint64, blockRoot [32]byte, targetEpoch types.Epoch) {
+	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.ProcessAttestation")
+	defer span.End()
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
+	for _, index := range validatorIndices {
+		// Validator indices will grow the vote cache.
+		for index >= uint64(len(f.votes)) {
+			f.votes = append(f.votes, Vote{currentRoot: params.BeaconConfig().ZeroHash, nextRoot: params.BeaconConfig().ZeroHash})
+		}
+
+		// Newly allocated vote if the root fields are untouched.
+		newVote := f.votes[index].nextRoot == params.BeaconConfig().ZeroHash &&
+			f.votes[index].currentRoot == params.BeaconConfig().ZeroHash
+
+		// Vote gets updated if it's newly allocated or high target epoch.
+		if newVote || targetEpoch > f.votes[index].nextEpoch {
+			f.votes[index].nextEpoch = targetEpoch
+			f.votes[index].nextRoot = blockRoot
+		}
+	}
+
+	processedAttestationCount.Inc()
+}
+
+// ProcessBlock processes a new block by inserting it to the fork choice store.
+func (f *ForkChoice) ProcessBlock(
+	ctx context.Context,
+	slot types.Slot,
+	blockRoot, parentRoot, graffiti [32]byte,
+	justifiedEpoch, finalizedEpoch types.Epoch,
+) error {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.ProcessBlock")
+	defer span.End()
+
+	return f.store.insert(ctx, slot, blockRoot, parentRoot, graffiti, justifiedEpoch, finalizedEpoch)
+}
+
+// Prune prunes the fork choice store with the new finalized root. The store is only pruned if the number
+// of the nodes which do not descend from the finalized root has met the prune threshold.
+func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
+	return f.store.prune(ctx, finalizedRoot)
+}
+
+// ForkChoiceNodes returns a snapshot of the block nodes in the fork choice store. Every node
+// comes after its parent in the returned list.
+func (f *ForkChoice) ForkChoiceNodes() []*forkchoicetypes.Node {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	nodes := make([]*forkchoicetypes.Node, 0, len(f.store.nodeByRoot))
+	if f.store.treeRootNode == nil {
+		return nodes
+	}
+	// Walk the tree breadth first so that parents come before their children.
+	queue := []*Node{f.store.treeRootNode}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = append(queue[1:], n.children...)
+
+		node := &forkchoicetypes.Node{
+			Slot:           n.slot,
+			Root:           n.root,
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.weight,
+			Graffiti:       n.graffiti,
+			Optimistic:     n.status == syncing,
+		}
+		if n.parent != nil {
+			node.ParentRoot = n.parent.root
+		}
+		if bestChild := n.bestChild(); bestChild != nil {
+			node.BestChild = bestChild.root
+		}
+		if n.bestDescendant != nil {
+			node.BestDescendant = n.bestDescendant.root
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// NodeCount returns the number of block nodes in the fork choice store.
+func (f *ForkChoice) NodeCount() int {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return len(f.store.nodeByRoot)
+}
+
+// JustifiedEpoch returns the justified epoch of the fork choice store.
+func (f *ForkChoice) JustifiedEpoch() types.Epoch {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.justifiedEpoch
+}
+
+// FinalizedEpoch returns the finalized epoch of the fork choice store.
+func (f *ForkChoice) FinalizedEpoch() types.Epoch {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.finalizedEpoch
+}
+
+// HasNode returns true if the node exists in fork choice store,
+// false else wise.
+func (f *ForkChoice) HasNode(root [32]byte) bool {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	_, ok := f.store.nodeByRoot[root]
+	return ok
+}
+
+// HasParent returns true if the node parent exists in fork choice store,
+// false else wise.
+func (f *ForkChoice) HasParent(root [32]byte) bool {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return false
+	}
+	return node.parent != nil
+}
+
+// IsCanonical returns true if the given root is part of the canonical chain.
+func (f *ForkChoice) IsCanonical(root [32]byte) bool {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	return f.store.canonicalNodes[root]
+}
+
+// AncestorRoot returns the ancestor root of input block root at a given slot.
+func (f *ForkChoice) AncestorRoot(ctx context.Context, root [32]byte, slot types.Slot) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.AncestorRoot")
+	defer span.End()
+
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return nil, errors.New("node does not exist")
+	}
+
+	for node.slot > slot {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		node = node.parent
+		if node == nil {
+			return nil, errors.New("ancestor is not in fork choice store")
+		}
+	}
+
+	return node.root[:], nil
+}
@@ -0,0 +1,158 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// setupForkChoice builds the following tree:
+//
+//	  0
+//	  |
+//	  1
+//	 / \
+//	2   3
+//	|
+//	4
+func setupForkChoice(t *testing.T) *ForkChoice {
+	ctx := context.Background()
+	f := New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, f.ProcessBlock(ctx, 100, indexToHash(0), params.BeaconConfig().ZeroHash, [32]byte{'g'}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 101, indexToHash(1), indexToHash(0), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 102, indexToHash(2), indexToHash(1), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 102, indexToHash(3), indexToHash(1), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 103, indexToHash(4), indexToHash(2), [32]byte{}, 0, 0))
+	return f
+}
+
+func TestForkChoice_ProcessBlock(t *testing.T) {
+	ctx := context.Background()
+	f := setupForkChoice(t)
+	assert.Equal(t, 5, f.NodeCount())
+
+	// Inserting a known block is a no-op.
+	require.NoError(t, f.ProcessBlock(ctx, 101, indexToHash(1), indexToHash(0), [32]byte{}, 0, 0))
+	assert.Equal(t, 5, f.NodeCount())
+
+	// Only the first block can be inserted without its parent.
+	err := f.ProcessBlock(ctx, 104, indexToHash(5), indexToHash(100), [32]byte{}, 0, 0)
+	require.ErrorIs(t, err, errInvalidParentRoot)
+	assert.Equal(t, false, f.HasNode(indexToHash(5)))
+}
+
+func TestForkChoice_ProcessBlock_UpdatesBestDescendants(t *testing.T) {
+	ctx := context.Background()
+	f := setupForkChoice(t)
+	require.NoError(t, f.ProcessBlock(ctx, 103, indexToHash(5), indexToHash(3), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 104, indexToHash(6), indexToHash(4), [32]byte{}, 0, 0))
+	assert.Equal(t, f.store.nodeByRoot[indexToHash(5)], f.store.nodeByRoot[indexToHash(3)].bestDescendant)
+	assert.Equal(t, f.store.nodeByRoot[indexToHash(6)], f.store.nodeByRoot[indexToHash(2)].bestDescendant)
+
+	// Updating the best descendants of the ancestors on insertion gives the same
+	// result as updating the whole tree.
+	bestDescendants := make(map[[32]byte]*Node)
+	for root, n := range f.store.nodeByRoot {
+		bestDescendants[root] = n.bestDescendant
+	}
+	require.NoError(t, f.store.treeRootNode.updateBestDescendant(ctx, 0, 0))
+	for root, n := range f.store.nodeByRoot {
+		assert.Equal(t, bestDescendants[root], n.bestDescendant, "Unexpected best descendant of %#x", root)
+	}
+}
+
+func TestForkChoice_HasNode_HasParent(t *testing.T) {
+	f := setupForkChoice(t)
+	assert.Equal(t, true, f.HasNode(indexToHash(0)))
+	assert.Equal(t, false, f.HasParent(indexToHash(0)))
+	assert.Equal(t, true, f.HasParent(indexToHash(4)))
+	assert.Equal(t, false, f.HasNode(indexToHash(5)))
+	assert.Equal(t, false, f.HasParent(indexToHash(5)))
+}
+
+func TestForkChoice_AncestorRoot(t *testing.T) {
+	ctx := context.Background()
+	f := setupForkChoice(t)
+
+	r, err := f.AncestorRoot(ctx, indexToHash(4), 101)
+	require.NoError(t, err)
+	want := indexToHash(1)
+	assert.DeepEqual(t, want[:], r)
+
+	r, err = f.AncestorRoot(ctx, indexToHash(4), 102)
+	require.NoError(t, err)
+	want = indexToHash(2)
+	assert.DeepEqual(t, want[:], r)
+
+	_, err = f.AncestorRoot(ctx, indexToHash(4), 99)
+	require.ErrorContains(t, "ancestor is not in fork choice store", err)
+	_, err = f.AncestorRoot(ctx, indexToHash(5), 99)
+	require.ErrorContains(t, "node does not exist", err)
+}
+
+func TestForkChoice_IsCanonical(t *testing.T) {
+	ctx := context.Background()
+	f := setupForkChoice(t)
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(3), 0)
+	r, err := f.Head(ctx, 0, indexToHash(0), []uint64{1}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r)
+
+	for i, want := range []bool{true, true, false, true, false} {
+		assert.Equal(t, want, f.IsCanonical(indexToHash(uint64(i))), "Unexpected canonical status for node %d", i)
+	}
+}
+
+func TestForkChoice_ForkChoiceNodes(t *testing.T) {
+	ctx := context.Background()
+	f := setupForkChoice(t)
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(4), 0)
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(1)))
+	_, err := f.Head(ctx, 0, indexToHash(0), []uint64{10}, 0)
+	require.NoError(t, err)
+
+	nodes := f.ForkChoiceNodes()
+	require.Equal(t, 5, len(nodes))
+	assert.DeepEqual(t, &forkchoicetypes.Node{
+		Slot:           100,
+		Root:           indexToHash(0),
+		Weight:         10,
+		BestChild:      indexToHash(1),
+		BestDescendant: indexToHash(4),
+		Graffiti:       [32]byte{'g'},
+	}, nodes[0])
+	assert.DeepEqual(t, &forkchoicetypes.Node{
+		Slot:           102,
+		Root:           indexToHash(2),
+		ParentRoot:     indexToHash(1),
+		Weight:         10,
+		BestChild:      indexToHash(4),
+		BestDescendant: indexToHash(4),
+		Optimistic:     true,
+	}, nodes[2])
+	assert.DeepEqual(t, &forkchoicetypes.Node{
+		Slot:       103,
+		Root:       indexToHash(4),
+		ParentRoot: indexToHash(2),
+		Weight:     10,
+		Optimistic: true,
+	}, nodes[4])
+}
+
+func TestForkChoice_Prune(t *testing.T) {
+	ctx := context.Background()
+	f := setupForkChoice(t)
+	f.store.pruneThreshold = 0
+
+	require.ErrorIs(t, f.Prune(ctx, indexToHash(5)), errUnknownFinalizedRoot)
+
+	require.NoError(t, f.Prune(ctx, indexToHash(2)))
+	assert.Equal(t, 2, f.NodeCount())
+	assert.Equal(t, indexToHash(2), f.store.treeRootNode.root)
+	assert.Equal(t, false, f.HasParent(indexToHash(2)))
+	assert.Equal(t, false, f.HasNode(indexToHash(3)))
+}
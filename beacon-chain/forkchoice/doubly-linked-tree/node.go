@@ -0,0 +1,109 @@
+package doublylinkedtree
+
+import (
+	"bytes"
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+)
+
+// applyWeightChanges recursively sums the balances of the descendants of the node
+// into its weight, after doing the same for each of its children.
+func (n *Node) applyWeightChanges(ctx context.Context) error {
+	childrenWeight := uint64(0)
+	for _, child := range n.children {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := child.applyWeightChanges(ctx); err != nil {
+			return err
+		}
+		childrenWeight += child.weight
+	}
+	// There is no need to adjust the weight of the zero hash, it is an alias to the genesis block.
+	if n.root == params.BeaconConfig().ZeroHash {
+		return nil
+	}
+	n.weight = n.balance + childrenWeight
+	return nil
+}
+
+// updateBestDescendant recursively updates the best descendant of the node and of all of its
+// descendants.
+func (n *Node) updateBestDescendant(ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	for _, child := range n.children {
+		if err := child.updateBestDescendant(ctx, justifiedEpoch, finalizedEpoch); err != nil {
+			return err
+		}
+	}
+	n.updateBestDescendantFromChildren(justifiedEpoch, finalizedEpoch)
+	return nil
+}
+
+// updateBestDescendantFromChildren updates the best descendant of the node from its children,
+// whose best descendants must be up to date. It returns true if the best descendant changed.
+// The best child is the heaviest child leading to a viable head, ties are broken by the highest
+// root. The best descendant is the best descendant of the best child, or the best child itself
+// if it is a leaf.
+func (n *Node) updateBestDescendantFromChildren(justifiedEpoch, finalizedEpoch types.Epoch) bool {
+	var bestChild *Node
+	for _, child := range n.children {
+		if !child.leadsToViableHead(justifiedEpoch, finalizedEpoch) {
+			continue
+		}
+		if bestChild == nil || child.weight > bestChild.weight ||
+			(child.weight == bestChild.weight && bytes.Compare(child.root[:], bestChild.root[:]) > 0) {
+			bestChild = child
+		}
+	}
+
+	previous := n.bestDescendant
+	switch {
+	case bestChild == nil:
+		n.bestDescendant = nil
+	case bestChild.bestDescendant == nil:
+		n.bestDescendant = bestChild
+	default:
+		n.bestDescendant = bestChild.bestDescendant
+	}
+	return n.bestDescendant != previous
+}
+
+// bestChild returns the child of the node which leads to its best descendant, or nil if there is none.
+func (n *Node) bestChild() *Node {
+	if n.bestDescendant == nil {
+		return nil
+	}
+	for _, child := range n.children {
+		if child == n.bestDescendant || child.bestDescendant == n.bestDescendant {
+			return child
+		}
+	}
+	return nil
+}
+
+// viableForHead returns true if the node is viable to head.
+// Any node with diff finalized or justified epoch than the ones in fork choice store
+// should not be viable to head. A node with an invalid execution payload is never viable.
+func (n *Node) viableForHead(justifiedEpoch, finalizedEpoch types.Epoch) bool {
+	if n.status == invalid {
+		return false
+	}
+	// `node` is viable if its justified epoch and finalized epoch are the same as the one in `Store`.
+	// It's also viable if we are in genesis epoch.
+	justified := justifiedEpoch == n.justifiedEpoch || justifiedEpoch == 0
+	finalized := finalizedEpoch == n.finalizedEpoch || finalizedEpoch == 0
+
+	return justified && finalized
+}
+
+// leadsToViableHead returns true if the node or the best descendent of the node is viable for head.
+// The best descendant of a node is only set if it is viable, so this must be called after the
+// best descendant has been updated.
+func (n *Node) leadsToViableHead(justifiedEpoch, finalizedEpoch types.Epoch) bool {
+	return n.bestDescendant != nil || n.viableForHead(justifiedEpoch, finalizedEpoch)
+}
@@ -0,0 +1,92 @@
+package doublylinkedtree
+
+import (
+	"context"
+
+	"go.opencensus.io/trace"
+)
+
+// IsOptimistic returns true if the block of the given root has been imported
+// optimistically, meaning its execution payload has not been validated by the
+// execution engine yet.
+func (f *ForkChoice) IsOptimistic(_ context.Context, root [32]byte) (bool, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return false, errUnknownNodeRoot
+	}
+	return node.status == syncing, nil
+}
+
+// SetOptimisticToValid marks the block of the given root as fully validated by the
+// execution engine. A valid payload implies that the payloads of all its ancestors
+// are valid as well, so every optimistic ancestor is marked as valid too.
+func (f *ForkChoice) SetOptimisticToValid(ctx context.Context, root [32]byte) error {
+	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.SetOptimisticToValid")
+	defer span.End()
+
+	f.store.nodesLock.Lock()
+	defer f.store.nodesLock.Unlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return errUnknownNodeRoot
+	}
+
+	for ; node != nil; node = node.parent {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		switch node.status {
+		case valid:
+			// Every ancestor of a valid node has already been marked as valid.
+			return nil
+		case invalid:
+			return errInvalidOptimisticStatus
+		}
+		node.status = valid
+	}
+	return nil
+}
+
+// SetOptimisticToInvalid marks the block of the given root and all of its descendants as
+// having an invalid execution payload. These blocks are no longer viable for head.
+// It returns the roots of all the blocks which have been invalidated.
+func (f *ForkChoice) SetOptimisticToInvalid(ctx context.Context, root [32]byte) ([][32]byte, error) {
+	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.SetOptimisticToInvalid")
+	defer span.End()
+
+	f.store.nodesLock.Lock()
+	defer f.store.nodesLock.Unlock()
+
+	node, ok := f.store.nodeByRoot[root]
+	if !ok || node == nil {
+		return nil, errUnknownNodeRoot
+	}
+
+	// Collect the subtree first, so that nothing is modified if one of the
+	// descendants has already been validated.
+	invalidated := make([]*Node, 0)
+	queue := []*Node{node}
+	for len(queue) > 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		n := queue[0]
+		queue = append(queue[1:], n.children...)
+		if n.status == valid {
+			// A fully validated payload can not become invalid.
+			return nil, errInvalidOptimisticStatus
+		}
+		invalidated = append(invalidated, n)
+	}
+
+	invalidRoots := make([][32]byte, len(invalidated))
+	for i, n := range invalidated {
+		n.status = invalid
+		invalidRoots[i] = n.root
+	}
+	return invalidRoots, nil
+}
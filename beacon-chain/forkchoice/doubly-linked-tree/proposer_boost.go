@@ -0,0 +1,127 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// BoostProposerRoot sets the block root which should be boosted during
+// the LMD fork choice algorithm calculations. This is meant to reward timely,
+// proposed blocks which occur before a cutoff interval set to
+// SECONDS_PER_SLOT // INTERVALS_PER_SLOT.
+//
+// Spec code:
+//  time_into_slot = (store.time - store.genesis_time) % SECONDS_PER_SLOT
+//  is_before_attesting_interval = time_into_slot < SECONDS_PER_SLOT // INTERVALS_PER_SLOT
+//  if get_current_slot(store) == block.slot and is_before_attesting_interval:
+//      store.proposer_boost_root = hash_tree_root(block)
+func (f *ForkChoice) BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime time.Time) error {
+	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.BoostProposerRoot")
+	defer span.End()
+
+	if isTimely(blockSlot, genesisTime) {
+		f.store.proposerBoostLock.Lock()
+		f.store.proposerBoostRoot = blockRoot
+		f.store.proposerBoostLock.Unlock()
+	}
+	return nil
+}
+
+// ResetBoostedProposerRoot sets the value of the proposer boosted root to zeros.
+//
+// Spec code:
+//  # Reset store.proposer_boost_root if this is a new slot
+//  if current_slot > previous_slot:
+//      store.proposer_boost_root = Root()
+func (f *ForkChoice) ResetBoostedProposerRoot(_ context.Context) error {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = [32]byte{}
+	return nil
+}
+
+// ProposerBoost returns the proposer boosted root of the fork choice store.
+func (f *ForkChoice) ProposerBoost() [32]byte {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	return f.store.proposerBoostRoot
+}
+
+// applyProposerBoostScore moves the proposer boost score from the previously boosted node to
+// the currently boosted one. The score is part of the node's balance, so it is summed into the
+// weights of all of its ancestors.
+func (s *Store) applyProposerBoostScore(newBalances []uint64) error {
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+
+	proposerScore := uint64(0)
+	if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		// The previously boosted node may have been pruned since.
+		if previousNode, ok := s.nodeByRoot[s.previousProposerBoostRoot]; ok && previousNode != nil {
+			if previousNode.balance < s.previousProposerBoostScore {
+				previousNode.balance = 0
+			} else {
+				previousNode.balance -= s.previousProposerBoostScore
+			}
+		}
+	}
+	if s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if currentNode, ok := s.nodeByRoot[s.proposerBoostRoot]; ok && currentNode != nil {
+			score, err := computeProposerBoostScore(newBalances)
+			if err != nil {
+				return err
+			}
+			proposerScore = score
+			currentNode.balance += proposerScore
+		}
+	}
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = proposerScore
+	return nil
+}
+
+// isTimely returns true if the current time is in the given slot, before its attesting interval.
+func isTimely(blockSlot types.Slot, genesisTime time.Time) bool {
+	now := prysmTime.Now()
+	if now.Before(genesisTime) {
+		return false
+	}
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	timeIntoSlot := uint64(now.Unix()-genesisTime.Unix()) % secondsPerSlot
+	isBeforeAttestingInterval := timeIntoSlot < secondsPerSlot/params.BeaconConfig().IntervalsPerSlot
+	return slots.SinceGenesis(genesisTime) == blockSlot && isBeforeAttestingInterval
+}
+
+// computeProposerBoostScore computes the weight of the proposer boost from the balances of the
+// justified state, in which inactive validators have a zero balance.
+//
+// Spec code:
+//  num_validators = len(get_active_validator_indices(state, get_current_epoch(state)))
+//  avg_balance = get_total_active_balance(state) // num_validators
+//  committee_size = num_validators // SLOTS_PER_EPOCH
+//  committee_weight = committee_size * avg_balance
+//  proposer_score = (committee_weight * PROPOSER_SCORE_BOOST) // 100
+func computeProposerBoostScore(validatorBalances []uint64) (uint64, error) {
+	totalActiveBalance := uint64(0)
+	numActive := uint64(0)
+	for _, balance := range validatorBalances {
+		if balance == 0 {
+			continue
+		}
+		totalActiveBalance += balance
+		numActive++
+	}
+	if numActive == 0 {
+		return 0, errNoActiveValidators
+	}
+	avgBalance := totalActiveBalance / numActive
+	committeeSize := numActive / uint64(params.BeaconConfig().SlotsPerEpoch)
+	committeeWeight := committeeSize * avgBalance
+	return (committeeWeight * params.BeaconConfig().ProposerScoreBoost) / 100, nil
+}
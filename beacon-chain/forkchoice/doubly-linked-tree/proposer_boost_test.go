@@ -0,0 +1,100 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestForkChoice_BoostProposerRoot(t *testing.T) {
+	ctx := context.Background()
+	secondsPerSlot := int64(params.BeaconConfig().SecondsPerSlot)
+	root := [32]byte{'a'}
+
+	t.Run("does not boost a block from a previous slot", func(t *testing.T) {
+		f := setup(0, 0)
+		genesis := time.Unix(time.Now().Unix()-2*secondsPerSlot, 0)
+		require.NoError(t, f.BoostProposerRoot(ctx, 1, root, genesis))
+		assert.Equal(t, [32]byte{}, f.ProposerBoost())
+	})
+	t.Run("does not boost a block received after the attesting interval", func(t *testing.T) {
+		f := setup(0, 0)
+		genesis := time.Unix(time.Now().Unix()-secondsPerSlot-secondsPerSlot/2, 0)
+		require.NoError(t, f.BoostProposerRoot(ctx, 1, root, genesis))
+		assert.Equal(t, [32]byte{}, f.ProposerBoost())
+	})
+	t.Run("boosts a timely block", func(t *testing.T) {
+		f := setup(0, 0)
+		genesis := time.Unix(time.Now().Unix()-secondsPerSlot, 0)
+		require.NoError(t, f.BoostProposerRoot(ctx, 1, root, genesis))
+		assert.Equal(t, root, f.ProposerBoost())
+		require.NoError(t, f.ResetBoostedProposerRoot(ctx))
+		assert.Equal(t, [32]byte{}, f.ProposerBoost())
+	})
+}
+
+func TestForkChoice_ProposerBoostChangesHead(t *testing.T) {
+	ctx := context.Background()
+	// With one validator having half the balance of the others, the proposer
+	// score outweighs its vote: 2 validators per committee with an average
+	// balance of 9 give a score of 18 * 40 / 100 = 7.
+	balances := make([]uint64, 2*params.BeaconConfig().SlotsPerEpoch)
+	for i := range balances {
+		balances[i] = 10
+	}
+	balances[0] = 5
+	f := New(0, 0, params.BeaconConfig().ZeroHash)
+
+	//     0
+	//    / \
+	//   1   2
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(0), params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), indexToHash(0), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), indexToHash(0), [32]byte{}, 0, 0))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 0)
+	r, err := f.Head(ctx, 0, indexToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head without proposer boost")
+
+	// Boosting block 2 outweighs the vote for block 1.
+	genesis := time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0)
+	require.NoError(t, f.BoostProposerRoot(ctx, 1, indexToHash(2), genesis))
+	r, err = f.Head(ctx, 0, indexToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with proposer boost")
+	assert.Equal(t, uint64(7), f.store.nodeByRoot[indexToHash(2)].weight)
+	assert.Equal(t, uint64(12), f.store.treeRootNode.weight)
+
+	// Computing head again does not boost the block twice.
+	r, err = f.Head(ctx, 0, indexToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with proposer boost")
+	assert.Equal(t, uint64(7), f.store.nodeByRoot[indexToHash(2)].weight)
+
+	// The boost is removed at the next slot.
+	require.NoError(t, f.ResetBoostedProposerRoot(ctx))
+	r, err = f.Head(ctx, 0, indexToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after proposer boost reset")
+	assert.Equal(t, uint64(0), f.store.nodeByRoot[indexToHash(2)].weight)
+	assert.Equal(t, uint64(5), f.store.treeRootNode.weight)
+}
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	_, err := computeProposerBoostScore([]uint64{0, 0})
+	require.ErrorIs(t, err, errNoActiveValidators)
+
+	balances := make([]uint64, 4*params.BeaconConfig().SlotsPerEpoch)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	// Inactive validators are ignored.
+	balances = append(balances, 0, 0)
+	score, err := computeProposerBoostScore(balances)
+	require.NoError(t, err)
+	assert.Equal(t, 4*params.BeaconConfig().MaxEffectiveBalance*params.BeaconConfig().ProposerScoreBoost/100, score)
+}
@@ -0,0 +1,192 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"go.opencensus.io/trace"
+)
+
+// head starts from justified root and then follows the best descendant links
+// to find the best block for head. The best descendants must have been updated
+// beforehand.
+func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.head")
+	defer span.End()
+
+	justifiedNode, ok := s.nodeByRoot[justifiedRoot]
+	if !ok || justifiedNode == nil {
+		return [32]byte{}, errUnknownJustifiedRoot
+	}
+
+	// If the justified node doesn't have a best descendant,
+	// the best node is itself.
+	bestDescendant := justifiedNode.bestDescendant
+	if bestDescendant == nil {
+		bestDescendant = justifiedNode
+	}
+
+	if !bestDescendant.viableForHead(s.justifiedEpoch, s.finalizedEpoch) {
+		return [32]byte{}, fmt.Errorf("head at slot %d with weight %d is not eligible, finalizedEpoch %d != %d, justifiedEpoch %d != %d",
+			bestDescendant.slot, bestDescendant.weight/10e9, bestDescendant.finalizedEpoch, s.finalizedEpoch, bestDescendant.justifiedEpoch, s.justifiedEpoch)
+	}
+
+	// Update metrics.
+	if s.headNode != bestDescendant {
+		headChangesCount.Inc()
+		headSlotNumber.Set(float64(bestDescendant.slot))
+		s.headNode = bestDescendant
+	}
+
+	// Update canonical mapping given the head root.
+	if err := s.updateCanonicalNodes(ctx, bestDescendant); err != nil {
+		return [32]byte{}, err
+	}
+
+	return bestDescendant.root, nil
+}
+
+// updateCanonicalNodes updates the canonical nodes mapping given the input head node.
+func (s *Store) updateCanonicalNodes(ctx context.Context, head *Node) error {
+	_, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.updateCanonicalNodes")
+	defer span.End()
+
+	// Set the input node to canonical.
+	s.canonicalNodes[head.root] = true
+
+	for n := head.parent; n != nil; n = n.parent {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// If the node is already in canonical mapping, we can be sure
+		// rest of the ancestors are canonical. Exit early.
+		if s.canonicalNodes[n.root] {
+			break
+		}
+		s.canonicalNodes[n.root] = true
+	}
+	return nil
+}
+
+// insert registers a new block node to the fork choice store's tree.
+// It then updates the best descendants of the ancestors of the node.
+func (s *Store) insert(ctx context.Context,
+	slot types.Slot,
+	root, parentRoot, graffiti [32]byte,
+	justifiedEpoch, finalizedEpoch types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.insert")
+	defer span.End()
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+
+	// Return if the block has been inserted into Store before.
+	if _, ok := s.nodeByRoot[root]; ok {
+		return nil
+	}
+
+	parent := s.nodeByRoot[parentRoot]
+	// A descendant of a block with an invalid execution payload is invalid as well.
+	nodeStatus := syncing
+	if parent != nil && parent.status == invalid {
+		nodeStatus = invalid
+	}
+
+	n := &Node{
+		slot:           slot,
+		root:           root,
+		graffiti:       graffiti,
+		parent:         parent,
+		justifiedEpoch: justifiedEpoch,
+		finalizedEpoch: finalizedEpoch,
+		status:         nodeStatus,
+	}
+
+	if parent == nil {
+		// Only the first block of the tree may be inserted without its parent.
+		if s.treeRootNode != nil {
+			return errInvalidParentRoot
+		}
+		s.treeRootNode = n
+		s.headNode = n
+	} else {
+		parent.children = append(parent.children, n)
+	}
+	s.nodeByRoot[root] = n
+
+	// The new node has no weight, so only its ancestors may have a new best descendant,
+	// and the walk stops at the first ancestor whose best descendant is unchanged.
+	for p := n.parent; p != nil; p = p.parent {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !p.updateBestDescendantFromChildren(s.justifiedEpoch, s.finalizedEpoch) {
+			break
+		}
+	}
+
+	// Update metrics.
+	processedBlockCount.Inc()
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+
+	return nil
+}
+
+// prune prunes the store with the new finalized root. Every node which does not descend from
+// the finalized node is removed, making the finalized node the new root of the tree. The tree
+// is only pruned if the number of nodes to remove has met the prune threshold.
+func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "doublyLinkedForkchoice.prune")
+	defer span.End()
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+
+	// The node would have seen finalized root or else it'd
+	// be able to prune it.
+	finalizedNode, ok := s.nodeByRoot[finalizedRoot]
+	if !ok || finalizedNode == nil {
+		return errUnknownFinalizedRoot
+	}
+
+	kept := make(map[[32]byte]*Node)
+	if err := collectSubtree(ctx, finalizedNode, kept); err != nil {
+		return err
+	}
+
+	// The number of the nodes to remove has not met the prune threshold.
+	// Pruning at small numbers incurs more cost than benefit.
+	if uint64(len(s.nodeByRoot)-len(kept)) < s.pruneThreshold {
+		return nil
+	}
+
+	for root := range s.nodeByRoot {
+		if _, ok := kept[root]; !ok {
+			delete(s.canonicalNodes, root)
+		}
+	}
+	s.nodeByRoot = kept
+	finalizedNode.parent = nil
+	s.treeRootNode = finalizedNode
+	s.finalizedRoot = finalizedRoot
+
+	prunedCount.Inc()
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+
+	return nil
+}
+
+// collectSubtree adds the given node and all of its descendants to the nodes mapping.
+func collectSubtree(ctx context.Context, n *Node, nodes map[[32]byte]*Node) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	nodes[n.root] = n
+	for _, child := range n.children {
+		if err := collectSubtree(ctx, child, nodes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
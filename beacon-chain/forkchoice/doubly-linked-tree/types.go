@@ -0,0 +1,65 @@
+package doublylinkedtree
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// ForkChoice defines the overall fork choice store which includes all block nodes, validator's latest votes and balances.
+type ForkChoice struct {
+	store     *Store
+	votes     []Vote // tracks individual validator's last vote.
+	votesLock sync.RWMutex
+	balances  []uint64 // tracks individual validator's last justified balances.
+}
+
+// Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
+type Store struct {
+	pruneThreshold uint64             // do not prune tree unless threshold is reached.
+	justifiedEpoch types.Epoch        // latest justified epoch in store.
+	finalizedEpoch types.Epoch        // latest finalized epoch in store.
+	finalizedRoot  [32]byte           // latest finalized root in store.
+	treeRootNode   *Node              // the root node of the store tree.
+	headNode       *Node              // last head node.
+	nodeByRoot     map[[32]byte]*Node // nodes indexed by roots.
+	canonicalNodes map[[32]byte]bool  // the canonical block nodes.
+	nodesLock      sync.RWMutex
+
+	proposerBoostRoot          [32]byte // latest block root that was boosted after being received in a timely manner.
+	previousProposerBoostRoot  [32]byte // previous block root that was boosted after being received in a timely manner.
+	previousProposerBoostScore uint64   // previous proposer boosted root score.
+	proposerBoostLock          sync.Mutex
+}
+
+// Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
+// This is used as a doubly linked tree for fork choice look up.
+type Node struct {
+	slot           types.Slot  // slot of the block converted to the node.
+	root           [32]byte    // root of the block converted to the node.
+	parent         *Node       // parent node of this node, nil for the tree root.
+	children       []*Node     // the list of direct children of this node.
+	justifiedEpoch types.Epoch // justifiedEpoch of this node.
+	finalizedEpoch types.Epoch // finalizedEpoch of this node.
+	balance        uint64      // the balance that voted for this node directly, including the proposer boost.
+	weight         uint64      // weight of this node, the balance of this node and all of its descendants.
+	bestDescendant *Node       // bestDescendant node of this node, nil if there is no viable descendant.
+	graffiti       [32]byte    // graffiti of the block node.
+	status         status      // optimistic status of this node.
+}
+
+// status defines the execution payload validity status of a node.
+type status uint8
+
+const (
+	syncing status = iota // the node's execution payload has not been validated by the execution engine yet.
+	valid                 // the node's execution payload has been validated, or the node is pre-merge.
+	invalid               // the node's execution payload has been declared invalid by the execution engine.
+)
+
+// Vote defines an individual validator's vote.
+type Vote struct {
+	currentRoot [32]byte    // current voting root.
+	nextRoot    [32]byte    // next voting root.
+	nextEpoch   types.Epoch // epoch of next voting period.
+}
@@ -0,0 +1,17 @@
+package forkchoice
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/config/features"
+)
+
+// New initializes a new fork choice store with the backend selected by the feature flags,
+// the doubly linked tree if it is enabled and the proto array otherwise.
+func New(justifiedEpoch, finalizedEpoch types.Epoch, finalizedRoot [32]byte) ForkChoicer {
+	if features.Get().EnableForkChoiceDoublyLinkedTree {
+		return doublylinkedtree.New(justifiedEpoch, finalizedEpoch, finalizedRoot)
+	}
+	return protoarray.New(justifiedEpoch, finalizedEpoch, finalizedRoot)
+}
@@ -0,0 +1,23 @@
+package forkchoice
+
+import (
+	"testing"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/config/features"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestNew_SelectsBackend(t *testing.T) {
+	f := New(1, 0, [32]byte{'a'})
+	_, ok := f.(*protoarray.ForkChoice)
+	assert.Equal(t, true, ok, "Expected proto array fork choice by default")
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableForkChoiceDoublyLinkedTree: true})
+	defer resetCfg()
+	f = New(1, 0, [32]byte{'a'})
+	_, ok = f.(*doublylinkedtree.ForkChoice)
+	assert.Equal(t, true, ok, "Expected doubly linked tree fork choice when enabled")
+	assert.Equal(t, 1, int(f.JustifiedEpoch()))
+}
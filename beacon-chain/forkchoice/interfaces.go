@@ -2,9 +2,10 @@ package forkchoice
 
 import (
 	"context"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 )
 
 // ForkChoicer represents the full fork choice interface composed of all of the sub-interfaces.
@@ -14,6 +15,8 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	OptimisticSyncer     // to track the execution payload status of blocks.
+	ProposerBooster      // to apply the proposer boost score to timely blocks.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -36,12 +39,29 @@ type Pruner interface {
 	Prune(context.Context, [32]byte) error
 }
 
+// OptimisticSyncer tracks whether blocks were imported optimistically, meaning their execution
+// payloads have not been validated by the execution engine yet.
+type OptimisticSyncer interface {
+	IsOptimistic(ctx context.Context, root [32]byte) (bool, error)
+	SetOptimisticToValid(ctx context.Context, root [32]byte) error
+	SetOptimisticToInvalid(ctx context.Context, root [32]byte) ([][32]byte, error)
+}
+
+// ProposerBooster boosts the weight of a block received in time during its own slot, which
+// protects the proposer of that block against ex ante reorgs.
+type ProposerBooster interface {
+	BoostProposerRoot(ctx context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime time.Time) error
+	ResetBoostedProposerRoot(ctx context.Context) error
+}
+
 // Getter returns fork choice related information.
 type Getter interface {
-	Nodes() []*protoarray.Node
-	Node([32]byte) *protoarray.Node
+	ForkChoiceNodes() []*forkchoicetypes.Node
+	NodeCount() int
 	HasNode([32]byte) bool
-	Store() *protoarray.Store
+	ProposerBoost() [32]byte
+	JustifiedEpoch() types.Epoch
+	FinalizedEpoch() types.Epoch
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot types.Slot) ([]byte, error)
 	IsCanonical(root [32]byte) bool
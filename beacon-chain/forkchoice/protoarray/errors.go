@@ -11,3 +11,6 @@ var errInvalidBestDescendantIndex = errors.New("best descendant index is invalid
 var errInvalidParentDelta = errors.New("parent delta is invalid")
 var errInvalidNodeDelta = errors.New("node delta is invalid")
 var errInvalidDeltaLength = errors.New("delta length is invalid")
+var errUnknownNodeRoot = errors.New("unknown block root")
+var errInvalidOptimisticStatus = errors.New("invalid optimistic status")
+var errNoActiveValidators = errors.New("no active validators")
@@ -0,0 +1,109 @@
+package protoarray
+
+import (
+	"context"
+
+	"go.opencensus.io/trace"
+)
+
+// IsOptimistic returns true if the block of the given root has been imported
+// optimistically, meaning its execution payload has not been validated by the
+// execution engine yet.
+func (f *ForkChoice) IsOptimistic(_ context.Context, root [32]byte) (bool, error) {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	index, ok := f.store.nodesIndices[root]
+	if !ok {
+		return false, errUnknownNodeRoot
+	}
+	if index >= uint64(len(f.store.nodes)) {
+		return false, errInvalidNodeIndex
+	}
+	return f.store.nodes[index].status == syncing, nil
+}
+
+// SetOptimisticToValid marks the block of the given root as fully validated by the
+// execution engine. A valid payload implies that the payloads of all its ancestors
+// are valid as well, so every optimistic ancestor is marked as valid too.
+func (f *ForkChoice) SetOptimisticToValid(ctx context.Context, root [32]byte) error {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.SetOptimisticToValid")
+	defer span.End()
+
+	f.store.nodesLock.Lock()
+	defer f.store.nodesLock.Unlock()
+
+	index, ok := f.store.nodesIndices[root]
+	if !ok {
+		return errUnknownNodeRoot
+	}
+
+	for index != NonExistentNode {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if index >= uint64(len(f.store.nodes)) {
+			return errInvalidNodeIndex
+		}
+		node := f.store.nodes[index]
+		switch node.status {
+		case valid:
+			// Every ancestor of a valid node has already been marked as valid.
+			return nil
+		case invalid:
+			return errInvalidOptimisticStatus
+		}
+		node.status = valid
+		index = node.parent
+	}
+	return nil
+}
+
+// SetOptimisticToInvalid marks the block of the given root and all of its descendants as
+// having an invalid execution payload. These blocks are no longer viable for head.
+// It returns the roots of all the blocks which have been invalidated.
+func (f *ForkChoice) SetOptimisticToInvalid(ctx context.Context, root [32]byte) ([][32]byte, error) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.SetOptimisticToInvalid")
+	defer span.End()
+
+	f.store.nodesLock.Lock()
+	defer f.store.nodesLock.Unlock()
+
+	index, ok := f.store.nodesIndices[root]
+	if !ok {
+		return nil, errUnknownNodeRoot
+	}
+	if index >= uint64(len(f.store.nodes)) {
+		return nil, errInvalidNodeIndex
+	}
+	if f.store.nodes[index].status == valid {
+		// A fully validated payload can not become invalid.
+		return nil, errInvalidOptimisticStatus
+	}
+
+	// Nodes are stored in insertion order, so every descendant of the invalid
+	// node is located after it and after its own parent in the list.
+	invalidated := map[uint64]bool{index: true}
+	indices := []uint64{index}
+	for i := index + 1; i < uint64(len(f.store.nodes)); i++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		node := f.store.nodes[i]
+		if node.parent == NonExistentNode || !invalidated[node.parent] {
+			continue
+		}
+		if node.status == valid {
+			return nil, errInvalidOptimisticStatus
+		}
+		invalidated[i] = true
+		indices = append(indices, i)
+	}
+
+	invalidRoots := make([][32]byte, len(indices))
+	for i, idx := range indices {
+		f.store.nodes[idx].status = invalid
+		invalidRoots[i] = f.store.nodes[idx].root
+	}
+	return invalidRoots, nil
+}
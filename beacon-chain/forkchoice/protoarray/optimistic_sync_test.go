@@ -0,0 +1,114 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// setupOptimisticForkChoice builds the following tree where every node is optimistic:
+//
+//	  0
+//	  |
+//	  1
+//	 / \
+//	2   3
+//	|
+//	4
+func setupOptimisticForkChoice(t *testing.T) *ForkChoice {
+	ctx := context.Background()
+	f := New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(0), params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), indexToHash(0), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 3, indexToHash(4), indexToHash(2), [32]byte{}, 0, 0))
+	return f
+}
+
+func TestForkChoice_IsOptimistic(t *testing.T) {
+	ctx := context.Background()
+	f := setupOptimisticForkChoice(t)
+
+	optimistic, err := f.IsOptimistic(ctx, indexToHash(4))
+	require.NoError(t, err)
+	assert.Equal(t, true, optimistic)
+
+	_, err = f.IsOptimistic(ctx, indexToHash(100))
+	require.ErrorIs(t, err, errUnknownNodeRoot)
+}
+
+func TestForkChoice_SetOptimisticToValid(t *testing.T) {
+	ctx := context.Background()
+	f := setupOptimisticForkChoice(t)
+
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(2)))
+	for i, want := range []bool{false, false, false, true, true} {
+		optimistic, err := f.IsOptimistic(ctx, indexToHash(uint64(i)))
+		require.NoError(t, err)
+		assert.Equal(t, want, optimistic, "Unexpected optimistic status for node %d", i)
+	}
+
+	// Validating a node a second time is a no-op.
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(2)))
+	require.ErrorIs(t, f.SetOptimisticToValid(ctx, indexToHash(100)), errUnknownNodeRoot)
+}
+
+func TestForkChoice_SetOptimisticToInvalid(t *testing.T) {
+	ctx := context.Background()
+	f := setupOptimisticForkChoice(t)
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(1)))
+
+	invalidRoots, err := f.SetOptimisticToInvalid(ctx, indexToHash(2))
+	require.NoError(t, err)
+	require.DeepEqual(t, [][32]byte{indexToHash(2), indexToHash(4)}, invalidRoots)
+
+	optimistic, err := f.IsOptimistic(ctx, indexToHash(3))
+	require.NoError(t, err)
+	assert.Equal(t, true, optimistic)
+	assert.Equal(t, invalid, f.store.nodes[f.store.nodesIndices[indexToHash(4)]].status)
+
+	// Descendants of an invalid block are invalid as well.
+	require.NoError(t, f.ProcessBlock(ctx, 4, indexToHash(5), indexToHash(4), [32]byte{}, 0, 0))
+	assert.Equal(t, invalid, f.store.nodes[f.store.nodesIndices[indexToHash(5)]].status)
+
+	// An invalid block can not be validated later on, and a valid block can not be invalidated.
+	require.ErrorIs(t, f.SetOptimisticToValid(ctx, indexToHash(4)), errInvalidOptimisticStatus)
+	_, err = f.SetOptimisticToInvalid(ctx, indexToHash(1))
+	require.ErrorIs(t, err, errInvalidOptimisticStatus)
+}
+
+func TestForkChoice_SetOptimisticToInvalid_ValidDescendant(t *testing.T) {
+	ctx := context.Background()
+	f := setupOptimisticForkChoice(t)
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(4)))
+
+	// Node 1 is an ancestor of the valid node 4, which makes it valid as well.
+	_, err := f.SetOptimisticToInvalid(ctx, indexToHash(1))
+	require.ErrorIs(t, err, errInvalidOptimisticStatus)
+	optimistic, err := f.IsOptimistic(ctx, indexToHash(3))
+	require.NoError(t, err)
+	assert.Equal(t, true, optimistic)
+}
+
+func TestForkChoice_Head_SkipsInvalidBranch(t *testing.T) {
+	ctx := context.Background()
+	f := setupOptimisticForkChoice(t)
+	balances := []uint64{1, 1}
+
+	// Both votes go to node 4, which makes it the head.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(4), 0)
+	r, err := f.Head(ctx, 0, indexToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(4), r)
+
+	// Once node 2 is invalidated, the head moves to the other branch despite the votes.
+	_, err = f.SetOptimisticToInvalid(ctx, indexToHash(2))
+	require.NoError(t, err)
+	r, err = f.Head(ctx, 0, indexToHash(0), balances, 0)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"go.opencensus.io/trace"
 )
@@ -63,7 +64,7 @@ func (f *ForkChoice) Head(
 	}
 	f.votes = newVotes
 
-	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
+	if err := f.store.applyWeightChanges(ctx, newBalances, justifiedEpoch, finalizedEpoch, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
 	f.balances = newBalances
@@ -128,6 +129,51 @@ func (f *ForkChoice) Nodes() []*Node {
 	return cpy
 }
 
+// ForkChoiceNodes returns a snapshot of the block nodes in the fork choice store. Every node
+// comes after its parent in the returned list.
+func (f *ForkChoice) ForkChoiceNodes() []*forkchoicetypes.Node {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	nodes := make([]*forkchoicetypes.Node, len(f.store.nodes))
+	for i, n := range f.store.nodes {
+		nodes[i] = &forkchoicetypes.Node{
+			Slot:           n.slot,
+			Root:           n.root,
+			ParentRoot:     f.store.rootAt(n.parent),
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.weight,
+			BestChild:      f.store.rootAt(n.bestChild),
+			BestDescendant: f.store.rootAt(n.bestDescendant),
+			Graffiti:       n.graffiti,
+			Optimistic:     n.status == syncing,
+		}
+	}
+	return nodes
+}
+
+// NodeCount returns the number of block nodes in the fork choice store.
+func (f *ForkChoice) NodeCount() int {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return len(f.store.nodes)
+}
+
+// JustifiedEpoch returns the justified epoch of the fork choice store.
+func (f *ForkChoice) JustifiedEpoch() types.Epoch {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.justifiedEpoch
+}
+
+// FinalizedEpoch returns the finalized epoch of the fork choice store.
+func (f *ForkChoice) FinalizedEpoch() types.Epoch {
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	return f.store.finalizedEpoch
+}
+
 // Store returns the fork choice store object which contains all the information regarding proto array fork choice.
 func (f *ForkChoice) Store() *Store {
 	f.store.nodesLock.Lock()
@@ -240,6 +286,14 @@ func (s *Store) NodesIndices() map[[32]byte]uint64 {
 	return s.nodesIndices
 }
 
+// rootAt returns the root of the node at the given index, or the zero hash if there is no such node.
+func (s *Store) rootAt(index uint64) [32]byte {
+	if index >= uint64(len(s.nodes)) {
+		return params.BeaconConfig().ZeroHash
+	}
+	return s.nodes[index].root
+}
+
 // head starts from justified root and then follows the best descendant links
 // to find the best block for head.
 func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, error) {
@@ -345,6 +399,12 @@ func (s *Store) insert(ctx context.Context,
 		parentIndex = NonExistentNode
 	}
 
+	// A descendant of a block with an invalid execution payload is invalid as well.
+	nodeStatus := syncing
+	if parentIndex != NonExistentNode && s.nodes[parentIndex].status == invalid {
+		nodeStatus = invalid
+	}
+
 	n := &Node{
 		slot:           slot,
 		root:           root,
@@ -355,6 +415,7 @@ func (s *Store) insert(ctx context.Context,
 		bestChild:      NonExistentNode,
 		bestDescendant: NonExistentNode,
 		weight:         0,
+		status:         nodeStatus,
 	}
 
 	s.nodesIndices[root] = index
@@ -376,9 +437,12 @@ func (s *Store) insert(ctx context.Context,
 
 // applyWeightChanges iterates backwards through the nodes in store. It checks all nodes parent
 // and its best child. For each node, it updates the weight with input delta and
-// back propagate the nodes delta to its parents delta. After scoring changes,
-// the best child is then updated along with best descendant.
-func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch, delta []int) error {
+// back propagate the nodes delta to its parents delta. The proposer boost score is moved
+// from the previously boosted node to the currently boosted one along the way. After scoring
+// changes, the best child is then updated along with best descendant.
+func (s *Store) applyWeightChanges(
+	ctx context.Context, newBalances []uint64, justifiedEpoch, finalizedEpoch types.Epoch, delta []int,
+) error {
 	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.applyWeightChanges")
 	defer span.End()
 
@@ -393,6 +457,10 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		s.finalizedEpoch = finalizedEpoch
 	}
 
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+	proposerScore := uint64(0)
+
 	// Iterate backwards through all index to node in store.
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
@@ -405,6 +473,20 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 
 		nodeDelta := delta[i]
 
+		// Remove the score of the previous proposer boost, and add the score of the current one.
+		// Both are propagated to the ancestors of the boosted node together with the votes.
+		if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash && s.previousProposerBoostRoot == n.root {
+			nodeDelta -= int(s.previousProposerBoostScore)
+		}
+		if s.proposerBoostRoot != params.BeaconConfig().ZeroHash && s.proposerBoostRoot == n.root {
+			score, err := computeProposerBoostScore(newBalances)
+			if err != nil {
+				return err
+			}
+			proposerScore = score
+			nodeDelta += int(proposerScore)
+		}
+
 		if nodeDelta < 0 {
 			// A node's weight can not be negative but the delta can be negative.
 			if int(n.weight)+nodeDelta < 0 {
@@ -436,6 +518,9 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		}
 	}
 
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = proposerScore
+
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
 		if n.parent != NonExistentNode {
@@ -652,8 +737,11 @@ func (s *Store) leadsToViableHead(node *Node) (bool, error) {
 
 // viableForHead returns true if the node is viable to head.
 // Any node with diff finalized or justified epoch than the ones in fork choice store
-// should not be viable to head.
+// should not be viable to head. A node with an invalid execution payload is never viable.
 func (s *Store) viableForHead(node *Node) bool {
+	if node.status == invalid {
+		return false
+	}
 	// `node` is viable if its justified epoch and finalized epoch are the same as the one in `Store`.
 	// It's also viable if we are in genesis epoch.
 	justified := s.justifiedEpoch == node.justifiedEpoch || s.justifiedEpoch == 0
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -97,6 +98,37 @@ func TestForkChoice_Nodes(t *testing.T) {
 	require.DeepEqual(t, s.nodes, f.Nodes())
 }
 
+func TestForkChoice_ForkChoiceNodes(t *testing.T) {
+	ctx := context.Background()
+	f := New(0, 0, [32]byte{})
+	require.NoError(t, f.ProcessBlock(ctx, 100, [32]byte{'a'}, [32]byte{}, [32]byte{'g'}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 101, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 102, [32]byte{'c'}, [32]byte{'b'}, [32]byte{}, 0, 0))
+	require.NoError(t, f.SetOptimisticToValid(ctx, [32]byte{'a'}))
+	f.ProcessAttestation(ctx, []uint64{0}, [32]byte{'c'}, 0)
+	_, err := f.Head(ctx, 0, [32]byte{'a'}, []uint64{10}, 0)
+	require.NoError(t, err)
+
+	nodes := f.ForkChoiceNodes()
+	require.Equal(t, 3, len(nodes))
+	assert.Equal(t, 3, f.NodeCount())
+	assert.DeepEqual(t, &forkchoicetypes.Node{
+		Slot:           100,
+		Root:           [32]byte{'a'},
+		Weight:         10,
+		BestChild:      [32]byte{'b'},
+		BestDescendant: [32]byte{'c'},
+		Graffiti:       [32]byte{'g'},
+	}, nodes[0])
+	assert.DeepEqual(t, &forkchoicetypes.Node{
+		Slot:       102,
+		Root:       [32]byte{'c'},
+		ParentRoot: [32]byte{'b'},
+		Weight:     10,
+		Optimistic: true,
+	}, nodes[2])
+}
+
 func TestStore_Head_UnknownJustifiedRoot(t *testing.T) {
 	s := &Store{nodesIndices: make(map[[32]byte]uint64)}
 
@@ -185,7 +217,7 @@ func TestStore_ApplyScoreChanges_InvalidDeltaLength(t *testing.T) {
 	s := &Store{}
 
 	// This will fail because node indices has length of 0, and delta list has a length of 1.
-	err := s.applyWeightChanges(context.Background(), 0, 0, []int{1})
+	err := s.applyWeightChanges(context.Background(), []uint64{}, 0, 0, []int{1})
 	assert.ErrorContains(t, errInvalidDeltaLength.Error(), err)
 }
 
@@ -193,7 +225,7 @@ func TestStore_ApplyScoreChanges_UpdateEpochs(t *testing.T) {
 	s := &Store{}
 
 	// The justified and finalized epochs in Store should be updated to 1 and 1 given the following input.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 1, 1, []int{}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), []uint64{}, 1, 1, []int{}))
 	assert.Equal(t, types.Epoch(1), s.justifiedEpoch, "Did not update justified epoch")
 	assert.Equal(t, types.Epoch(1), s.finalizedEpoch, "Did not update finalized epoch")
 }
@@ -207,7 +239,7 @@ func TestStore_ApplyScoreChanges_UpdateWeightsPositiveDelta(t *testing.T) {
 
 	// Each node gets one unique vote. The weight should look like 103 <- 102 <- 101 because
 	// they get propagated back.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []int{1, 1, 1}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), []uint64{}, 0, 0, []int{1, 1, 1}))
 	assert.Equal(t, uint64(103), s.nodes[0].weight)
 	assert.Equal(t, uint64(102), s.nodes[1].weight)
 	assert.Equal(t, uint64(101), s.nodes[2].weight)
@@ -222,7 +254,7 @@ func TestStore_ApplyScoreChanges_UpdateWeightsNegativeDelta(t *testing.T) {
 
 	// Each node gets one unique vote which contributes to negative delta.
 	// The weight should look like 97 <- 98 <- 99 because they get propagated back.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []int{-1, -1, -1}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), []uint64{}, 0, 0, []int{-1, -1, -1}))
 	assert.Equal(t, uint64(97), s.nodes[0].weight)
 	assert.Equal(t, uint64(98), s.nodes[1].weight)
 	assert.Equal(t, uint64(99), s.nodes[2].weight)
@@ -236,7 +268,7 @@ func TestStore_ApplyScoreChanges_UpdateWeightsMixedDelta(t *testing.T) {
 		{parent: 1, root: [32]byte{'A'}, weight: 100}}}
 
 	// Each node gets one mixed vote. The weight should look like 100 <- 200 <- 250.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []int{-100, -50, 150}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), []uint64{}, 0, 0, []int{-100, -50, 150}))
 	assert.Equal(t, uint64(100), s.nodes[0].weight)
 	assert.Equal(t, uint64(200), s.nodes[1].weight)
 	assert.Equal(t, uint64(250), s.nodes[2].weight)
@@ -24,6 +24,11 @@ type Store struct {
 	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
 	nodesLock      sync.RWMutex
+
+	proposerBoostRoot          [32]byte // latest block root that was boosted after being received in a timely manner.
+	previousProposerBoostRoot  [32]byte // previous block root that was boosted after being received in a timely manner.
+	previousProposerBoostScore uint64   // previous proposer boosted root score.
+	proposerBoostLock          sync.Mutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -38,8 +43,18 @@ type Node struct {
 	bestChild      uint64      // bestChild index of this node.
 	bestDescendant uint64      // bestDescendant of this node.
 	graffiti       [32]byte    // graffiti of the block node.
+	status         status      // optimistic status of this node.
 }
 
+// status defines the execution payload validity status of a node.
+type status uint8
+
+const (
+	syncing status = iota // the node's execution payload has not been validated by the execution engine yet.
+	valid                 // the node's execution payload has been validated, or the node is pre-merge.
+	invalid               // the node's execution payload has been declared invalid by the execution engine.
+)
+
 // Vote defines an individual validator's vote.
 type Vote struct {
 	currentRoot [32]byte    // current voting root.
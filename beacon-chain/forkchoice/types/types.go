@@ -0,0 +1,23 @@
+// Package types defines the implementation-neutral data structures returned by the
+// fork choice store, so that callers do not depend on any particular backend.
+package types
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Node is a snapshot of a block tracked by the fork choice store. The parent, best child
+// and best descendant of a node are referred to by their block roots, which are zero
+// when the node has none.
+type Node struct {
+	Slot           types.Slot  // slot of the block.
+	Root           [32]byte    // root of the block.
+	ParentRoot     [32]byte    // root of the parent block, zero if the parent is not in the store.
+	JustifiedEpoch types.Epoch // justified epoch of the block's post state.
+	FinalizedEpoch types.Epoch // finalized epoch of the block's post state.
+	Weight         uint64      // weight of the block, including the proposer boost.
+	BestChild      [32]byte    // root of the child leading to the best descendant, zero if none.
+	BestDescendant [32]byte    // root of the best descendant, zero if none.
+	Graffiti       [32]byte    // graffiti of the block.
+	Optimistic     bool        // whether the execution payload of the block has not been validated yet.
+}
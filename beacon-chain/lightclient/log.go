@@ -0,0 +1,7 @@
+package lightclient
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "lightclient")
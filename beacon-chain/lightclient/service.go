@@ -0,0 +1,239 @@
+// Package lightclient builds the data served to light clients as blocks are imported. It keeps
+// the best light client update of each sync committee period in the database, and the latest
+// finality and optimistic updates in memory.
+package lightclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/async/event"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+var (
+	// ErrBlockNotFound is returned when the block requested for a bootstrap is not known.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrPreAltairBlock is returned when the block requested for a bootstrap has no sync committee.
+	ErrPreAltairBlock = errors.New("block is older than the altair fork")
+)
+
+// Fetcher defines a struct which can serve light client data.
+type Fetcher interface {
+	Bootstrap(ctx context.Context, blockRoot [32]byte) (*ethpbv2.LightClientBootstrap, error)
+	BestUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpbv2.LightClientUpdate, error)
+	FinalityUpdate() *ethpbv2.LightClientFinalityUpdate
+	OptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate
+}
+
+// Config to set up the light client service.
+type Config struct {
+	DB            db.NoHeadAccessDatabase
+	StateGen      stategen.StateManager
+	StateNotifier statefeed.Notifier
+}
+
+// Service builds light client updates from the sync aggregates of imported blocks.
+type Service struct {
+	cfg              *Config
+	ctx              context.Context
+	cancel           context.CancelFunc
+	lock             sync.RWMutex
+	finalityUpdate   *ethpbv2.LightClientFinalityUpdate
+	optimisticUpdate *ethpbv2.LightClientOptimisticUpdate
+}
+
+// NewService configures the light client service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start the light client service.
+func (s *Service) Start() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	go s.run(stateChannel, stateSub)
+}
+
+// Stop the light client service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the light client service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Bootstrap returns the light client bootstrap of the given trusted block root.
+func (s *Service) Bootstrap(ctx context.Context, blockRoot [32]byte) (*ethpbv2.LightClientBootstrap, error) {
+	ctx, span := trace.StartSpan(ctx, "lightclient.Bootstrap")
+	defer span.End()
+
+	blk, err := s.cfg.DB.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block")
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Wrapf(ErrBlockNotFound, "%#x", blockRoot)
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, ErrPreAltairBlock
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state")
+	}
+	return newBootstrap(ctx, st, blk)
+}
+
+// BestUpdates returns the best light client update of at most count sync committee periods,
+// starting at the given period.
+func (s *Service) BestUpdates(ctx context.Context, startPeriod, count uint64) ([]*ethpbv2.LightClientUpdate, error) {
+	return s.cfg.DB.LightClientUpdates(ctx, startPeriod, count)
+}
+
+// FinalityUpdate returns the finality update with the latest attested header, or nil if there is none.
+func (s *Service) FinalityUpdate() *ethpbv2.LightClientFinalityUpdate {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.finalityUpdate
+}
+
+// OptimisticUpdate returns the optimistic update with the latest attested header, or nil if there is none.
+func (s *Service) OptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.optimisticUpdate
+}
+
+// run processes the blocks imported by the node until the service is stopped.
+func (s *Service) run(stateChannel chan *feed.Event, stateSub event.Subscription) {
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.BlockProcessedData)
+			if !ok || data.SignedBlock == nil {
+				continue
+			}
+			if err := s.processBlock(s.ctx, data.SignedBlock); err != nil {
+				log.WithError(err).WithField("slot", data.Slot).Debug("Could not build light client update")
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// processBlock builds the light client update for the sync aggregate of an imported block,
+// which signs over the parent block of that block.
+func (s *Service) processBlock(ctx context.Context, blk block.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "lightclient.processBlock")
+	defer span.End()
+
+	if blk.Version() == version.Phase0 {
+		return nil
+	}
+	syncAggregate, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		return errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil
+	}
+	attestedRoot := bytesutil.ToBytes32(blk.Block().ParentRoot())
+	attestedBlock, err := s.cfg.DB.Block(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested block")
+	}
+	if attestedBlock == nil || attestedBlock.IsNil() {
+		return errors.Wrapf(ErrBlockNotFound, "attested block %#x", attestedRoot)
+	}
+	// The sync committee of the first Altair block signs over a Phase 0 block, which has no sync committee.
+	if attestedBlock.Version() == version.Phase0 {
+		return nil
+	}
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+	var finalizedBlock block.SignedBeaconBlock
+	if finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root); finalizedRoot != params.BeaconConfig().ZeroHash {
+		finalizedBlock, err = s.cfg.DB.Block(ctx, finalizedRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized block")
+		}
+	}
+	update, err := newUpdate(ctx, attestedState, attestedBlock, finalizedBlock, syncAggregate, blk.Block().Slot())
+	if err != nil {
+		return err
+	}
+	if err := s.saveBestUpdate(ctx, update); err != nil {
+		return err
+	}
+	s.setLatestUpdates(update)
+	return nil
+}
+
+// saveBestUpdate saves the update if it is better than the best update of its sync committee period.
+func (s *Service) saveBestUpdate(ctx context.Context, update *ethpbv2.LightClientUpdate) error {
+	period := SyncCommitteePeriod(update.AttestedHeader.Slot)
+	best, err := s.cfg.DB.LightClientUpdates(ctx, period, 1)
+	if err != nil {
+		return errors.Wrap(err, "could not get best light client update")
+	}
+	if len(best) > 0 && !isBetterUpdate(update, best[0]) {
+		return nil
+	}
+	if err := s.cfg.DB.SaveLightClientUpdate(ctx, period, update); err != nil {
+		return errors.Wrap(err, "could not save light client update")
+	}
+	log.WithFields(logrus.Fields{
+		"period":       period,
+		"attestedSlot": update.AttestedHeader.Slot,
+		"participants": participants(update.SyncAggregate),
+	}).Debug("Saved best light client update of sync committee period")
+	return nil
+}
+
+// setLatestUpdates keeps the finality and optimistic updates of the given update if its
+// attested header is newer than the ones kept so far.
+func (s *Service) setLatestUpdates(update *ethpbv2.LightClientUpdate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.optimisticUpdate == nil || update.AttestedHeader.Slot > s.optimisticUpdate.AttestedHeader.Slot {
+		s.optimisticUpdate = optimisticUpdate(update)
+	}
+	if u := finalityUpdate(update); u != nil {
+		if s.finalityUpdate == nil || u.AttestedHeader.Slot > s.finalityUpdate.AttestedHeader.Slot {
+			s.finalityUpdate = u
+		}
+	}
+}
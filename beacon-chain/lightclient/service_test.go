@@ -0,0 +1,168 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/container/trie"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+type testChain struct {
+	service       *Service
+	finalizedRoot [32]byte
+	attestedRoot  [32]byte
+	attestedBlock block.SignedBeaconBlock
+	stateRoot     [32]byte
+}
+
+// setupChain saves a finalized block, and an attested block whose post-state finalizes it.
+func setupChain(t *testing.T) *testChain {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+
+	finalized := util.NewBeaconBlockAltair()
+	finalized.Block.Slot = 8
+	finalizedBlock, err := wrapper.WrappedAltairSignedBeaconBlock(finalized)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, finalizedBlock))
+	finalizedRoot, err := finalized.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(40))
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	attested := util.NewBeaconBlockAltair()
+	attested.Block.Slot = 40
+	attested.Block.ParentRoot = finalizedRoot[:]
+	attested.Block.StateRoot = stateRoot[:]
+	attestedBlock, err := wrapper.WrappedAltairSignedBeaconBlock(attested)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, attestedBlock))
+	attestedRoot, err := attested.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	sg := stategen.NewMockService()
+	sg.StatesByRoot[attestedRoot] = st
+	return &testChain{
+		service:       NewService(ctx, &Config{DB: beaconDB, StateGen: sg}),
+		finalizedRoot: finalizedRoot,
+		attestedRoot:  attestedRoot,
+		attestedBlock: attestedBlock,
+		stateRoot:     stateRoot,
+	}
+}
+
+// childBlock returns a block at the given slot whose sync aggregate signs over the parent root.
+func childBlock(t *testing.T, slot types.Slot, parentRoot [32]byte, participants uint64) block.SignedBeaconBlock {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parentRoot[:]
+	b.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+func TestService_processBlock(t *testing.T) {
+	ctx := context.Background()
+	c := setupChain(t)
+	s := c.service
+
+	// Blocks without sync committee participation produce no update.
+	require.NoError(t, s.processBlock(ctx, childBlock(t, 41, c.attestedRoot, 0)))
+	assert.Equal(t, true, s.OptimisticUpdate() == nil)
+
+	require.NoError(t, s.processBlock(ctx, childBlock(t, 41, c.attestedRoot, 300)))
+	updates, err := s.BestUpdates(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	update := updates[0]
+	assert.Equal(t, types.Slot(40), update.AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(41), update.SignatureSlot)
+	assert.Equal(t, uint64(300), update.SyncAggregate.SyncCommitteeBits.Count())
+	assert.DeepEqual(t, c.stateRoot[:], update.AttestedHeader.StateRoot)
+	require.NotNil(t, update.FinalizedHeader)
+	assert.Equal(t, types.Slot(8), update.FinalizedHeader.Slot)
+
+	// The proofs of the update verify against the state root of the attested header.
+	st, err := s.cfg.StateGen.StateByRoot(ctx, c.attestedRoot)
+	require.NoError(t, err)
+	nextSyncCommittee, err := st.NextSyncCommittee()
+	require.NoError(t, err)
+	nextSyncCommitteeRoot, err := nextSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, trie.VerifyMerkleProof(c.stateRoot[:], nextSyncCommitteeRoot[:], v2.NextSyncCommitteeGeneralizedIndex(), update.NextSyncCommitteeBranch))
+	assert.Equal(t, true, trie.VerifyMerkleProof(c.stateRoot[:], c.finalizedRoot[:], v2.FinalizedRootGeneralizedIndex(), update.FinalityBranch))
+
+	finality := s.FinalityUpdate()
+	require.NotNil(t, finality)
+	assert.DeepEqual(t, update.FinalizedHeader, finality.FinalizedHeader)
+	optimistic := s.OptimisticUpdate()
+	require.NotNil(t, optimistic)
+	assert.Equal(t, types.Slot(41), optimistic.SignatureSlot)
+
+	// A worse update of the same period does not replace the best update.
+	require.NoError(t, s.processBlock(ctx, childBlock(t, 42, c.attestedRoot, 200)))
+	updates, err = s.BestUpdates(ctx, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), updates[0].SyncAggregate.SyncCommitteeBits.Count())
+
+	// A better update of the same period replaces the best update.
+	require.NoError(t, s.processBlock(ctx, childBlock(t, 43, c.attestedRoot, params.BeaconConfig().SyncCommitteeSize)))
+	updates, err = s.BestUpdates(ctx, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SyncCommitteeSize, updates[0].SyncAggregate.SyncCommitteeBits.Count())
+}
+
+func TestService_processBlock_UnknownParent(t *testing.T) {
+	c := setupChain(t)
+	err := c.service.processBlock(context.Background(), childBlock(t, 41, [32]byte{'a'}, 300))
+	require.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestService_Bootstrap(t *testing.T) {
+	ctx := context.Background()
+	c := setupChain(t)
+	s := c.service
+
+	_, err := s.Bootstrap(ctx, [32]byte{'a'})
+	require.ErrorIs(t, err, ErrBlockNotFound)
+
+	phase0 := util.NewBeaconBlock()
+	require.NoError(t, s.cfg.DB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(phase0)))
+	phase0Root, err := phase0.Block.HashTreeRoot()
+	require.NoError(t, err)
+	_, err = s.Bootstrap(ctx, phase0Root)
+	require.ErrorIs(t, err, ErrPreAltairBlock)
+
+	bootstrap, err := s.Bootstrap(ctx, c.attestedRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(40), bootstrap.Header.Slot)
+	st, err := s.cfg.StateGen.StateByRoot(ctx, c.attestedRoot)
+	require.NoError(t, err)
+	currentSyncCommittee, err := st.CurrentSyncCommittee()
+	require.NoError(t, err)
+	assert.DeepEqual(t, currentSyncCommittee.Pubkeys, bootstrap.CurrentSyncCommittee.Pubkeys)
+	currentSyncCommitteeRoot, err := currentSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, trie.VerifyMerkleProof(c.stateRoot[:], currentSyncCommitteeRoot[:], v2.CurrentSyncCommitteeGeneralizedIndex(), bootstrap.CurrentSyncCommitteeBranch))
+}
@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+)
+
+var _ lightclient.Fetcher = (*Fetcher)(nil)
+
+// Fetcher serves the light client data it is set up with.
+type Fetcher struct {
+	Bootstraps       map[[32]byte]*ethpbv2.LightClientBootstrap
+	BootstrapErr     error
+	Updates          map[uint64]*ethpbv2.LightClientUpdate
+	LatestFinality   *ethpbv2.LightClientFinalityUpdate
+	LatestOptimistic *ethpbv2.LightClientOptimisticUpdate
+}
+
+// Bootstrap --
+func (f *Fetcher) Bootstrap(_ context.Context, blockRoot [32]byte) (*ethpbv2.LightClientBootstrap, error) {
+	if f.BootstrapErr != nil {
+		return nil, f.BootstrapErr
+	}
+	bootstrap, ok := f.Bootstraps[blockRoot]
+	if !ok {
+		return nil, lightclient.ErrBlockNotFound
+	}
+	return bootstrap, nil
+}
+
+// BestUpdates --
+func (f *Fetcher) BestUpdates(_ context.Context, startPeriod, count uint64) ([]*ethpbv2.LightClientUpdate, error) {
+	updates := make([]*ethpbv2.LightClientUpdate, 0)
+	for period := startPeriod; period < startPeriod+count; period++ {
+		if update, ok := f.Updates[period]; ok {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+// FinalityUpdate --
+func (f *Fetcher) FinalityUpdate() *ethpbv2.LightClientFinalityUpdate {
+	return f.LatestFinality
+}
+
+// OptimisticUpdate --
+func (f *Fetcher) OptimisticUpdate() *ethpbv2.LightClientOptimisticUpdate {
+	return f.LatestOptimistic
+}
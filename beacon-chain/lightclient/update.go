@@ -0,0 +1,239 @@
+package lightclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+// SyncCommitteePeriod returns the sync committee period of the given slot.
+func SyncCommitteePeriod(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// newUpdate builds the light client update for a sync aggregate included at the signature slot,
+// which signs over the attested block. The attested state is the post-state of the attested block.
+// The finalized block may be nil if the block of the finalized checkpoint of the attested state
+// is not known, in which case the update carries no finality.
+func newUpdate(
+	ctx context.Context,
+	attestedState state.BeaconState,
+	attestedBlock block.SignedBeaconBlock,
+	finalizedBlock block.SignedBeaconBlock,
+	syncAggregate *ethpb.SyncAggregate,
+	signatureSlot types.Slot,
+) (*ethpbv2.LightClientUpdate, error) {
+	attestedHeader, err := blockHeader(attestedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested header")
+	}
+	nextSyncCommittee, err := attestedState.NextSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next sync committee")
+	}
+	nextSyncCommitteeBranch, err := attestedState.NextSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next sync committee proof")
+	}
+	update := &ethpbv2.LightClientUpdate{
+		AttestedHeader:          attestedHeader,
+		NextSyncCommittee:       v2SyncCommittee(nextSyncCommittee),
+		NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+		SyncAggregate:           v1SyncAggregate(syncAggregate),
+		SignatureSlot:           signatureSlot,
+	}
+	if finalizedBlock == nil || finalizedBlock.IsNil() {
+		return update, nil
+	}
+	finalizedHeader, err := blockHeader(finalizedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized header")
+	}
+	finalityBranch, err := attestedState.FinalizedRootProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized root proof")
+	}
+	update.FinalizedHeader = finalizedHeader
+	update.FinalityBranch = finalityBranch
+	return update, nil
+}
+
+// newBootstrap builds the light client bootstrap of a trusted block from its post-state.
+func newBootstrap(ctx context.Context, st state.BeaconState, blk block.SignedBeaconBlock) (*ethpbv2.LightClientBootstrap, error) {
+	header, err := blockHeader(blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	currentSyncCommittee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	currentSyncCommitteeBranch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee proof")
+	}
+	return &ethpbv2.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       v2SyncCommittee(currentSyncCommittee),
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	}, nil
+}
+
+// finalityUpdate returns the finality update contained in the given update, or nil if the update carries no finality.
+func finalityUpdate(update *ethpbv2.LightClientUpdate) *ethpbv2.LightClientFinalityUpdate {
+	if !hasFinality(update) {
+		return nil
+	}
+	return &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// optimisticUpdate returns the optimistic update contained in the given update.
+func optimisticUpdate(update *ethpbv2.LightClientUpdate) *ethpbv2.LightClientOptimisticUpdate {
+	return &ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// isBetterUpdate returns true if the new update should replace the old update as the best
+// update of their sync committee period, following the ranking of the light client spec. Updates
+// built by this package always carry the next sync committee.
+//
+// Spec code:
+// def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//    # Compare supermajority (> 2/3) sync committee participation
+//    max_active_participants = len(new_update.sync_aggregate.sync_committee_bits)
+//    new_num_active_participants = sum(new_update.sync_aggregate.sync_committee_bits)
+//    old_num_active_participants = sum(old_update.sync_aggregate.sync_committee_bits)
+//    new_has_supermajority = new_num_active_participants * 3 >= max_active_participants * 2
+//    old_has_supermajority = old_num_active_participants * 3 >= max_active_participants * 2
+//    if new_has_supermajority != old_has_supermajority:
+//        return new_has_supermajority > old_has_supermajority
+//    if not new_has_supermajority and new_num_active_participants != old_num_active_participants:
+//        return new_num_active_participants > old_num_active_participants
+//
+//    # Compare presence of relevant sync committee
+//    new_has_relevant_sync_committee = is_sync_committee_update(new_update) and (
+//        compute_sync_committee_period_at_slot(new_update.attested_header.slot)
+//        == compute_sync_committee_period_at_slot(new_update.signature_slot)
+//    )
+//    old_has_relevant_sync_committee = is_sync_committee_update(old_update) and (
+//        compute_sync_committee_period_at_slot(old_update.attested_header.slot)
+//        == compute_sync_committee_period_at_slot(old_update.signature_slot)
+//    )
+//    if new_has_relevant_sync_committee != old_has_relevant_sync_committee:
+//        return new_has_relevant_sync_committee
+//
+//    # Compare indication of any finality
+//    new_has_finality = is_finality_update(new_update)
+//    old_has_finality = is_finality_update(old_update)
+//    if new_has_finality != old_has_finality:
+//        return new_has_finality
+//
+//    # Compare sync committee finality
+//    if new_has_finality:
+//        new_has_sync_committee_finality = (
+//            compute_sync_committee_period_at_slot(new_update.finalized_header.slot)
+//            == compute_sync_committee_period_at_slot(new_update.attested_header.slot)
+//        )
+//        old_has_sync_committee_finality = (
+//            compute_sync_committee_period_at_slot(old_update.finalized_header.slot)
+//            == compute_sync_committee_period_at_slot(old_update.attested_header.slot)
+//        )
+//        if new_has_sync_committee_finality != old_has_sync_committee_finality:
+//            return new_has_sync_committee_finality
+//
+//    # Tiebreaker 1: Sync committee participation beyond supermajority
+//    if new_num_active_participants != old_num_active_participants:
+//        return new_num_active_participants > old_num_active_participants
+//
+//    # Tiebreaker 2: Prefer older data (fewer changes to best)
+//    if new_update.attested_header.slot != old_update.attested_header.slot:
+//        return new_update.attested_header.slot < old_update.attested_header.slot
+//    return new_update.signature_slot < old_update.signature_slot
+func isBetterUpdate(newUpdate, oldUpdate *ethpbv2.LightClientUpdate) bool {
+	maxActiveParticipants := params.BeaconConfig().SyncCommitteeSize
+	newActiveParticipants := participants(newUpdate.SyncAggregate)
+	oldActiveParticipants := participants(oldUpdate.SyncAggregate)
+	newHasSupermajority := newActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldActiveParticipants*3 >= maxActiveParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newActiveParticipants != oldActiveParticipants {
+		return newActiveParticipants > oldActiveParticipants
+	}
+
+	newHasRelevantSyncCommittee := SyncCommitteePeriod(newUpdate.AttestedHeader.Slot) == SyncCommitteePeriod(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := SyncCommitteePeriod(oldUpdate.AttestedHeader.Slot) == SyncCommitteePeriod(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	newHasFinality := hasFinality(newUpdate)
+	oldHasFinality := hasFinality(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+	if newHasFinality {
+		newHasSyncCommitteeFinality := SyncCommitteePeriod(newUpdate.FinalizedHeader.Slot) == SyncCommitteePeriod(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality := SyncCommitteePeriod(oldUpdate.FinalizedHeader.Slot) == SyncCommitteePeriod(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	if newActiveParticipants != oldActiveParticipants {
+		return newActiveParticipants > oldActiveParticipants
+	}
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func hasFinality(update *ethpbv2.LightClientUpdate) bool {
+	return update.FinalizedHeader != nil
+}
+
+func participants(syncAggregate *ethpbv1.SyncAggregate) uint64 {
+	return syncAggregate.SyncCommitteeBits.Count()
+}
+
+func blockHeader(blk block.SignedBeaconBlock) (*ethpbv1.BeaconBlockHeader, error) {
+	header, err := block.SignedBeaconBlockHeaderFromBlockInterface(blk)
+	if err != nil {
+		return nil, err
+	}
+	return migration.V1Alpha1SignedHeaderToV1(header).Message, nil
+}
+
+func v2SyncCommittee(committee *ethpb.SyncCommittee) *ethpbv2.SyncCommittee {
+	return &ethpbv2.SyncCommittee{
+		Pubkeys:         committee.Pubkeys,
+		AggregatePubkey: committee.AggregatePubkey,
+	}
+}
+
+func v1SyncAggregate(syncAggregate *ethpb.SyncAggregate) *ethpbv1.SyncAggregate {
+	return &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      syncAggregate.SyncCommitteeBits,
+		SyncCommitteeSignature: syncAggregate.SyncCommitteeSignature,
+	}
+}
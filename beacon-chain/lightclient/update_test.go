@@ -0,0 +1,101 @@
+package lightclient
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func testUpdate(attestedSlot, signatureSlot types.Slot, participants uint64, finalizedSlot *types.Slot) *ethpbv2.LightClientUpdate {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	update := &ethpbv2.LightClientUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{Slot: attestedSlot},
+		SyncAggregate:  &ethpbv1.SyncAggregate{SyncCommitteeBits: bits},
+		SignatureSlot:  signatureSlot,
+	}
+	if finalizedSlot != nil {
+		update.FinalizedHeader = &ethpbv1.BeaconBlockHeader{Slot: *finalizedSlot}
+	}
+	return update
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	periodSlots := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	samePeriod := periodSlots + 64
+	previousPeriod := types.Slot(64)
+
+	tests := []struct {
+		name      string
+		newUpdate *ethpbv2.LightClientUpdate
+		oldUpdate *ethpbv2.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority beats more finality",
+			newUpdate: testUpdate(100, 101, 400, nil),
+			oldUpdate: testUpdate(100, 101, 300, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: testUpdate(100, 101, 300, nil),
+			oldUpdate: testUpdate(100, 101, 200, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "relevant sync committee",
+			newUpdate: testUpdate(periodSlots-1, periodSlots+1, 400, nil),
+			oldUpdate: testUpdate(periodSlots+1, periodSlots+2, 400, nil),
+			want:      false,
+		},
+		{
+			name:      "finality",
+			newUpdate: testUpdate(periodSlots+100, periodSlots+101, 400, &samePeriod),
+			oldUpdate: testUpdate(periodSlots+100, periodSlots+101, 500, nil),
+			want:      true,
+		},
+		{
+			name:      "sync committee finality",
+			newUpdate: testUpdate(periodSlots+100, periodSlots+101, 400, &samePeriod),
+			oldUpdate: testUpdate(periodSlots+100, periodSlots+101, 500, &previousPeriod),
+			want:      true,
+		},
+		{
+			name:      "more participants beyond supermajority",
+			newUpdate: testUpdate(100, 101, 500, nil),
+			oldUpdate: testUpdate(100, 101, 400, nil),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: testUpdate(100, 101, 400, nil),
+			oldUpdate: testUpdate(99, 101, 400, nil),
+			want:      false,
+		},
+		{
+			name:      "older signature slot",
+			newUpdate: testUpdate(100, 101, 400, nil),
+			oldUpdate: testUpdate(100, 102, 400, nil),
+			want:      true,
+		},
+		{
+			name:      "equal updates",
+			newUpdate: testUpdate(100, 101, 400, nil),
+			oldUpdate: testUpdate(100, 101, 400, nil),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
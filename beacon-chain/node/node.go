@@ -21,14 +21,17 @@ import (
 	apigateway "github.com/prysmaticlabs/prysm/api/gateway"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/checkpoint"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
 	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -43,6 +46,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
@@ -100,6 +104,9 @@ type BeaconNode struct {
 	slasherAttestationsFeed *event.Feed
 	finalizedStateAtStartUp state.BeaconState
 	serviceFlagOpts         *serviceFlagOpts
+	proposerIdsCache        *cache.ProposerPayloadIDsCache
+	feeRecipientCache       *cache.FeeRecipientCache
+	checkpointInitializer   checkpoint.Initializer
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -141,6 +148,8 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
+		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		feeRecipientCache:       cache.NewFeeRecipientCache(),
 	}
 
 	for _, opt := range opts {
@@ -195,6 +204,18 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerLightClientService(); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerPrunerService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
 	}
@@ -294,7 +315,7 @@ func (b *BeaconNode) Close() {
 }
 
 func (b *BeaconNode) startForkChoice() {
-	f := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	f := forkchoice.New(0, 0, params.BeaconConfig().ZeroHash)
 	b.forkChoiceStore = f
 }
 
@@ -373,6 +394,11 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	if err := b.db.EnsureEmbeddedGenesis(b.ctx); err != nil {
 		return err
 	}
+	if b.checkpointInitializer != nil {
+		if err := b.checkpointInitializer.Initialize(b.ctx, d); err != nil {
+			return errors.Wrap(err, "could not initialize database from checkpoint sync data")
+		}
+	}
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
 		return err
@@ -477,25 +503,28 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootstrapNodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           dataDir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint64(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
-		DB:                b.db,
+		NoDiscovery:        cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:        slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:       slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.TrustedPeers.Name)),
+		BootstrapNodeAddr:  bootstrapNodeAddrs,
+		RelayNodeAddr:      cliCtx.String(cmd.RelayNode.Name),
+		DataDir:            dataDir,
+		LocalIP:            cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:        cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:            cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:         cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:        cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:            cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:            cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:           cliCtx.Uint64(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:      cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:       slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:         cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:      cliCtx.Bool(flags.DisableDiscv5.Name),
+		GossipTraceFile:    cliCtx.String(flags.GossipTraceFile.Name),
+		GossipTraceMaxSize: cliCtx.Uint64(flags.GossipTraceMaxSize.Name),
+		StateNotifier:      b,
+		DB:                 b.db,
 	})
 	if err != nil {
 		return err
@@ -538,6 +567,7 @@ func (b *BeaconNode) registerBlockchainService() error {
 		blockchain.WithDatabase(b.db),
 		blockchain.WithDepositCache(b.depositCache),
 		blockchain.WithChainStartFetcher(web3Service),
+		blockchain.WithExecutionEngineCaller(web3Service),
 		blockchain.WithAttestationPool(b.attestationPool),
 		blockchain.WithExitPool(b.exitPool),
 		blockchain.WithSlashingPool(b.slashingsPool),
@@ -548,6 +578,8 @@ func (b *BeaconNode) registerBlockchainService() error {
 		blockchain.WithStateGen(b.stateGen),
 		blockchain.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		blockchain.WithFinalizedStateAtStartUp(b.finalizedStateAtStartUp),
+		blockchain.WithProposerIdsCache(b.proposerIdsCache),
+		blockchain.WithFeeRecipientCache(b.feeRecipientCache),
 	)
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
 	if err != nil {
@@ -621,6 +653,7 @@ func (b *BeaconNode) registerSyncService() error {
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
+		regularsync.WithExecutionEngineCaller(web3Service),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -641,6 +674,55 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	// Backfilled blocks would be deleted right away when history is pruned.
+	if b.cliCtx.Bool(flags.PruneHistory.Name) {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		P2P:         b.fetchP2P(),
+		DB:          b.db,
+		Chain:       chainService,
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerPrunerService() error {
+	if !b.cliCtx.Bool(flags.PruneHistory.Name) {
+		return nil
+	}
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	ps := pruner.NewService(b.ctx, &pruner.Config{
+		DB:              b.db,
+		Chain:           chainService,
+		RetentionEpochs: types.Epoch(b.cliCtx.Uint64(flags.PruneHistoryEpochs.Name)),
+	})
+	return b.services.RegisterService(ps)
+}
+
+func (b *BeaconNode) registerLightClientService() error {
+	ls := lightclient.NewService(b.ctx, &lightclient.Config{
+		DB:            b.db,
+		StateGen:      b.stateGen,
+		StateNotifier: b,
+	})
+	return b.services.RegisterService(ls)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -687,6 +769,21 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	// Blocks are not backfilled when history is pruned.
+	var backfillChecker backfill.Checker
+	if !b.cliCtx.Bool(flags.PruneHistory.Name) {
+		var backfillService *backfill.Service
+		if err := b.services.FetchService(&backfillService); err != nil {
+			return err
+		}
+		backfillChecker = backfillService
+	}
+
+	var lightClientService *lightclient.Service
+	if err := b.services.FetchService(&lightClientService); err != nil {
+		return err
+	}
+
 	var slasherService *slasher.Service
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
@@ -736,6 +833,7 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		BandwidthProvider:       p2pService,
 		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
@@ -756,6 +854,8 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		BackfillService:         backfillChecker,
+		LightClientFetcher:      lightClientService,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
@@ -764,6 +864,9 @@ func (b *BeaconNode) registerRPCService() error {
 		StateGen:                b.stateGen,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		MaxMsgSize:              maxMsgSize,
+		ExecutionEngineCaller:   web3Service,
+		ProposerIdsCache:        b.proposerIdsCache,
+		FeeRecipientCache:       b.feeRecipientCache,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -2,6 +2,7 @@ package node
 
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/checkpoint"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 )
 
@@ -23,3 +24,12 @@ func WithPowchainFlagOptions(opts []powchain.Option) Option {
 		return nil
 	}
 }
+
+// WithCheckpointInitializer sets the initializer of the database from checkpoint sync data,
+// which is run once the database is opened.
+func WithCheckpointInitializer(initializer checkpoint.Initializer) Option {
+	return func(bn *BeaconNode) error {
+		bn.checkpointInitializer = initializer
+		return nil
+	}
+}
@@ -0,0 +1,272 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+)
+
+// bandwidthIdleTimeout is how long the bandwidth used with a peer or over a protocol is kept
+// after its last use, after which it is no longer accounted for.
+const bandwidthIdleTimeout = time.Hour
+
+// bandwidthFromStats converts the bandwidth statistics of libp2p.
+func bandwidthFromStats(stats metrics.Stats) types.Bandwidth {
+	return types.Bandwidth{In: uint64(stats.TotalIn), Out: uint64(stats.TotalOut)}
+}
+
+// BandwidthTotals returns the bandwidth used over all the streams of the host.
+func (s *Service) BandwidthTotals() types.Bandwidth {
+	return bandwidthFromStats(s.bandwidth.GetBandwidthTotals())
+}
+
+// BandwidthForPeer returns the bandwidth used over the streams with the peer.
+func (s *Service) BandwidthForPeer(pid peer.ID) types.Bandwidth {
+	return bandwidthFromStats(s.bandwidth.GetBandwidthForPeer(pid))
+}
+
+// BandwidthForPeerByProtocol returns the bandwidth used with the peer over the streams of each
+// protocol.
+func (s *Service) BandwidthForPeerByProtocol(pid peer.ID) map[string]types.Bandwidth {
+	return s.bandwidth.peers.forPeer(pid)
+}
+
+// BandwidthByProtocol returns the bandwidth used over the streams of each protocol, including
+// the req/resp protocols and the gossipsub protocol.
+func (s *Service) BandwidthByProtocol() map[string]types.Bandwidth {
+	byProtocol := s.bandwidth.GetBandwidthByProtocol()
+	res := make(map[string]types.Bandwidth, len(byProtocol))
+	for p, stats := range byProtocol {
+		res[string(p)] = bandwidthFromStats(stats)
+	}
+	return res
+}
+
+// BandwidthByTopic returns the bandwidth used by the messages of each gossip topic.
+func (s *Service) BandwidthByTopic() map[string]types.Bandwidth {
+	return s.topicBandwidth.byTopic()
+}
+
+// BandwidthForPeerByTopic returns the bandwidth used with the peer by the messages of each gossip
+// topic.
+func (s *Service) BandwidthForPeerByTopic(pid peer.ID) map[string]types.Bandwidth {
+	return s.topicBandwidth.peers.forPeer(pid)
+}
+
+// trimIdleBandwidth stops accounting for the bandwidth of the peers and protocols idle since the
+// given time.
+func (s *Service) trimIdleBandwidth(since time.Time) {
+	s.bandwidth.TrimIdle(since)
+	s.bandwidth.peers.trimIdle(since)
+	s.topicBandwidth.peers.trimIdle(since)
+}
+
+// peerBandwidth accounts for the bandwidth used with each peer by each protocol or gossip topic.
+type peerBandwidth struct {
+	lock  sync.Mutex
+	peers map[peer.ID]*peerUsage
+}
+
+type peerUsage struct {
+	lastUsed time.Time
+	usages   map[string]*types.Bandwidth
+}
+
+func newPeerBandwidth() *peerBandwidth {
+	return &peerBandwidth{peers: make(map[peer.ID]*peerUsage)}
+}
+
+func (b *peerBandwidth) add(pid peer.ID, name string, in, out uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	u, ok := b.peers[pid]
+	if !ok {
+		u = &peerUsage{usages: make(map[string]*types.Bandwidth)}
+		b.peers[pid] = u
+	}
+	u.lastUsed = time.Now()
+	bw, ok := u.usages[name]
+	if !ok {
+		bw = &types.Bandwidth{}
+		u.usages[name] = bw
+	}
+	bw.In += in
+	bw.Out += out
+}
+
+func (b *peerBandwidth) forPeer(pid peer.ID) map[string]types.Bandwidth {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	u, ok := b.peers[pid]
+	if !ok {
+		return map[string]types.Bandwidth{}
+	}
+	res := make(map[string]types.Bandwidth, len(u.usages))
+	for name, bw := range u.usages {
+		res[name] = *bw
+	}
+	return res
+}
+
+func (b *peerBandwidth) trimIdle(since time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for pid, u := range b.peers {
+		if u.lastUsed.Before(since) {
+			delete(b.peers, pid)
+		}
+	}
+}
+
+var _ = metrics.Reporter(&bandwidthCounter{})
+
+// bandwidthCounter is the bandwidth counter of libp2p, which also accounts for the bandwidth used
+// with each peer over each protocol.
+type bandwidthCounter struct {
+	*metrics.BandwidthCounter
+	peers *peerBandwidth
+}
+
+func newBandwidthCounter() *bandwidthCounter {
+	return &bandwidthCounter{
+		BandwidthCounter: metrics.NewBandwidthCounter(),
+		peers:            newPeerBandwidth(),
+	}
+}
+
+// LogSentMessageStream accounts for the bytes sent to the peer over a stream of the protocol.
+func (c *bandwidthCounter) LogSentMessageStream(size int64, proto protocol.ID, p peer.ID) {
+	c.BandwidthCounter.LogSentMessageStream(size, proto, p)
+	c.peers.add(p, string(proto), 0, uint64(size))
+}
+
+// LogRecvMessageStream accounts for the bytes received from the peer over a stream of the protocol.
+func (c *bandwidthCounter) LogRecvMessageStream(size int64, proto protocol.ID, p peer.ID) {
+	c.BandwidthCounter.LogRecvMessageStream(size, proto, p)
+	c.peers.add(p, string(proto), uint64(size), 0)
+}
+
+var _ = pubsub.RawTracer(&topicBandwidthTracer{})
+
+// topicBandwidthTracer accounts for the size of the messages received and sent on each gossip
+// topic, over all the peers and with each peer. Control messages are not attributed to any topic.
+type topicBandwidthTracer struct {
+	lock   sync.Mutex
+	topics map[string]*types.Bandwidth
+	peers  *peerBandwidth
+	self   peer.ID
+}
+
+func newTopicBandwidthTracer(self peer.ID) *topicBandwidthTracer {
+	return &topicBandwidthTracer{
+		topics: make(map[string]*types.Bandwidth),
+		peers:  newPeerBandwidth(),
+		self:   self,
+	}
+}
+
+func (t *topicBandwidthTracer) byTopic() map[string]types.Bandwidth {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	res := make(map[string]types.Bandwidth, len(t.topics))
+	for topic, bw := range t.topics {
+		res[topic] = *bw
+	}
+	return res
+}
+
+// topicBandwidth returns the bandwidth of the topic, which must be called with the lock held.
+func (t *topicBandwidthTracer) topicBandwidth(topic string) *types.Bandwidth {
+	bw, ok := t.topics[topic]
+	if !ok {
+		bw = &types.Bandwidth{}
+		t.topics[topic] = bw
+	}
+	return bw
+}
+
+// RecvRPC accounts for the messages received from a peer.
+func (t *topicBandwidthTracer) RecvRPC(rpc *pubsub.RPC) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, msg := range rpc.Publish {
+		t.topicBandwidth(msg.GetTopic()).In += uint64(msg.Size())
+	}
+}
+
+// SendRPC accounts for the messages sent to a peer.
+func (t *topicBandwidthTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, msg := range rpc.Publish {
+		size := uint64(msg.Size())
+		t.topicBandwidth(msg.GetTopic()).Out += size
+		t.peers.add(p, msg.GetTopic(), 0, size)
+	}
+}
+
+// received accounts for a message received from a peer. The peer an RPC is received from is not
+// exposed to tracers, so the messages are accounted for per peer once pubsub handles them, which
+// leaves out those of the topics the node is not subscribed to. The messages published by the node
+// are handled as received from itself, and are not accounted for.
+func (t *topicBandwidthTracer) received(msg *pubsub.Message) {
+	if msg.ReceivedFrom == t.self {
+		return
+	}
+	t.peers.add(msg.ReceivedFrom, msg.GetTopic(), uint64(msg.Message.Size()), 0)
+}
+
+// AddPeer is not accounted for.
+func (t *topicBandwidthTracer) AddPeer(_ peer.ID, _ protocol.ID) {}
+
+// RemovePeer is not accounted for.
+func (t *topicBandwidthTracer) RemovePeer(_ peer.ID) {}
+
+// Join is not accounted for.
+func (t *topicBandwidthTracer) Join(_ string) {}
+
+// Leave is not accounted for.
+func (t *topicBandwidthTracer) Leave(_ string) {}
+
+// Graft is not accounted for.
+func (t *topicBandwidthTracer) Graft(_ peer.ID, _ string) {}
+
+// Prune is not accounted for.
+func (t *topicBandwidthTracer) Prune(_ peer.ID, _ string) {}
+
+// ValidateMessage accounts for a message received from a peer, before it is validated.
+func (t *topicBandwidthTracer) ValidateMessage(msg *pubsub.Message) {
+	t.received(msg)
+}
+
+// DeliverMessage is not accounted for.
+func (t *topicBandwidthTracer) DeliverMessage(_ *pubsub.Message) {}
+
+// RejectMessage accounts for a message received from a peer and rejected before validation. The
+// messages rejected by validation were accounted for when they were validated.
+func (t *topicBandwidthTracer) RejectMessage(msg *pubsub.Message, reason string) {
+	switch reason {
+	case pubsub.RejectValidationFailed, pubsub.RejectValidationIgnored, pubsub.RejectValidationThrottled:
+		return
+	}
+	t.received(msg)
+}
+
+// DuplicateMessage accounts for a message received from a peer that was already seen.
+func (t *topicBandwidthTracer) DuplicateMessage(msg *pubsub.Message) {
+	t.received(msg)
+}
+
+// ThrottlePeer is not accounted for.
+func (t *topicBandwidthTracer) ThrottlePeer(_ peer.ID) {}
+
+// DropRPC is not accounted for.
+func (t *topicBandwidthTracer) DropRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// UndeliverableMessage is not accounted for.
+func (t *topicBandwidthTracer) UndeliverableMessage(_ *pubsub.Message) {}
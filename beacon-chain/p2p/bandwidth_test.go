@@ -0,0 +1,92 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestService_Bandwidth(t *testing.T) {
+	self, err := peer.Decode("16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	pid, err := peer.Decode("16Uiu2HAkyKhpwhAEjKpRNdmVshJYXhKQDW9a1g2ALzCxQwW7YhfN")
+	require.NoError(t, err)
+	other, err := peer.Decode("16Uiu2HAkuYiVvrVBtYuwNfY3WK2uE3EkeW8jn6tSjHvYHj4Zvrbm")
+	require.NoError(t, err)
+	s := &Service{
+		bandwidth:      newBandwidthCounter(),
+		topicBandwidth: newTopicBandwidthTracer(self),
+	}
+	blocksByRange := "/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy"
+	// Streams report their bandwidth both in total and per protocol and peer.
+	recv := func(size int64, p protocol.ID) {
+		s.bandwidth.LogRecvMessage(size)
+		s.bandwidth.LogRecvMessageStream(size, p, pid)
+	}
+	sent := func(size int64, p protocol.ID) {
+		s.bandwidth.LogSentMessage(size)
+		s.bandwidth.LogSentMessageStream(size, p, pid)
+	}
+	recv(100, "/meshsub/1.1.0")
+	sent(300, "/meshsub/1.1.0")
+	sent(1000, protocol.ID(blocksByRange))
+
+	// The meters of libp2p are updated in the background once per second.
+	for i := 0; i < 30 && s.BandwidthTotals().Out != 1300; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Equal(t, types.Bandwidth{In: 100, Out: 1300}, s.BandwidthTotals())
+	assert.Equal(t, types.Bandwidth{In: 100, Out: 1300}, s.BandwidthForPeer(pid))
+	assert.DeepEqual(t, map[string]types.Bandwidth{
+		"/meshsub/1.1.0": {In: 100, Out: 300},
+		blocksByRange:    {Out: 1000},
+	}, s.BandwidthByProtocol())
+	assert.DeepEqual(t, map[string]types.Bandwidth{
+		"/meshsub/1.1.0": {In: 100, Out: 300},
+		blocksByRange:    {Out: 1000},
+	}, s.BandwidthForPeerByProtocol(pid))
+	assert.DeepEqual(t, map[string]types.Bandwidth{}, s.BandwidthForPeerByProtocol(other))
+
+	blockTopic := "/eth2/4a26c58b/beacon_block/ssz_snappy"
+	aggTopic := "/eth2/4a26c58b/beacon_aggregate_and_proof/ssz_snappy"
+	block := &pubsubpb.Message{Topic: &blockTopic, Data: make([]byte, 500)}
+	agg := &pubsubpb.Message{Topic: &aggTopic, Data: make([]byte, 50)}
+	s.topicBandwidth.RecvRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Publish: []*pubsubpb.Message{block, agg}}})
+	s.topicBandwidth.RecvRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Publish: []*pubsubpb.Message{agg}}})
+	s.topicBandwidth.SendRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Publish: []*pubsubpb.Message{block}}}, pid)
+	// Control messages are not attributed to topics.
+	s.topicBandwidth.SendRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Control: &pubsubpb.ControlMessage{}}}, pid)
+
+	assert.DeepEqual(t, map[string]types.Bandwidth{
+		blockTopic: {In: uint64(block.Size()), Out: uint64(block.Size())},
+		aggTopic:   {In: 2 * uint64(agg.Size())},
+	}, s.BandwidthByTopic())
+
+	// Received messages are accounted for per peer as pubsub handles them, once each.
+	s.topicBandwidth.ValidateMessage(&pubsub.Message{Message: block, ReceivedFrom: pid})
+	s.topicBandwidth.RejectMessage(&pubsub.Message{Message: block, ReceivedFrom: pid}, pubsub.RejectValidationFailed)
+	s.topicBandwidth.DuplicateMessage(&pubsub.Message{Message: agg, ReceivedFrom: pid})
+	s.topicBandwidth.RejectMessage(&pubsub.Message{Message: agg, ReceivedFrom: other}, pubsub.RejectValidationQueueFull)
+	// The messages published by the node are not received from a peer.
+	s.topicBandwidth.ValidateMessage(&pubsub.Message{Message: agg, ReceivedFrom: self})
+	assert.DeepEqual(t, map[string]types.Bandwidth{
+		blockTopic: {In: uint64(block.Size()), Out: uint64(block.Size())},
+		aggTopic:   {In: uint64(agg.Size())},
+	}, s.BandwidthForPeerByTopic(pid))
+	assert.DeepEqual(t, map[string]types.Bandwidth{
+		aggTopic: {In: uint64(agg.Size())},
+	}, s.BandwidthForPeerByTopic(other))
+	assert.DeepEqual(t, map[string]types.Bandwidth{}, s.BandwidthForPeerByTopic(self))
+
+	// The peers idle since the given time are no longer accounted for.
+	s.trimIdleBandwidth(time.Now().Add(time.Minute))
+	assert.DeepEqual(t, map[string]types.Bandwidth{}, s.BandwidthForPeerByProtocol(pid))
+	assert.DeepEqual(t, map[string]types.Bandwidth{}, s.BandwidthForPeerByTopic(pid))
+}
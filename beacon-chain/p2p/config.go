@@ -12,6 +12,7 @@ type Config struct {
 	EnableUPnP          bool
 	DisableDiscv5       bool
 	StaticPeers         []string
+	TrustedPeers        []string
 	BootstrapNodeAddr   []string
 	Discv5BootStrapAddr []string
 	RelayNodeAddr       string
@@ -26,6 +27,8 @@ type Config struct {
 	MaxPeers            uint64
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	GossipTraceFile     string
+	GossipTraceMaxSize  uint64
 	StateNotifier       statefeed.Notifier
-	DB                  db.ReadOnlyDatabase
+	DB                  db.NoHeadAccessDatabase
 }
@@ -25,8 +25,9 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Disallow dialing banned peers.
+	return !s.peers.IsBannedPeer(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -59,7 +60,13 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	// Banned peers are only known by their id once the connection is authenticated.
+	if s.peers.IsBannedPeer(pid) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned peer"}).Trace("Not accepting connection")
+		return false
+	}
 	return true
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -95,6 +96,35 @@ func TestService_InterceptBannedIP(t *testing.T) {
 	}
 }
 
+func TestService_InterceptBannedPeer(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    20,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	multiAddress, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	pid := peer.ID("peer1")
+
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptAddrDial(pid, multiAddress))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}))
+
+	s.peers.SetBannedPeers([]peer.ID{pid})
+	assert.Equal(t, false, s.InterceptPeerDial(pid))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, multiAddress))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}))
+
+	// Trusting the peer lifts the ban.
+	s.peers.SetTrustedPeers([]peer.ID{pid})
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}))
+}
+
 func TestService_RejectInboundPeersBeyondLimit(t *testing.T) {
 	limit := 20
 	s := &Service{
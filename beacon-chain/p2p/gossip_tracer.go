@@ -0,0 +1,190 @@
+package p2p
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/gossiptrace"
+)
+
+// gossipTraceBackups is the number of rotated capture files kept besides the current one.
+const gossipTraceBackups = 5
+
+// gossipTraceQueueSize is the number of events buffered before they are written to the capture.
+// Events are dropped while the queue is full, so that tracing never slows down gossip.
+const gossipTraceQueueSize = 4096
+
+// gossipTraceFlushInterval is the interval at which buffered records are written to the capture file.
+const gossipTraceFlushInterval = time.Second
+
+// maxTracedValidations is the maximum number of messages in validation tracked for their latency.
+// Messages whose validation outcome is never traced would otherwise accumulate.
+const maxTracedValidations = 16384
+
+var _ = pubsub.RawTracer(&gossipTracer{})
+
+// gossipTracer records the gossip messages received by the node, with the outcome and latency of
+// their validation, to a capture file. The events of pubsub are only queued, and their records are
+// built by the writer, so that computing message IDs does not slow down gossip.
+type gossipTracer struct {
+	writer *gossiptrace.Writer
+	msgID  func(pmsg *pubsubpb.Message) string
+	events chan *gossipTraceEvent
+	// validations is the time at which the validation of each message started, which is only
+	// accessed by the writer.
+	validations map[string]time.Time
+}
+
+// gossipTraceEvent is an event of a message, queued to be recorded.
+type gossipTraceEvent struct {
+	time   time.Time
+	event  string
+	msg    *pubsub.Message
+	result string
+}
+
+// newGossipTracer opens the capture file at the path, which is rotated once it exceeds the maximum
+// size in bytes.
+func newGossipTracer(path string, maxSize int64, msgID func(pmsg *pubsubpb.Message) string) (*gossipTracer, error) {
+	w, err := gossiptrace.NewWriter(path, maxSize, gossipTraceBackups)
+	if err != nil {
+		return nil, err
+	}
+	return &gossipTracer{
+		writer:      w,
+		msgID:       msgID,
+		events:      make(chan *gossipTraceEvent, gossipTraceQueueSize),
+		validations: make(map[string]time.Time),
+	}, nil
+}
+
+// run writes the records of the traced events to the capture file until the context is canceled.
+func (t *gossipTracer) run(ctx context.Context) {
+	ticker := time.NewTicker(gossipTraceFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case e := <-t.events:
+			if err := t.writer.Write(t.toRecord(e)); err != nil {
+				log.WithError(err).Error("Could not write gossip trace record")
+			}
+		case <-ticker.C:
+			if err := t.writer.Flush(); err != nil {
+				log.WithError(err).Error("Could not flush gossip trace records")
+			}
+		case <-ctx.Done():
+			if err := t.writer.Close(); err != nil {
+				log.WithError(err).Error("Could not close gossip trace file")
+			}
+			return
+		}
+	}
+}
+
+// toRecord builds the record of the event, computing the message ID once, and times the validation
+// of the message.
+func (t *gossipTracer) toRecord(e *gossipTraceEvent) *gossiptrace.Record {
+	id := t.msgID(e.msg.Message)
+	r := &gossiptrace.Record{
+		Time:      e.time.UnixNano(),
+		Event:     e.event,
+		Topic:     e.msg.GetTopic(),
+		MessageID: hex.EncodeToString([]byte(id)),
+		Size:      len(e.msg.Data),
+		Result:    e.result,
+	}
+	if e.msg.ReceivedFrom != "" {
+		r.Peer = e.msg.ReceivedFrom.String()
+	}
+	switch e.event {
+	case gossiptrace.EventReceived:
+		if len(t.validations) >= maxTracedValidations {
+			t.validations = make(map[string]time.Time)
+		}
+		t.validations[id] = e.time
+	case gossiptrace.EventDelivered, gossiptrace.EventRejected:
+		// Messages rejected before validation were never received for validation.
+		if start, ok := t.validations[id]; ok {
+			r.Latency = int64(e.time.Sub(start))
+			delete(t.validations, id)
+		}
+	}
+	return r
+}
+
+// record queues an event of the message, without blocking.
+func (t *gossipTracer) record(event string, msg *pubsub.Message, result string) {
+	select {
+	case t.events <- &gossipTraceEvent{time: time.Now(), event: event, msg: msg, result: result}:
+	default:
+		gossipTraceDroppedRecords.Inc()
+	}
+}
+
+// ValidateMessage traces a new message received from a peer, and starts timing its validation.
+func (t *gossipTracer) ValidateMessage(msg *pubsub.Message) {
+	t.record(gossiptrace.EventReceived, msg, "")
+}
+
+// DeliverMessage traces a message accepted by validation.
+func (t *gossipTracer) DeliverMessage(msg *pubsub.Message) {
+	t.record(gossiptrace.EventDelivered, msg, gossiptrace.ResultAccept)
+}
+
+// RejectMessage traces a message rejected or ignored by validation, or rejected before it for the
+// given reason.
+func (t *gossipTracer) RejectMessage(msg *pubsub.Message, reason string) {
+	result := reason
+	switch reason {
+	case pubsub.RejectValidationFailed:
+		result = gossiptrace.ResultReject
+	case pubsub.RejectValidationIgnored:
+		result = gossiptrace.ResultIgnore
+	}
+	t.record(gossiptrace.EventRejected, msg, result)
+}
+
+// DuplicateMessage traces an already seen message received again.
+func (t *gossipTracer) DuplicateMessage(msg *pubsub.Message) {
+	t.record(gossiptrace.EventDuplicated, msg, "")
+}
+
+// UndeliverableMessage traces a message dropped because a subscriber was too slow to receive it.
+func (t *gossipTracer) UndeliverableMessage(msg *pubsub.Message) {
+	t.record(gossiptrace.EventUndeliverable, msg, "")
+}
+
+// AddPeer is not traced.
+func (t *gossipTracer) AddPeer(_ peer.ID, _ protocol.ID) {}
+
+// RemovePeer is not traced.
+func (t *gossipTracer) RemovePeer(_ peer.ID) {}
+
+// Join is not traced.
+func (t *gossipTracer) Join(_ string) {}
+
+// Leave is not traced.
+func (t *gossipTracer) Leave(_ string) {}
+
+// Graft is not traced.
+func (t *gossipTracer) Graft(_ peer.ID, _ string) {}
+
+// Prune is not traced.
+func (t *gossipTracer) Prune(_ peer.ID, _ string) {}
+
+// ThrottlePeer is not traced.
+func (t *gossipTracer) ThrottlePeer(_ peer.ID) {}
+
+// RecvRPC is not traced.
+func (t *gossipTracer) RecvRPC(_ *pubsub.RPC) {}
+
+// SendRPC is not traced.
+func (t *gossipTracer) SendRPC(_ *pubsub.RPC, _ peer.ID) {}
+
+// DropRPC is not traced.
+func (t *gossipTracer) DropRPC(_ *pubsub.RPC, _ peer.ID) {}
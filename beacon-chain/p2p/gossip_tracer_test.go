@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"context"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/gossiptrace"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestGossipTracer_RecordsMessages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	msgIDs := 0
+	msgID := func(pmsg *pubsubpb.Message) string {
+		msgIDs++
+		return string(pmsg.Data)
+	}
+	tracer, err := newGossipTracer(path, 1<<20, msgID)
+	require.NoError(t, err)
+
+	pid, err := peer.Decode("16Uiu2HAkyKhpwhAEjKpRNdmVshJYXhKQDW9a1g2ALzCxQwW7YhfN")
+	require.NoError(t, err)
+	topic := "/eth2/4a26c58b/beacon_block/ssz_snappy"
+	newMsg := func(data string) *pubsub.Message {
+		return &pubsub.Message{Message: &pubsubpb.Message{Topic: &topic, Data: []byte(data)}, ReceivedFrom: pid}
+	}
+
+	accepted, rejected, ignored := newMsg("accepted"), newMsg("rejected"), newMsg("ignored")
+	tracer.ValidateMessage(accepted)
+	tracer.ValidateMessage(rejected)
+	tracer.ValidateMessage(ignored)
+	time.Sleep(time.Millisecond)
+	tracer.DeliverMessage(accepted)
+	tracer.RejectMessage(rejected, pubsub.RejectValidationFailed)
+	tracer.RejectMessage(ignored, pubsub.RejectValidationIgnored)
+	tracer.DuplicateMessage(accepted)
+	tracer.RejectMessage(newMsg("throttled"), pubsub.RejectValidationThrottled)
+	// The message IDs are computed by the writer, not by pubsub.
+	assert.Equal(t, 0, msgIDs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	exited := make(chan struct{})
+	go func() {
+		tracer.run(ctx)
+		close(exited)
+	}()
+	// Wait for the queued events to be written before closing the capture.
+	for len(tracer.events) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-exited
+
+	var records []*gossiptrace.Record
+	require.NoError(t, gossiptrace.ReadFile(path, func(r *gossiptrace.Record) error {
+		records = append(records, r)
+		return nil
+	}))
+	require.Equal(t, 8, len(records))
+	// The message ID of each event is computed once.
+	assert.Equal(t, 8, msgIDs)
+
+	for _, r := range records[:3] {
+		assert.Equal(t, gossiptrace.EventReceived, r.Event)
+		assert.Equal(t, topic, r.Topic)
+		assert.Equal(t, pid.String(), r.Peer)
+	}
+	assert.Equal(t, hex.EncodeToString([]byte("accepted")), records[0].MessageID)
+	assert.Equal(t, len("accepted"), records[0].Size)
+
+	delivered := records[3]
+	assert.Equal(t, gossiptrace.EventDelivered, delivered.Event)
+	assert.Equal(t, gossiptrace.ResultAccept, delivered.Result)
+	assert.Equal(t, true, delivered.Latency >= int64(time.Millisecond))
+
+	assert.Equal(t, gossiptrace.EventRejected, records[4].Event)
+	assert.Equal(t, gossiptrace.ResultReject, records[4].Result)
+	assert.Equal(t, true, records[4].Latency > 0)
+	assert.Equal(t, gossiptrace.EventRejected, records[5].Event)
+	assert.Equal(t, gossiptrace.ResultIgnore, records[5].Result)
+
+	assert.Equal(t, gossiptrace.EventDuplicated, records[6].Event)
+	assert.Equal(t, hex.EncodeToString([]byte("accepted")), records[6].MessageID)
+
+	// Messages rejected before validation have the reason as result, and no latency.
+	assert.Equal(t, gossiptrace.EventRejected, records[7].Event)
+	assert.Equal(t, pubsub.RejectValidationThrottled, records[7].Result)
+	assert.Equal(t, int64(0), records[7].Latency)
+
+	// The validations of traced outcomes are no longer tracked.
+	assert.Equal(t, 0, len(tracer.validations))
+}
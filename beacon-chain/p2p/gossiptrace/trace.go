@@ -0,0 +1,204 @@
+// Package gossiptrace defines the records of a gossip message capture, which traces the gossip
+// messages handled by a beacon node, and how captures are written to and read from rotating
+// files of JSON lines.
+package gossiptrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/io/file"
+)
+
+// Events of the records of a capture.
+const (
+	// EventReceived is the event of a new message received from a peer, entering validation.
+	EventReceived = "received"
+	// EventDelivered is the event of a message accepted by validation and delivered to the subscribers.
+	EventDelivered = "delivered"
+	// EventRejected is the event of a message rejected or ignored by validation, or rejected before it.
+	EventRejected = "rejected"
+	// EventDuplicated is the event of an already seen message received again, and dropped.
+	EventDuplicated = "duplicated"
+	// EventUndeliverable is the event of a delivered message dropped because a subscriber was too slow.
+	EventUndeliverable = "undeliverable"
+)
+
+// Validation results of the records of a capture.
+const (
+	// ResultAccept is the result of a message accepted by validation.
+	ResultAccept = "accept"
+	// ResultReject is the result of a message rejected by validation.
+	ResultReject = "reject"
+	// ResultIgnore is the result of a message ignored by validation.
+	ResultIgnore = "ignore"
+)
+
+// Record is a traced event of a gossip message.
+type Record struct {
+	// Time is the unix time of the event, in nanoseconds.
+	Time      int64  `json:"time"`
+	Event     string `json:"event"`
+	Topic     string `json:"topic"`
+	Peer      string `json:"peer,omitempty"`
+	MessageID string `json:"message_id"`
+	Size      int    `json:"size,omitempty"`
+	// Result is the validation result of delivered and rejected messages. Messages rejected
+	// before validation have the reason of the rejection as result.
+	Result string `json:"result,omitempty"`
+	// Latency is the time spent validating delivered and rejected messages, in nanoseconds.
+	Latency int64 `json:"latency,omitempty"`
+}
+
+// Writer writes records to a capture file, rotating it once it exceeds its maximum size. Rotated
+// files are suffixed by their rotation order, .1 being the most recent, and the oldest are deleted
+// beyond the maximum number of backups.
+type Writer struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	buf        *bufio.Writer
+	size       int64
+}
+
+// NewWriter opens the capture file at the path for appending records.
+func NewWriter(path string, maxSize int64, maxBackups int) (*Writer, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("maximum capture file size must be positive")
+	}
+	dir := filepath.Dir(path)
+	exists, err := file.HasDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := file.MkdirAll(dir); err != nil {
+			return nil, errors.Wrap(err, "could not create capture directory")
+		}
+	}
+	w := &Writer{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends a record to the capture file.
+func (w *Writer) Write(r *Record) error {
+	enc, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "could not encode record")
+	}
+	enc = append(enc, '\n')
+	if w.size > 0 && w.size+int64(len(enc)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.buf.Write(enc)
+	w.size += int64(n)
+	return err
+}
+
+// Flush writes the buffered records to the capture file.
+func (w *Writer) Flush() error {
+	return w.buf.Flush()
+}
+
+// Close flushes the buffered records and closes the capture file.
+func (w *Writer) Close() error {
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	return w.file.Close()
+}
+
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "could not open capture file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	w.file = f
+	w.buf = bufio.NewWriter(f)
+	w.size = info.Size()
+	return nil
+}
+
+func (w *Writer) rotate() error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if w.maxBackups <= 0 {
+		if err := os.Remove(w.path); err != nil {
+			return err
+		}
+		return w.open()
+	}
+	for i := w.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(backupPath(w.path, i), backupPath(w.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(w.path, backupPath(w.path, 1)); err != nil {
+		return err
+	}
+	return w.open()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+// Files returns the existing files of the capture at the path, from the oldest rotated file to the
+// current one.
+func Files(path string) []string {
+	var backups []string
+	for i := 1; file.FileExists(backupPath(path, i)); i++ {
+		backups = append(backups, backupPath(path, i))
+	}
+	files := make([]string, 0, len(backups)+1)
+	for i := len(backups) - 1; i >= 0; i-- {
+		files = append(files, backups[i])
+	}
+	if file.FileExists(path) {
+		files = append(files, path)
+	}
+	return files
+}
+
+// ReadFile calls the function with each record of the capture file, in order, until the function
+// returns an error.
+func ReadFile(path string, fn func(r *Record) error) error {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not open capture file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close capture file")
+		}
+	}()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		r := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), r); err != nil {
+			return errors.Wrapf(err, "could not decode record at %s:%d", path, line)
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,95 @@
+package gossiptrace
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func testRecord(i int) *Record {
+	return &Record{
+		Time:      int64(i),
+		Event:     EventDelivered,
+		Topic:     "/eth2/4a26c58b/beacon_block/ssz_snappy",
+		Peer:      "16Uiu2HAkyKhpwhAEjKpRNdmVshJYXhKQDW9a1g2ALzCxQwW7YhfN",
+		MessageID: fmt.Sprintf("%040x", i),
+		Size:      100,
+		Result:    ResultAccept,
+		Latency:   1000,
+	}
+}
+
+func readAll(t *testing.T, path string) []*Record {
+	var records []*Record
+	for _, f := range Files(path) {
+		require.NoError(t, ReadFile(f, func(r *Record) error {
+			records = append(records, r)
+			return nil
+		}))
+	}
+	return records
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture", "trace.jsonl")
+	w, err := NewWriter(path, 1<<20, 1)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, w.Write(testRecord(i)))
+	}
+	require.NoError(t, w.Close())
+
+	records := readAll(t, path)
+	require.Equal(t, 10, len(records))
+	for i, r := range records {
+		assert.DeepEqual(t, testRecord(i), r)
+	}
+
+	// Reopening the capture appends to it.
+	w, err = NewWriter(path, 1<<20, 1)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testRecord(10)))
+	require.NoError(t, w.Close())
+	assert.Equal(t, 11, len(readAll(t, path)))
+}
+
+func TestWriter_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	w, err := NewWriter(path, 1000, 2)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, w.Write(testRecord(i)))
+	}
+	require.NoError(t, w.Close())
+
+	files := Files(path)
+	require.DeepEqual(t, []string{path + ".2", path + ".1", path}, files)
+	records := readAll(t, path)
+	require.Equal(t, true, len(records) > 0 && len(records) < 100)
+	// The oldest records were deleted with the oldest rotated file, the remaining ones are in order.
+	last := records[len(records)-1]
+	assert.Equal(t, int64(99), last.Time)
+	for i := 1; i < len(records); i++ {
+		assert.Equal(t, records[i-1].Time+1, records[i].Time)
+	}
+}
+
+func TestNewWriter_InvalidSize(t *testing.T) {
+	_, err := NewWriter(filepath.Join(t.TempDir(), "trace.jsonl"), 0, 1)
+	assert.ErrorContains(t, "must be positive", err)
+}
+
+func TestReadFile_Malformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	w, err := NewWriter(path, 1<<20, 0)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testRecord(0)))
+	_, err = w.buf.WriteString("not a record\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	err = ReadFile(path, func(r *Record) error { return nil })
+	assert.ErrorContains(t, "could not decode record at "+path+":2", err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
 	"google.golang.org/protobuf/proto"
@@ -28,6 +29,7 @@ type P2P interface {
 	Sender
 	ConnectionHandler
 	PeersProvider
+	BandwidthProvider
 	MetadataProvider
 }
 
@@ -91,6 +93,16 @@ type PeersProvider interface {
 	Peers() *peers.Status
 }
 
+// BandwidthProvider provides the bandwidth used by the node with its peers.
+type BandwidthProvider interface {
+	BandwidthTotals() types.Bandwidth
+	BandwidthForPeer(pid peer.ID) types.Bandwidth
+	BandwidthForPeerByProtocol(pid peer.ID) map[string]types.Bandwidth
+	BandwidthForPeerByTopic(pid peer.ID) map[string]types.Bandwidth
+	BandwidthByProtocol() map[string]types.Bandwidth
+	BandwidthByTopic() map[string]types.Bandwidth
+}
+
 // MetadataProvider returns the metadata related information for the local peer.
 type MetadataProvider interface {
 	Metadata() metadata.Metadata
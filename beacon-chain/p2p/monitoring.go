@@ -3,6 +3,7 @@ package p2p
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 )
 
 var (
@@ -15,6 +16,25 @@ var (
 		Name: "libp2p_peers",
 		Help: "Tracks the total number of libp2p peers",
 	})
+	gossipTraceDroppedRecords = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_trace_dropped_records",
+		Help: "The number of gossip trace records dropped because the capture could not keep up.",
+	})
+	bandwidthBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_bandwidth_bytes",
+		Help: "The number of bytes received from (in) and sent to (out) peers over all the streams.",
+	},
+		[]string{"direction"})
+	protocolBandwidthBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_protocol_bandwidth_bytes",
+		Help: "The number of bytes received from (in) and sent to (out) peers over the streams of a protocol.",
+	},
+		[]string{"protocol", "direction"})
+	topicBandwidthBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_topic_bandwidth_bytes",
+		Help: "The number of bytes of the messages received from (in) and sent to (out) peers on a gossip topic.",
+	},
+		[]string{"topic", "direction"})
 	repeatPeerConnections = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
@@ -52,4 +72,16 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+	setBandwidthMetrics(bandwidthBytes.WithLabelValues("in"), bandwidthBytes.WithLabelValues("out"), s.BandwidthTotals())
+	for p, bw := range s.BandwidthByProtocol() {
+		setBandwidthMetrics(protocolBandwidthBytes.WithLabelValues(p, "in"), protocolBandwidthBytes.WithLabelValues(p, "out"), bw)
+	}
+	for topic, bw := range s.BandwidthByTopic() {
+		setBandwidthMetrics(topicBandwidthBytes.WithLabelValues(topic, "in"), topicBandwidthBytes.WithLabelValues(topic, "out"), bw)
+	}
+}
+
+func setBandwidthMetrics(in, out prometheus.Gauge, bw types.Bandwidth) {
+	in.Set(float64(bw.In))
+	out.Set(float64(bw.Out))
 }
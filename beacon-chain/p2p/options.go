@@ -36,6 +36,7 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 		libp2p.UserAgent(version.BuildData()),
 		libp2p.ConnectionGater(s),
 		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.BandwidthReporter(s.bandwidth),
 	}
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
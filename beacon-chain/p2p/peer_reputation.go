@@ -0,0 +1,79 @@
+package p2p
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// peerReputationSaveInterval is how often the reputations of the known peers are persisted.
+const peerReputationSaveInterval = 5 * time.Minute
+
+// loadPeerReputations restores the reputations of peers persisted in the database, and returns
+// the known good peers among them, best scored first, up to the maximum number of peers.
+func (s *Service) loadPeerReputations() []peer.AddrInfo {
+	if s.cfg.DB == nil {
+		return nil
+	}
+	reputations, err := s.cfg.DB.PeerReputations(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not load peer reputations")
+		return nil
+	}
+	restored := s.peers.RestoreReputations(reputations)
+	scorer := s.peers.Scorers()
+	goodPeers := make([]peer.ID, 0, len(restored))
+	for _, pid := range restored {
+		if !s.peers.IsBad(pid) && scorer.Score(pid) >= 0 {
+			goodPeers = append(goodPeers, pid)
+		}
+	}
+	sort.SliceStable(goodPeers, func(i, j int) bool {
+		return scorer.Score(goodPeers[i]) > scorer.Score(goodPeers[j])
+	})
+	addrInfos := make([]peer.AddrInfo, 0, len(goodPeers))
+	for _, pid := range goodPeers {
+		if uint64(len(addrInfos)) >= s.cfg.MaxPeers {
+			break
+		}
+		if info, ok := s.knownAddrInfo(pid); ok {
+			addrInfos = append(addrInfos, info)
+		}
+	}
+	log.WithField("restored", len(restored)).WithField("knownGood", len(addrInfos)).Debug("Loaded peer reputations")
+	return addrInfos
+}
+
+// knownAddrInfo returns the address info of a peer from its last known address, or else from its
+// last known ENR.
+func (s *Service) knownAddrInfo(pid peer.ID) (peer.AddrInfo, bool) {
+	if addr, err := s.peers.Address(pid); err == nil && addr != nil {
+		return peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{addr}}, true
+	}
+	record, err := s.peers.ENR(pid)
+	if err != nil || record == nil {
+		return peer.AddrInfo{}, false
+	}
+	node, err := enode.New(enode.ValidSchemes, record)
+	if err != nil {
+		return peer.AddrInfo{}, false
+	}
+	info, _, err := convertToAddrInfo(node)
+	if err != nil || info.ID != pid {
+		return peer.AddrInfo{}, false
+	}
+	return *info, true
+}
+
+// savePeerReputations persists the reputations of the known peers in the database.
+func (s *Service) savePeerReputations() {
+	if s.cfg.DB == nil {
+		return
+	}
+	if err := s.cfg.DB.SavePeerReputations(s.ctx, s.peers.Reputations()); err != nil {
+		log.WithError(err).Error("Could not save peer reputations")
+	}
+}
@@ -0,0 +1,92 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestService_PeerReputations(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	newService := func() *Service {
+		return &Service{
+			ctx: context.Background(),
+			cfg: &Config{DB: db, MaxPeers: 30},
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit: 30,
+				ScorerParams: &scorers.Config{
+					BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+						Threshold: 2,
+					},
+				},
+			}),
+			genesisTime:           time.Now(),
+			genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+		}
+	}
+	s := newService()
+
+	// A peer with a known address.
+	addrPeer, err := peer.Decode("16Uiu2HAkyKhpwhAEjKpRNdmVshJYXhKQDW9a1g2ALzCxQwW7YhfN")
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/192.0.2.1/tcp/13000")
+	require.NoError(t, err)
+	s.peers.Add(nil, addrPeer, addr, network.DirOutbound)
+
+	// A peer with a known ENR only.
+	pkey, err := privKey(&Config{DataDir: t.TempDir()})
+	require.NoError(t, err)
+	node, err := s.createLocalNode(pkey, net.ParseIP("192.0.2.2"), 13000, 12000)
+	require.NoError(t, err)
+	enrPeerInfo, _, err := convertToAddrInfo(node.Node())
+	require.NoError(t, err)
+	enrPeer := enrPeerInfo.ID
+	s.peers.Add(node.Node().Record(), enrPeer, nil, network.DirInbound)
+
+	// A bad peer, which is not to be dialed.
+	badPeer, err := peer.Decode("16Uiu2HAkv54NSq4WcAp99M6FmEEc4MrKvNPScK9aiwsSt6i1SjfU")
+	require.NoError(t, err)
+	s.peers.Add(nil, badPeer, addr, network.DirInbound)
+	s.peers.Scorers().BadResponsesScorer().Increment(badPeer)
+	s.peers.Scorers().BadResponsesScorer().Increment(badPeer)
+
+	// A peer without address nor ENR, which is not persisted.
+	s.peers.Add(nil, "peer", nil, network.DirInbound)
+
+	s.savePeerReputations()
+	reputations, err := db.PeerReputations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(reputations))
+
+	restarted := newService()
+	knownGood := restarted.loadPeerReputations()
+	require.Equal(t, 2, len(knownGood))
+	for _, info := range knownGood {
+		switch info.ID {
+		case addrPeer:
+			assert.DeepEqual(t, []ma.Multiaddr{addr}, info.Addrs)
+		case enrPeer:
+			assert.DeepEqual(t, enrPeerInfo.Addrs, info.Addrs)
+		default:
+			t.Errorf("Unexpected known good peer %s", info.ID)
+		}
+	}
+	assert.Equal(t, true, restarted.peers.IsBad(badPeer))
+	record, err := restarted.peers.ENR(enrPeer)
+	require.NoError(t, err)
+	assert.Equal(t, node.Node().Record().Seq(), record.Seq())
+
+	// Nothing is loaded without a database.
+	assert.Equal(t, 0, len((&Service{cfg: &Config{}}).loadPeerReputations()))
+}
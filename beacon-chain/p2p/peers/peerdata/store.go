@@ -38,9 +38,11 @@ type StoreConfig struct {
 // the mutex when accessing data.
 type Store struct {
 	sync.RWMutex
-	ctx    context.Context
-	config *StoreConfig
-	peers  map[peer.ID]*PeerData
+	ctx          context.Context
+	config       *StoreConfig
+	peers        map[peer.ID]*PeerData
+	trustedPeers map[peer.ID]bool
+	bannedPeers  map[peer.ID]bool
 }
 
 // PeerData aggregates protocol and application level info about a single peer.
@@ -69,9 +71,11 @@ type PeerData struct {
 // NewStore creates new peer data store.
 func NewStore(ctx context.Context, config *StoreConfig) *Store {
 	return &Store{
-		ctx:    ctx,
-		config: config,
-		peers:  make(map[peer.ID]*PeerData),
+		ctx:          ctx,
+		config:       config,
+		peers:        make(map[peer.ID]*PeerData),
+		trustedPeers: make(map[peer.ID]bool),
+		bannedPeers:  make(map[peer.ID]bool),
 	}
 }
 
@@ -111,6 +115,71 @@ func (s *Store) Peers() map[peer.ID]*PeerData {
 	return s.peers
 }
 
+// SetTrustedPeers adds the peers to the trusted peer set. Trusted peers are exempt from scoring
+// and pruning.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetTrustedPeers(pids []peer.ID) {
+	for _, pid := range pids {
+		s.trustedPeers[pid] = true
+	}
+}
+
+// DeleteTrustedPeers removes the peers from the trusted peer set.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) DeleteTrustedPeers(pids []peer.ID) {
+	for _, pid := range pids {
+		delete(s.trustedPeers, pid)
+	}
+}
+
+// TrustedPeers returns the peers of the trusted peer set.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) TrustedPeers() []peer.ID {
+	pids := make([]peer.ID, 0, len(s.trustedPeers))
+	for pid := range s.trustedPeers {
+		pids = append(pids, pid)
+	}
+	return pids
+}
+
+// IsTrustedPeer checks whether the peer is in the trusted peer set.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) IsTrustedPeer(pid peer.ID) bool {
+	return s.trustedPeers[pid]
+}
+
+// SetBannedPeers adds the peers to the banned peer set. Banned peers are never connected to.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetBannedPeers(pids []peer.ID) {
+	for _, pid := range pids {
+		s.bannedPeers[pid] = true
+	}
+}
+
+// DeleteBannedPeers removes the peers from the banned peer set.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) DeleteBannedPeers(pids []peer.ID) {
+	for _, pid := range pids {
+		delete(s.bannedPeers, pid)
+	}
+}
+
+// BannedPeers returns the peers of the banned peer set.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) BannedPeers() []peer.ID {
+	pids := make([]peer.ID, 0, len(s.bannedPeers))
+	for pid := range s.bannedPeers {
+		pids = append(pids, pid)
+	}
+	return pids
+}
+
+// IsBannedPeer checks whether the peer is in the banned peer set.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) IsBannedPeer(pid peer.ID) bool {
+	return s.bannedPeers[pid]
+}
+
 // Config exposes store configuration params.
 func (s *Store) Config() *StoreConfig {
 	return s.config
@@ -80,3 +80,32 @@ func TestStore_PeerDataGetOrCreate(t *testing.T) {
 	assert.Equal(t, uint64(0), peerData.ProcessedBlocks)
 	require.Equal(t, 1, len(store.Peers()))
 }
+
+func TestStore_TrustedAndBannedPeers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
+		MaxPeers: 12,
+	})
+	pid1, pid2 := peer.ID("00001"), peer.ID("00002")
+	assert.Equal(t, false, store.IsTrustedPeer(pid1))
+	assert.Equal(t, 0, len(store.TrustedPeers()))
+
+	store.SetTrustedPeers([]peer.ID{pid1, pid2})
+	assert.Equal(t, true, store.IsTrustedPeer(pid1))
+	assert.Equal(t, 2, len(store.TrustedPeers()))
+	store.DeleteTrustedPeers([]peer.ID{pid1})
+	assert.Equal(t, false, store.IsTrustedPeer(pid1))
+	assert.DeepEqual(t, []peer.ID{pid2}, store.TrustedPeers())
+	// Trust does not depend on the data of the peer.
+	assert.Equal(t, 0, len(store.Peers()))
+
+	store.SetBannedPeers([]peer.ID{pid1})
+	assert.Equal(t, true, store.IsBannedPeer(pid1))
+	assert.Equal(t, false, store.IsBannedPeer(pid2))
+	assert.DeepEqual(t, []peer.ID{pid1}, store.BannedPeers())
+	store.DeleteBannedPeers([]peer.ID{pid1})
+	assert.Equal(t, false, store.IsBannedPeer(pid1))
+	assert.Equal(t, 0, len(store.BannedPeers()))
+}
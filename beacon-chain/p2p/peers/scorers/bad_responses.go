@@ -114,6 +114,19 @@ func (s *BadResponsesScorer) Increment(pid peer.ID) {
 	peerData.BadResponses++
 }
 
+// restore sets the persisted bad responses count of a peer, decremented once per decay interval
+// elapsed since it was persisted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *BadResponsesScorer) restore(pid peer.ID, count int, elapsed time.Duration) {
+	decays := int(elapsed / s.config.DecayInterval)
+	if count > decays {
+		count -= decays
+	} else {
+		count = 0
+	}
+	s.store.PeerDataGetOrCreate(pid).BadResponses = count
+}
+
 // IsBadPeer states if the peer is to be considered bad.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (s *BadResponsesScorer) IsBadPeer(pid peer.ID) bool {
@@ -124,6 +137,10 @@ func (s *BadResponsesScorer) IsBadPeer(pid peer.ID) bool {
 
 // isBadPeer is lock-free version of IsBadPeer.
 func (s *BadResponsesScorer) isBadPeer(pid peer.ID) bool {
+	// Trusted peers are never considered bad.
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
 	if peerData, ok := s.store.PeerData(pid); ok {
 		return peerData.BadResponses >= s.config.Threshold
 	}
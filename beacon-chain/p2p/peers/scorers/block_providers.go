@@ -174,6 +174,19 @@ func (s *BlockProviderScorer) processedBlocks(pid peer.ID) uint64 {
 	return 0
 }
 
+// restore sets the persisted processed blocks of a peer, decayed once per decay interval
+// elapsed since they were persisted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *BlockProviderScorer) restore(pid peer.ID, processedBlocks uint64, elapsed time.Duration) {
+	decay := uint64(elapsed/s.config.DecayInterval) * s.config.Decay
+	if processedBlocks > decay {
+		processedBlocks -= decay
+	} else {
+		processedBlocks = 0
+	}
+	s.store.PeerDataGetOrCreate(pid).ProcessedBlocks = processedBlocks
+}
+
 // IsBadPeer states if the peer is to be considered bad.
 // Block provider scorer cannot guarantee that lower score of a peer is indeed a sign of a bad peer.
 // Therefore this scorer never marks peers as bad, and relies on scores to probabilistically sort
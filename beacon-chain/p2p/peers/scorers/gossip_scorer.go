@@ -1,6 +1,9 @@
 package scorers
 
 import (
+	"math"
+	"time"
+
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -11,6 +14,8 @@ var _ Scorer = (*GossipScorer)(nil)
 const (
 	// The boundary till which a peer's gossip score is acceptable.
 	gossipThreshold = -100.0
+	// The time it takes for a persisted gossip score to decay to half its value.
+	gossipScoreHalfLife = time.Hour
 )
 
 // GossipScorer represents scorer that evaluates peers based on their gossip performance.
@@ -59,6 +64,10 @@ func (s *GossipScorer) IsBadPeer(pid peer.ID) bool {
 
 // isBadPeer is lock-free version of IsBadPeer.
 func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
+	// Trusted peers are never considered bad.
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
 	peerData, ok := s.store.PeerData(pid)
 	if !ok {
 		return false
@@ -92,6 +101,16 @@ func (s *GossipScorer) SetGossipData(pid peer.ID, gScore float64,
 	peerData.TopicScores = topicScores
 }
 
+// restore sets the persisted gossip score and behaviour penalty of a peer, halved once per
+// half-life elapsed since they were persisted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *GossipScorer) restore(pid peer.ID, gScore, bPenalty float64, elapsed time.Duration) {
+	decay := math.Pow(0.5, float64(elapsed)/float64(gossipScoreHalfLife))
+	peerData := s.store.PeerDataGetOrCreate(pid)
+	peerData.GossipScore = gScore * decay
+	peerData.BehaviourPenalty = bPenalty * decay
+}
+
 // GossipData gets the gossip related information of the given remote peer.
 // This can return nil if there is no known gossip record the peer.
 // This will error if the peer does not exist.
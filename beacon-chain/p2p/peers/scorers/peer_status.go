@@ -75,6 +75,10 @@ func (s *PeerStatusScorer) IsBadPeer(pid peer.ID) bool {
 
 // isBadPeer is lock-free version of IsBadPeer.
 func (s *PeerStatusScorer) isBadPeer(pid peer.ID) bool {
+	// Trusted peers are never considered bad.
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
 	peerData, ok := s.store.PeerData(pid)
 	if !ok {
 		return false
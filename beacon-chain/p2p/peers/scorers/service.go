@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	"github.com/prysmaticlabs/prysm/config/features"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
 var _ Scorer = (*Service)(nil)
@@ -120,9 +121,25 @@ func (s *Service) ScoreNoLock(pid peer.ID) float64 {
 	score += s.scorers.blockProviderScorer.score(pid) * s.scorerWeight(s.scorers.blockProviderScorer)
 	score += s.scorers.peerStatusScorer.score(pid) * s.scorerWeight(s.scorers.peerStatusScorer)
 	score += s.scorers.gossipScorer.score(pid) * s.scorerWeight(s.scorers.gossipScorer)
+	// Trusted peers are not penalized.
+	if s.store.IsTrustedPeer(pid) && score < 0 {
+		score = 0
+	}
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
+// RestoreNoLock restores the persisted scoring data of a peer, decayed according to the time
+// elapsed since it was persisted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Service) RestoreNoLock(pid peer.ID, reputation *pbrpc.PeerReputation, elapsed time.Duration) {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	s.scorers.badResponsesScorer.restore(pid, int(reputation.BadResponses), elapsed)
+	s.scorers.blockProviderScorer.restore(pid, reputation.ProcessedBlocks, elapsed)
+	s.scorers.gossipScorer.restore(pid, reputation.GossipScore, reputation.BehaviourPenalty, elapsed)
+}
+
 // IsBadPeer traverses all the scorers to see if any of them classifies peer as bad.
 func (s *Service) IsBadPeer(pid peer.ID) bool {
 	s.store.RLock()
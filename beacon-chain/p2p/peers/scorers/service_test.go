@@ -10,7 +10,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
 )
 
 func TestScorers_Service_Init(t *testing.T) {
@@ -308,3 +310,79 @@ func TestScorers_Service_BadPeers(t *testing.T) {
 	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer3"))
 	assert.Equal(t, 2, len(peerStatuses.Scorers().BadPeers()))
 }
+
+func TestScorers_Service_TrustedPeers(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	peerStatuses.Add(nil, "peer1", nil, network.DirOutbound)
+	peerStatuses.Add(nil, "peer2", nil, network.DirOutbound)
+	peerStatuses.SetTrustedPeers([]peer.ID{"peer1"})
+	for _, pid := range []peer.ID{"peer1", "peer2"} {
+		peerStatuses.Scorers().BadResponsesScorer().Increment(pid)
+		peerStatuses.Scorers().BadResponsesScorer().Increment(pid)
+	}
+
+	// Trusted peers are neither bad nor penalized.
+	assert.Equal(t, false, peerStatuses.Scorers().IsBadPeer("peer1"))
+	assert.Equal(t, false, peerStatuses.Scorers().BadResponsesScorer().IsBadPeer("peer1"))
+	assert.Equal(t, 0.0, peerStatuses.Scorers().Score("peer1"))
+	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer2"))
+	assert.Equal(t, true, peerStatuses.Scorers().Score("peer2") < 0)
+	assert.DeepEqual(t, []peer.ID{"peer2"}, peerStatuses.Scorers().BadPeers())
+
+	// Once untrusted, the peer is scored again.
+	peerStatuses.DeleteTrustedPeers([]peer.ID{"peer1"})
+	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer1"))
+}
+
+func TestScorers_Service_RestoreReputations(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     5,
+				DecayInterval: time.Hour,
+			},
+			BlockProviderScorerConfig: &scorers.BlockProviderScorerConfig{
+				DecayInterval: time.Minute,
+				Decay:         64,
+			},
+		},
+	})
+	pid1, err := peer.Decode("16Uiu2HAkyKhpwhAEjKpRNdmVshJYXhKQDW9a1g2ALzCxQwW7YhfN")
+	require.NoError(t, err)
+	pid2, err := peer.Decode("16Uiu2HAkv54NSq4WcAp99M6FmEEc4MrKvNPScK9aiwsSt6i1SjfU")
+	require.NoError(t, err)
+	savedAt := time.Now().Add(-2*time.Hour - time.Minute).Unix()
+	restored := peerStatuses.RestoreReputations([]*pb.PeerReputation{
+		{PeerId: pid1.String(), BadResponses: 5, ProcessedBlocks: 200 * 64, GossipScore: -8, BehaviourPenalty: 4, SavedAt: savedAt},
+		{PeerId: pid2.String(), BadResponses: 1, SavedAt: savedAt},
+		{PeerId: "invalid"},
+	})
+	assert.DeepEqual(t, []peer.ID{pid1, pid2}, restored)
+
+	// Scoring data is decayed according to the time elapsed since it was saved.
+	badResponses, err := peerStatuses.Scorers().BadResponsesScorer().Count(pid1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, badResponses)
+	badResponses, err = peerStatuses.Scorers().BadResponsesScorer().Count(pid2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, badResponses)
+	assert.Equal(t, uint64((200-121)*64), peerStatuses.Scorers().BlockProviderScorer().ProcessedBlocks(pid1))
+	gossipScore, behaviourPenalty, _, err := peerStatuses.Scorers().GossipScorer().GossipData(pid1)
+	require.NoError(t, err)
+	assert.Equal(t, true, gossipScore < -1.9 && gossipScore > -2.1, "Unexpected gossip score %f", gossipScore)
+	assert.Equal(t, true, behaviourPenalty > 0.9 && behaviourPenalty < 1.1, "Unexpected behaviour penalty %f", behaviourPenalty)
+
+	// Known peers are left untouched.
+	restored = peerStatuses.RestoreReputations([]*pb.PeerReputation{{PeerId: pid1.String(), BadResponses: 6}})
+	assert.Equal(t, 0, len(restored))
+	assert.Equal(t, false, peerStatuses.IsBad(pid1))
+}
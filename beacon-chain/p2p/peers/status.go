@@ -26,9 +26,11 @@ import (
 	"context"
 	"math"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
@@ -82,6 +84,11 @@ type Status struct {
 	store     *peerdata.Store
 	ipTracker map[string]uint64
 	rand      *rand.Rand
+
+	minPeersPerSubnet int
+	subnetsLock       sync.RWMutex
+	attnets           map[uint64]bool
+	syncnets          map[uint64]bool
 }
 
 // StatusConfig represents peer status service params.
@@ -90,6 +97,8 @@ type StatusConfig struct {
 	PeerLimit int
 	// ScorerParams holds peer scorer configuration params.
 	ScorerParams *scorers.Config
+	// MinPeersPerSubnet is the number of connected peers pruning keeps on each subscribed subnet.
+	MinPeersPerSubnet int
 }
 
 // NewStatus creates a new status entity.
@@ -104,7 +113,10 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		ipTracker: map[string]uint64{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
-		rand: rand.NewDeterministicGenerator(),
+		rand:              rand.NewDeterministicGenerator(),
+		minPeersPerSubnet: config.MinPeersPerSubnet,
+		attnets:           map[uint64]bool{},
+		syncnets:          map[uint64]bool{},
 	}
 }
 
@@ -291,6 +303,22 @@ func (p *Status) SubscribedToSubnet(index uint64) []peer.ID {
 	return peers
 }
 
+// SetSubscribedSubnets sets the attestation and sync committee subnets the
+// node is subscribed to. Pruning keeps the minimum number of peers on each of them.
+func (p *Status) SetSubscribedSubnets(attnets, syncnets []uint64) {
+	p.subnetsLock.Lock()
+	defer p.subnetsLock.Unlock()
+
+	p.attnets = make(map[uint64]bool, len(attnets))
+	for _, idx := range attnets {
+		p.attnets[idx] = true
+	}
+	p.syncnets = make(map[uint64]bool, len(syncnets))
+	for _, idx := range syncnets {
+		p.syncnets[idx] = true
+	}
+}
+
 // SetConnectionState sets the connection state of the given remote peer.
 func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionState) {
 	p.store.Lock()
@@ -334,9 +362,76 @@ func (p *Status) IsBad(pid peer.ID) bool {
 
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
+	if p.store.IsBannedPeer(pid) {
+		return true
+	}
+	// Trusted peers are exempt from scoring.
+	if p.store.IsTrustedPeer(pid) {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
+// SetTrustedPeers marks the peers as trusted, lifting any ban on them. Trusted peers are never
+// considered bad by the scorers, and are never pruned.
+func (p *Status) SetTrustedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.DeleteBannedPeers(pids)
+	p.store.SetTrustedPeers(pids)
+}
+
+// DeleteTrustedPeers removes the peers from the trusted peers.
+func (p *Status) DeleteTrustedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.DeleteTrustedPeers(pids)
+}
+
+// TrustedPeers returns the trusted peers.
+func (p *Status) TrustedPeers() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.TrustedPeers()
+}
+
+// IsTrustedPeer states if the peer is trusted.
+func (p *Status) IsTrustedPeer(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsTrustedPeer(pid)
+}
+
+// SetBannedPeers bans the peers, removing them from the trusted peers. Banned peers are always
+// considered bad, so they are neither dialed nor accepted.
+func (p *Status) SetBannedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.DeleteTrustedPeers(pids)
+	p.store.SetBannedPeers(pids)
+}
+
+// DeleteBannedPeers lifts the ban on the peers.
+func (p *Status) DeleteBannedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.DeleteBannedPeers(pids)
+}
+
+// BannedPeers returns the banned peers.
+func (p *Status) BannedPeers() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.BannedPeers()
+}
+
+// IsBannedPeer states if the peer is banned.
+func (p *Status) IsBannedPeer(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsBannedPeer(pid)
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -522,9 +617,15 @@ func (p *Status) Inactive() []peer.ID {
 	return peers
 }
 
-// Bad returns the peers that are bad.
+// Bad returns the peers that are bad, banned peers included.
 func (p *Status) Bad() []peer.ID {
-	return p.scorers.BadResponsesScorer().BadPeers()
+	bad := p.scorers.BadResponsesScorer().BadPeers()
+	for _, pid := range p.BannedPeers() {
+		if !p.scorers.BadResponsesScorer().IsBadPeer(pid) {
+			bad = append(bad, pid)
+		}
+	}
+	return bad
 }
 
 // All returns all the peers regardless of state.
@@ -538,6 +639,83 @@ func (p *Status) All() []peer.ID {
 	return pids
 }
 
+// Reputations returns the scoring data and last known network records of the peers with a known
+// address or ENR, in a form suitable for persisting them.
+func (p *Status) Reputations() []*pb.PeerReputation {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	savedAt := prysmTime.Now().Unix()
+	reputations := make([]*pb.PeerReputation, 0, len(p.store.Peers()))
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Address == nil && peerData.Enr == nil {
+			continue
+		}
+		reputation := &pb.PeerReputation{
+			PeerId:           pid.String(),
+			BadResponses:     uint64(peerData.BadResponses),
+			ProcessedBlocks:  peerData.ProcessedBlocks,
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+			SavedAt:          savedAt,
+		}
+		if peerData.Address != nil {
+			reputation.Address = peerData.Address.String()
+		}
+		if peerData.Enr != nil {
+			enc, err := rlp.EncodeToBytes(peerData.Enr)
+			if err == nil {
+				reputation.Enr = enc
+			}
+		}
+		reputations = append(reputations, reputation)
+	}
+	return reputations
+}
+
+// RestoreReputations adds the peers of persisted reputations as disconnected peers, restoring their
+// scoring data decayed according to the time elapsed since they were persisted. Peers that are
+// already known, or whose reputation cannot be decoded, are skipped. The restored peers are returned.
+func (p *Status) RestoreReputations(reputations []*pb.PeerReputation) []peer.ID {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := prysmTime.Now()
+	restored := make([]peer.ID, 0, len(reputations))
+	for _, reputation := range reputations {
+		pid, err := peer.Decode(reputation.PeerId)
+		if err != nil {
+			continue
+		}
+		if _, ok := p.store.PeerData(pid); ok {
+			continue
+		}
+		peerData := &peerdata.PeerData{
+			Direction: network.DirUnknown,
+			ConnState: PeerDisconnected,
+		}
+		if reputation.Address != "" {
+			addr, err := ma.NewMultiaddr(reputation.Address)
+			if err != nil {
+				continue
+			}
+			peerData.Address = addr
+		}
+		if len(reputation.Enr) > 0 {
+			record := &enr.Record{}
+			if err := rlp.DecodeBytes(reputation.Enr, record); err != nil {
+				continue
+			}
+			peerData.Enr = record
+		}
+		p.store.SetPeerData(pid, peerData)
+		p.addIpToTracker(pid)
+		p.scorers.RestoreNoLock(pid, reputation, now.Sub(time.Unix(reputation.SavedAt, 0)))
+		restored = append(restored, pid)
+	}
+	return restored
+}
+
 // Prune clears out and removes outdated and disconnected peers.
 func (p *Status) Prune() {
 	p.store.Lock()
@@ -560,9 +738,9 @@ func (p *Status) Prune() {
 		score float64
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select disconnected peers with a smaller bad response count.
+	// Select disconnected peers with a smaller bad response count. Trusted peers are kept.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(pid) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(pid) && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.Scorers().ScoreNoLock(pid),
@@ -608,9 +786,9 @@ func (p *Status) deprecatedPrune() {
 		badResp int
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select disconnected peers with a smaller bad response count.
+	// Select disconnected peers with a smaller bad response count. Trusted peers are kept.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -744,6 +922,8 @@ func (p *Status) BestNonFinalized(minPeers uint64, ourHeadEpoch types.Epoch) (ty
 // the pruning relies on simple heuristics such as
 // bad response count. In the future scoring will be used
 // to determine the most suitable peers to take out.
+// Peers needed to keep the minimum number of peers on a
+// subscribed subnet are never selected.
 func (p *Status) PeersToPrune() []peer.ID {
 	if !features.Get().EnablePeerScorer {
 		return p.deprecatedPeersToPrune()
@@ -765,10 +945,10 @@ func (p *Status) PeersToPrune() []peer.ID {
 		score float64
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select connected and inbound peers to prune.
+	// Select connected and inbound peers to prune, other than trusted peers.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
 				score: p.scorers.ScoreNoLock(pid),
@@ -796,14 +976,11 @@ func (p *Status) PeersToPrune() []peer.ID {
 	if excessInbound > amountToPrune {
 		amountToPrune = excessInbound
 	}
-	if amountToPrune < len(peersToPrune) {
-		peersToPrune = peersToPrune[:amountToPrune]
-	}
-	ids := make([]peer.ID, 0, len(peersToPrune))
+	candidates := make([]peer.ID, 0, len(peersToPrune))
 	for _, pr := range peersToPrune {
-		ids = append(ids, pr.pid)
+		candidates = append(candidates, pr.pid)
 	}
-	return ids
+	return p.selectPeersToPrune(candidates, amountToPrune)
 }
 
 // Deprecated: Is used to represent the older method
@@ -826,10 +1003,10 @@ func (p *Status) deprecatedPeersToPrune() []peer.ID {
 		badResp int
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select connected and inbound peers to prune.
+	// Select connected and inbound peers to prune, other than trusted peers.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -856,16 +1033,95 @@ func (p *Status) deprecatedPeersToPrune() []peer.ID {
 	if excessInbound > amountToPrune {
 		amountToPrune = excessInbound
 	}
-	if amountToPrune < len(peersToPrune) {
-		peersToPrune = peersToPrune[:amountToPrune]
-	}
-	ids := make([]peer.ID, 0, len(peersToPrune))
+	candidates := make([]peer.ID, 0, len(peersToPrune))
 	for _, pr := range peersToPrune {
-		ids = append(ids, pr.pid)
+		candidates = append(candidates, pr.pid)
+	}
+	return p.selectPeersToPrune(candidates, amountToPrune)
+}
+
+// selectPeersToPrune takes up to amount peers from the sorted candidates, skipping
+// those whose disconnection would leave a subscribed attestation or sync committee
+// subnet with fewer than the minimum number of peers. This method assumes the store
+// lock is acquired before executing the method.
+func (p *Status) selectPeersToPrune(candidates []peer.ID, amount int) []peer.ID {
+	p.subnetsLock.RLock()
+	defer p.subnetsLock.RUnlock()
+
+	protectSubnets := p.minPeersPerSubnet > 0 && (len(p.attnets) > 0 || len(p.syncnets) > 0)
+	attnetPeers := make(map[uint64]int, len(p.attnets))
+	syncnetPeers := make(map[uint64]int, len(p.syncnets))
+	if protectSubnets {
+		for _, peerData := range p.store.Peers() {
+			if peerData.ConnState != PeerConnected {
+				continue
+			}
+			attnets, syncnets := subnetsFromMetadata(peerData.MetaData)
+			countSubnetPeers(attnetPeers, p.attnets, attnets, 1)
+			countSubnetPeers(syncnetPeers, p.syncnets, syncnets, 1)
+		}
+	}
+
+	ids := make([]peer.ID, 0, amount)
+	for _, pid := range candidates {
+		if len(ids) >= amount {
+			break
+		}
+		if protectSubnets {
+			peerData, ok := p.store.PeerData(pid)
+			if !ok {
+				continue
+			}
+			attnets, syncnets := subnetsFromMetadata(peerData.MetaData)
+			if p.neededForSubnets(attnetPeers, attnets) || p.neededForSubnets(syncnetPeers, syncnets) {
+				continue
+			}
+			countSubnetPeers(attnetPeers, p.attnets, attnets, -1)
+			countSubnetPeers(syncnetPeers, p.syncnets, syncnets, -1)
+		}
+		ids = append(ids, pid)
 	}
 	return ids
 }
 
+// neededForSubnets returns true if any of the given subnets has no more than
+// the minimum number of peers left.
+func (p *Status) neededForSubnets(subnetPeers map[uint64]int, subnets []uint64) bool {
+	for _, idx := range subnets {
+		if count, ok := subnetPeers[idx]; ok && count <= p.minPeersPerSubnet {
+			return true
+		}
+	}
+	return false
+}
+
+// countSubnetPeers adds delta to the peer count of every given subnet that is subscribed.
+func countSubnetPeers(subnetPeers map[uint64]int, subscribed map[uint64]bool, subnets []uint64, delta int) {
+	for _, idx := range subnets {
+		if subscribed[idx] {
+			subnetPeers[idx] += delta
+		}
+	}
+}
+
+// subnetsFromMetadata returns the attestation and sync committee subnets advertised in the metadata.
+func subnetsFromMetadata(md metadata.Metadata) (attnets, syncnets []uint64) {
+	if md == nil || md.IsNil() {
+		return nil, nil
+	}
+	if md.AttnetsBitfield() != nil {
+		attnets = indicesFromBitfield(md.AttnetsBitfield())
+	}
+	if mdV1 := md.MetadataObjV1(); mdV1 != nil {
+		for i := uint64(0); i < mdV1.Syncnets.Len(); i++ {
+			if mdV1.Syncnets.BitAt(i) {
+				syncnets = append(syncnets, i)
+			}
+		}
+	}
+	return attnets, syncnets
+}
+
 // HighestEpoch returns the highest epoch reported epoch amongst peers.
 func (p *Status) HighestEpoch() types.Epoch {
 	p.store.RLock()
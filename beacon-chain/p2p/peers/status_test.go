@@ -755,6 +755,129 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestStatus_TrustedAndBannedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	trusted := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	banned := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	p.SetTrustedPeers([]peer.ID{trusted})
+	p.SetBannedPeers([]peer.ID{banned})
+	assert.DeepEqual(t, []peer.ID{trusted}, p.TrustedPeers())
+	assert.DeepEqual(t, []peer.ID{banned}, p.BannedPeers())
+
+	// Trusted peers are never bad, banned peers always are.
+	p.Scorers().BadResponsesScorer().Increment(trusted)
+	assert.Equal(t, false, p.IsBad(trusted))
+	assert.Equal(t, true, p.IsBad(banned))
+	assert.DeepEqual(t, []peer.ID{banned}, p.Bad())
+
+	// Trusting a peer lifts its ban, and banning a peer removes its trust.
+	p.SetTrustedPeers([]peer.ID{banned})
+	assert.Equal(t, false, p.IsBannedPeer(banned))
+	assert.Equal(t, false, p.IsBad(banned))
+	p.SetBannedPeers([]peer.ID{trusted})
+	assert.Equal(t, false, p.IsTrustedPeer(trusted))
+	assert.Equal(t, true, p.IsBad(trusted))
+	p.DeleteBannedPeers([]peer.ID{trusted})
+	p.DeleteTrustedPeers([]peer.ID{banned})
+	assert.Equal(t, 0, len(p.TrustedPeers()))
+	assert.Equal(t, 0, len(p.BannedPeers()))
+}
+
+func TestStatus_TrustedPeersNotPruned(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	var trusted []peer.ID
+	for i := 0; i < p.MaxPeerLimit()+10; i++ {
+		pid := addPeer(t, p, peers.PeerDisconnected)
+		if i%10 == 0 {
+			trusted = append(trusted, pid)
+		}
+	}
+	p.SetTrustedPeers(trusted)
+	p.Prune()
+	assert.Equal(t, p.MaxPeerLimit(), len(p.All()))
+	for _, pid := range trusted {
+		_, err := p.ConnectionState(pid)
+		assert.NoError(t, err, "Trusted peer was pruned")
+	}
+
+	// Trusted inbound peers are not selected for pruning.
+	for i := 0; i < p.MaxPeerLimit(); i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		p.SetTrustedPeers([]peer.ID{pid})
+	}
+	assert.Equal(t, 0, len(p.PeersToPrune()))
+}
+
+func TestStatus_SubnetPeersNotPruned(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:         30,
+		MinPeersPerSubnet: 2,
+		ScorerParams:      &scorers.Config{},
+	})
+	for i := 0; i < p.MaxPeerLimit(); i++ {
+		createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	// Peers on subnets have bad responses, so that they are the first to be pruned.
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(1, true)
+	var attnetPeers []peer.ID
+	for i := 0; i < 2; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		p.SetMetadata(pid, wrapper.WrappedMetadataV1(&pb.MetaDataV1{Attnets: attnets, Syncnets: bitfield.NewBitvector4()}))
+		p.Scorers().BadResponsesScorer().Increment(pid)
+		attnetPeers = append(attnetPeers, pid)
+	}
+	syncnets := bitfield.NewBitvector4()
+	syncnets.SetBitAt(0, true)
+	var syncnetPeers []peer.ID
+	for i := 0; i < 3; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		p.SetMetadata(pid, wrapper.WrappedMetadataV1(&pb.MetaDataV1{Attnets: bitfield.NewBitvector64(), Syncnets: syncnets}))
+		p.Scorers().BadResponsesScorer().Increment(pid)
+		syncnetPeers = append(syncnetPeers, pid)
+	}
+	contains := func(ids []peer.ID, pid peer.ID) bool {
+		for _, id := range ids {
+			if id == pid {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Without subscriptions, all peers on subnets are pruned first.
+	pruned := p.PeersToPrune()
+	amount := len(pruned)
+	for _, pid := range append(attnetPeers, syncnetPeers...) {
+		assert.Equal(t, true, contains(pruned, pid))
+	}
+
+	// Subscribed subnets keep the minimum number of peers.
+	p.SetSubscribedSubnets([]uint64{1}, []uint64{0})
+	pruned = p.PeersToPrune()
+	assert.Equal(t, amount, len(pruned))
+	for _, pid := range attnetPeers {
+		assert.Equal(t, false, contains(pruned, pid), "Peer on subscribed attestation subnet was pruned")
+	}
+	prunedSyncnetPeers := 0
+	for _, pid := range syncnetPeers {
+		if contains(pruned, pid) {
+			prunedSyncnetPeers++
+		}
+	}
+	assert.Equal(t, 1, prunedSyncnetPeers)
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
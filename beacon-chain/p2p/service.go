@@ -29,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
 	"github.com/prysmaticlabs/prysm/runtime"
@@ -84,6 +85,8 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	bandwidth             *bandwidthCounter
+	topicBandwidth        *topicBandwidthTracer
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -101,6 +104,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		bandwidth:     newBandwidthCounter(),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -134,6 +138,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 	s.host.RemoveStreamHandler(identify.IDDelta)
+	s.topicBandwidth = newTopicBandwidthTracer(h.ID())
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -150,6 +155,19 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithPeerScore(peerScoringParams()),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
+		pubsub.WithRawTracer(s.topicBandwidth),
+	}
+	if s.cfg.GossipTraceFile != "" {
+		tracer, err := newGossipTracer(s.cfg.GossipTraceFile, int64(s.cfg.GossipTraceMaxSize)*1024*1024, func(pmsg *pubsubpb.Message) string {
+			return MsgID(s.genesisValidatorsRoot, pmsg)
+		})
+		if err != nil {
+			log.WithError(err).Error("Failed to open gossip trace file")
+			return nil, err
+		}
+		psOpts = append(psOpts, pubsub.WithRawTracer(tracer))
+		go tracer.run(s.ctx)
+		log.WithField("path", s.cfg.GossipTraceFile).Info("Tracing gossip messages")
 	}
 	// Set the pubsub global parameters that we require.
 	setPubSubParameters()
@@ -165,7 +183,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	s.pubsub = gs
 
 	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
-		PeerLimit: int(s.cfg.MaxPeers),
+		PeerLimit:         int(s.cfg.MaxPeers),
+		MinPeersPerSubnet: int(flags.Get().MinimumPeersPerSubnet),
 		ScorerParams: &scorers.Config{
 			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
 				Threshold:     maxBadResponses,
@@ -187,6 +206,10 @@ func (s *Service) Start() {
 		return
 	}
 
+	// Restores the reputations of the peers known before the node restarted, so that
+	// bad peers remain bad, and good peers can be dialed once the service is started.
+	knownGoodPeers := s.loadPeerReputations()
+
 	// Waits until the state is initialized via an event feed.
 	// Used for fork-related data when connecting peers.
 	s.awaitStateInitialized()
@@ -230,6 +253,15 @@ func (s *Service) Start() {
 		}
 		s.connectWithAllPeers(addrs)
 	}
+	if len(s.cfg.TrustedPeers) > 0 {
+		addrs, err := peersFromStringAddrs(s.cfg.TrustedPeers)
+		if err != nil {
+			log.Errorf("Could not connect to trusted peer: %v", err)
+		}
+		s.trustPeers(addrs)
+		s.connectWithAllPeers(addrs)
+	}
+	s.connectWithAllAddrInfos(knownGoodPeers)
 	// Initialize metadata according to the
 	// current epoch.
 	s.RefreshENR()
@@ -237,11 +269,17 @@ func (s *Service) Start() {
 	// Periodic functions.
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
+		s.ensureTrustedPeerConnections(s.ctx)
 	})
-	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, 30*time.Minute, func() {
+		s.Peers().Prune()
+		s.trimIdleBandwidth(time.Now().Add(-bandwidthIdleTimeout))
+	})
+	async.RunEvery(s.ctx, peerReputationSaveInterval, s.savePeerReputations)
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
+		s.updateSubscribedSubnets()
 	})
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
 		log.WithFields(logrus.Fields{
@@ -272,6 +310,9 @@ func (s *Service) Start() {
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.started {
+		s.savePeerReputations()
+	}
 	s.started = false
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
@@ -436,6 +477,10 @@ func (s *Service) connectWithAllPeers(multiAddrs []multiaddr.Multiaddr) {
 		log.Errorf("Could not convert to peer address info's from multiaddresses: %v", err)
 		return
 	}
+	s.connectWithAllAddrInfos(addrInfos)
+}
+
+func (s *Service) connectWithAllAddrInfos(addrInfos []peer.AddrInfo) {
 	for _, info := range addrInfos {
 		// make each dial non-blocking
 		go func(info peer.AddrInfo) {
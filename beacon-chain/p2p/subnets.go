@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -270,3 +271,44 @@ func byteCount(bitCount int) int {
 	}
 	return numOfBytes
 }
+
+// updateSubscribedSubnets records the attestation and sync committee subnets
+// of the topics we are subscribed to, so that pruning keeps enough peers on them.
+func (s *Service) updateSubscribedSubnets() {
+	if s.pubsub == nil {
+		return
+	}
+	attnets, syncnets := subnetsFromTopics(s.pubsub.GetTopics())
+	s.peers.SetSubscribedSubnets(attnets, syncnets)
+}
+
+// subnetsFromTopics returns the attestation and sync committee subnet
+// indices of the given gossip topics.
+func subnetsFromTopics(topics []string) (attnets, syncnets []uint64) {
+	for _, topic := range topics {
+		// Topics are of the form /eth2/<digest>/<name>/<encoding>.
+		parts := strings.Split(topic, "/")
+		if len(parts) < 4 {
+			continue
+		}
+		name := parts[3]
+		if idx, ok := subnetIndexFromTopicName(name, GossipAttestationMessage); ok && idx < attestationSubnetCount {
+			attnets = append(attnets, idx)
+		}
+		if idx, ok := subnetIndexFromTopicName(name, GossipSyncCommitteeMessage); ok && idx < syncCommsSubnetCount {
+			syncnets = append(syncnets, idx)
+		}
+	}
+	return attnets, syncnets
+}
+
+func subnetIndexFromTopicName(name, message string) (uint64, bool) {
+	if !strings.HasPrefix(name, message+"_") {
+		return 0, false
+	}
+	idx, err := strconv.ParseUint(strings.TrimPrefix(name, message+"_"), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
@@ -490,3 +490,19 @@ func Test_SyncSubnets(t *testing.T) {
 		})
 	}
 }
+
+func Test_subnetsFromTopics(t *testing.T) {
+	topics := []string{
+		"/eth2/b5303f2a/beacon_attestation_5/ssz_snappy",
+		"/eth2/b5303f2a/beacon_attestation_63/ssz_snappy",
+		"/eth2/b5303f2a/beacon_attestation_64/ssz_snappy",
+		"/eth2/b5303f2a/beacon_aggregate_and_proof/ssz_snappy",
+		"/eth2/b5303f2a/sync_committee_2/ssz_snappy",
+		"/eth2/b5303f2a/sync_committee_contribution_and_proof/ssz_snappy",
+		"/eth2/b5303f2a/beacon_block/ssz_snappy",
+		"invalid",
+	}
+	attnets, syncnets := subnetsFromTopics(topics)
+	assert.DeepEqual(t, []uint64{5, 63}, attnets)
+	assert.DeepEqual(t, []uint64{2}, syncnets)
+}
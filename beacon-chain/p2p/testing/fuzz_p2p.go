@@ -12,6 +12,7 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
 	"google.golang.org/protobuf/proto"
@@ -83,6 +84,36 @@ func (_ *FakeP2P) Peers() *peers.Status {
 	return nil
 }
 
+// BandwidthTotals -- fake.
+func (_ *FakeP2P) BandwidthTotals() p2ptypes.Bandwidth {
+	return p2ptypes.Bandwidth{}
+}
+
+// BandwidthForPeer -- fake.
+func (_ *FakeP2P) BandwidthForPeer(_ peer.ID) p2ptypes.Bandwidth {
+	return p2ptypes.Bandwidth{}
+}
+
+// BandwidthForPeerByProtocol -- fake.
+func (_ *FakeP2P) BandwidthForPeerByProtocol(_ peer.ID) map[string]p2ptypes.Bandwidth {
+	return nil
+}
+
+// BandwidthForPeerByTopic -- fake.
+func (_ *FakeP2P) BandwidthForPeerByTopic(_ peer.ID) map[string]p2ptypes.Bandwidth {
+	return nil
+}
+
+// BandwidthByProtocol -- fake.
+func (_ *FakeP2P) BandwidthByProtocol() map[string]p2ptypes.Bandwidth {
+	return nil
+}
+
+// BandwidthByTopic -- fake.
+func (_ *FakeP2P) BandwidthByTopic() map[string]p2ptypes.Bandwidth {
+	return nil
+}
+
 // PublishToTopic -- fake.
 func (_ *FakeP2P) PublishToTopic(_ context.Context, _ string, _ []byte, _ ...pubsub.PubOpt) error {
 	return nil
@@ -0,0 +1,46 @@
+package testing
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+)
+
+// MockBandwidthProvider implements BandwidthProvider for testing.
+type MockBandwidthProvider struct {
+	Totals        p2ptypes.Bandwidth
+	Peers         map[peer.ID]p2ptypes.Bandwidth
+	PeerProtocols map[peer.ID]map[string]p2ptypes.Bandwidth
+	PeerTopics    map[peer.ID]map[string]p2ptypes.Bandwidth
+	Protocols     map[string]p2ptypes.Bandwidth
+	Topics        map[string]p2ptypes.Bandwidth
+}
+
+// BandwidthTotals returns the mocked totals.
+func (m *MockBandwidthProvider) BandwidthTotals() p2ptypes.Bandwidth {
+	return m.Totals
+}
+
+// BandwidthForPeer returns the mocked bandwidth of the peer.
+func (m *MockBandwidthProvider) BandwidthForPeer(pid peer.ID) p2ptypes.Bandwidth {
+	return m.Peers[pid]
+}
+
+// BandwidthForPeerByProtocol returns the mocked bandwidth of the peer per protocol.
+func (m *MockBandwidthProvider) BandwidthForPeerByProtocol(pid peer.ID) map[string]p2ptypes.Bandwidth {
+	return m.PeerProtocols[pid]
+}
+
+// BandwidthForPeerByTopic returns the mocked bandwidth of the peer per topic.
+func (m *MockBandwidthProvider) BandwidthForPeerByTopic(pid peer.ID) map[string]p2ptypes.Bandwidth {
+	return m.PeerTopics[pid]
+}
+
+// BandwidthByProtocol returns the mocked bandwidth of the protocols.
+func (m *MockBandwidthProvider) BandwidthByProtocol() map[string]p2ptypes.Bandwidth {
+	return m.Protocols
+}
+
+// BandwidthByTopic returns the mocked bandwidth of the topics.
+func (m *MockBandwidthProvider) BandwidthByTopic() map[string]p2ptypes.Bandwidth {
+	return m.Topics
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/metadata"
 	"github.com/sirupsen/logrus"
@@ -348,6 +349,36 @@ func (p *TestP2P) Peers() *peers.Status {
 	return p.peers
 }
 
+// BandwidthTotals mocks the p2p func.
+func (_ *TestP2P) BandwidthTotals() p2ptypes.Bandwidth {
+	return p2ptypes.Bandwidth{}
+}
+
+// BandwidthForPeer mocks the p2p func.
+func (_ *TestP2P) BandwidthForPeer(_ peer.ID) p2ptypes.Bandwidth {
+	return p2ptypes.Bandwidth{}
+}
+
+// BandwidthForPeerByProtocol mocks the p2p func.
+func (_ *TestP2P) BandwidthForPeerByProtocol(_ peer.ID) map[string]p2ptypes.Bandwidth {
+	return map[string]p2ptypes.Bandwidth{}
+}
+
+// BandwidthForPeerByTopic mocks the p2p func.
+func (_ *TestP2P) BandwidthForPeerByTopic(_ peer.ID) map[string]p2ptypes.Bandwidth {
+	return map[string]p2ptypes.Bandwidth{}
+}
+
+// BandwidthByProtocol mocks the p2p func.
+func (_ *TestP2P) BandwidthByProtocol() map[string]p2ptypes.Bandwidth {
+	return map[string]p2ptypes.Bandwidth{}
+}
+
+// BandwidthByTopic mocks the p2p func.
+func (_ *TestP2P) BandwidthByTopic() map[string]p2ptypes.Bandwidth {
+	return map[string]p2ptypes.Bandwidth{}
+}
+
 // FindPeersWithSubnet mocks the p2p func.
 func (_ *TestP2P) FindPeersWithSubnet(_ context.Context, _ string, _, _ uint64) (bool, error) {
 	return false, nil
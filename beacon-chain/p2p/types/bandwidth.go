@@ -0,0 +1,7 @@
+package types
+
+// Bandwidth is the number of bytes received from and sent to peers.
+type Bandwidth struct {
+	In  uint64
+	Out uint64
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
 )
 
 // ensurePeerConnections will attempt to reestablish connection to the peers
@@ -39,3 +41,39 @@ func connectWithTimeout(ctx context.Context, h host.Host, peer *peer.AddrInfo) e
 	defer cancel()
 	return h.Connect(ctx, *peer)
 }
+
+// trustPeers adds the peers of the multiaddresses to the peer status as trusted peers,
+// so that they are exempt from scoring and pruning, and reconnected to when disconnected.
+func (s *Service) trustPeers(multiAddrs []ma.Multiaddr) {
+	addrInfos, err := peer.AddrInfosFromP2pAddrs(multiAddrs...)
+	if err != nil {
+		log.Errorf("Could not convert to peer address info's from multiaddresses: %v", err)
+		return
+	}
+	pids := make([]peer.ID, 0, len(addrInfos))
+	for _, info := range addrInfos {
+		if len(info.Addrs) == 0 {
+			continue
+		}
+		s.peers.Add(nil /* ENR */, info.ID, info.Addrs[0], network.DirUnknown)
+		pids = append(pids, info.ID)
+	}
+	s.peers.SetTrustedPeers(pids)
+}
+
+// ensureTrustedPeerConnections will attempt to reestablish connection to the
+// trusted peers with a known address if there are currently no connections to them.
+func (s *Service) ensureTrustedPeerConnections(ctx context.Context) {
+	for _, pid := range s.peers.TrustedPeers() {
+		if pid == s.host.ID() || len(s.host.Network().ConnsToPeer(pid)) > 0 {
+			continue
+		}
+		addr, err := s.peers.Address(pid)
+		if err != nil || addr == nil {
+			continue
+		}
+		if err := connectWithTimeout(ctx, s.host, &peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{addr}}); err != nil {
+			log.WithField("peer", pid).WithField("addrs", addr).WithError(err).Debug("Failed to reconnect to trusted peer")
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package powchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// NewPayloadMethod v1 request string for JSON-RPC.
+	NewPayloadMethod = "engine_newPayloadV1"
+	// ForkchoiceUpdatedMethod v1 request string for JSON-RPC.
+	ForkchoiceUpdatedMethod = "engine_forkchoiceUpdatedV1"
+	// GetPayloadMethod v1 request string for JSON-RPC.
+	GetPayloadMethod = "engine_getPayloadV1"
+	// ExecutionBlockByHashMethod request string for JSON-RPC.
+	ExecutionBlockByHashMethod = "eth_getBlockByHash"
+	// ExecutionBlockByNumberMethod request string for JSON-RPC.
+	ExecutionBlockByNumberMethod = "eth_getBlockByNumber"
+	// ClientVersionMethod request string for JSON-RPC.
+	ClientVersionMethod = "web3_clientVersion"
+	// Defines the seconds to wait before timing out engine endpoints with block execution semantics (newPayload, forkchoiceUpdated).
+	payloadAndForkchoiceUpdatedTimeout = 8 * time.Second
+	// Defines the seconds before timing out engine endpoints with non-block execution semantics.
+	defaultEngineTimeout = time.Second
+)
+
+// ForkchoiceUpdatedResponse is the response kind received by the
+// engine_forkchoiceUpdatedV1 endpoint.
+type ForkchoiceUpdatedResponse struct {
+	Status    *pb.PayloadStatus  `json:"payloadStatus"`
+	PayloadId *pb.PayloadIDBytes `json:"payloadId"`
+}
+
+// EngineCaller defines a client that can interact with an Ethereum
+// execution node's engine service via JSON-RPC.
+type EngineCaller interface {
+	NewPayload(ctx context.Context, payload *ethpb.ExecutionPayload) ([]byte, error)
+	ForkchoiceUpdated(
+		ctx context.Context, state *pb.ForkchoiceState, attrs *pb.PayloadAttributes,
+	) (*pb.PayloadIDBytes, []byte, error)
+	GetPayload(ctx context.Context, payloadId [8]byte) (*ethpb.ExecutionPayload, error)
+	LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock, error)
+	ExecutionBlockByHash(ctx context.Context, hash common.Hash) (*pb.ExecutionBlock, error)
+	GetTerminalBlockHash(ctx context.Context) ([]byte, bool, error)
+	IsValidTerminalBlockHash(ctx context.Context, blkHash common.Hash) (bool, error)
+	ClientVersion(ctx context.Context) (string, error)
+}
+
+// NewPayload calls the engine_newPayloadV1 method via JSON-RPC. It returns the latest
+// valid hash known to the execution engine along with an error describing the
+// payload status if the payload was not fully validated.
+func (s *Service) NewPayload(ctx context.Context, payload *ethpb.ExecutionPayload) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.NewPayload")
+	defer span.End()
+
+	if s.rpcClient == nil {
+		return nil, errNoEngineClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, payloadAndForkchoiceUpdatedTimeout)
+	defer cancel()
+	result := &pb.PayloadStatus{}
+	err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, pb.NewExecutionPayloadJSON(payload))
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+
+	switch result.Status {
+	case pb.PayloadStatus_INVALID_BLOCK_HASH:
+		return nil, ErrInvalidBlockHashPayloadStatus
+	case pb.PayloadStatus_INVALID_TERMINAL_BLOCK:
+		return nil, ErrInvalidTerminalBlockPayloadStatus
+	case pb.PayloadStatus_ACCEPTED, pb.PayloadStatus_SYNCING:
+		return nil, ErrAcceptedSyncingPayloadStatus
+	case pb.PayloadStatus_INVALID:
+		return result.LatestValidHash, ErrInvalidPayloadStatus
+	case pb.PayloadStatus_VALID:
+		return result.LatestValidHash, nil
+	default:
+		return nil, ErrUnknownPayloadStatus
+	}
+}
+
+// ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC. When payload
+// attributes are provided, the execution engine starts building a payload on top of the
+// new head and returns an identifier which can be used to retrieve it with GetPayload.
+func (s *Service) ForkchoiceUpdated(
+	ctx context.Context, state *pb.ForkchoiceState, attrs *pb.PayloadAttributes,
+) (*pb.PayloadIDBytes, []byte, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ForkchoiceUpdated")
+	defer span.End()
+
+	if s.rpcClient == nil {
+		return nil, nil, errNoEngineClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, payloadAndForkchoiceUpdatedTimeout)
+	defer cancel()
+	result := &ForkchoiceUpdatedResponse{}
+	// A nil attributes value has to be sent over the wire as a JSON null, rather than
+	// as a typed nil pointer which would be encoded as an empty object.
+	var attributes interface{}
+	if attrs != nil {
+		attributes = attrs
+	}
+	err := s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, attributes)
+	if err != nil {
+		return nil, nil, handleRPCError(err)
+	}
+
+	if result.Status == nil {
+		return nil, nil, ErrNilResponse
+	}
+	resp := result.Status
+	switch resp.Status {
+	case pb.PayloadStatus_SYNCING:
+		return nil, nil, ErrAcceptedSyncingPayloadStatus
+	case pb.PayloadStatus_INVALID:
+		return nil, resp.LatestValidHash, ErrInvalidPayloadStatus
+	case pb.PayloadStatus_VALID:
+		return result.PayloadId, resp.LatestValidHash, nil
+	default:
+		return nil, nil, ErrUnknownPayloadStatus
+	}
+}
+
+// GetPayload calls the engine_getPayloadV1 method via JSON-RPC.
+func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte) (*ethpb.ExecutionPayload, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.GetPayload")
+	defer span.End()
+
+	if s.rpcClient == nil {
+		return nil, errNoEngineClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultEngineTimeout)
+	defer cancel()
+	result := &pb.ExecutionPayloadJSON{}
+	err := s.rpcClient.CallContext(ctx, result, GetPayloadMethod, pb.PayloadIDBytes(payloadId))
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+	return result.ToProto()
+}
+
+// LatestExecutionBlock fetches the latest execution engine block by calling
+// eth_getBlockByNumber via JSON-RPC.
+func (s *Service) LatestExecutionBlock(ctx context.Context) (*pb.ExecutionBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.LatestExecutionBlock")
+	defer span.End()
+
+	if s.rpcClient == nil {
+		return nil, errNoEngineClient
+	}
+	result := &pb.ExecutionBlock{}
+	err := s.rpcClient.CallContext(
+		ctx,
+		result,
+		ExecutionBlockByNumberMethod,
+		"latest",
+		false, /* no full transaction objects */
+	)
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+	return result, nil
+}
+
+// ExecutionBlockByHash fetches an execution engine block by hash by calling
+// eth_getBlockByHash via JSON-RPC.
+func (s *Service) ExecutionBlockByHash(ctx context.Context, hash common.Hash) (*pb.ExecutionBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ExecutionBlockByHash")
+	defer span.End()
+
+	if s.rpcClient == nil {
+		return nil, errNoEngineClient
+	}
+	var result *pb.ExecutionBlock
+	err := s.rpcClient.CallContext(ctx, &result, ExecutionBlockByHashMethod, hash, false /* no full transaction objects */)
+	if err != nil {
+		return nil, handleRPCError(err)
+	}
+	if result == nil {
+		return nil, ErrNilResponse
+	}
+	return result, nil
+}
+
+// ClientVersion fetches the name and version of the execution client by calling
+// web3_clientVersion via JSON-RPC.
+func (s *Service) ClientVersion(ctx context.Context) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ClientVersion")
+	defer span.End()
+
+	if s.rpcClient == nil {
+		return "", errNoEngineClient
+	}
+	var result string
+	if err := s.rpcClient.CallContext(ctx, &result, ClientVersionMethod); err != nil {
+		return "", handleRPCError(err)
+	}
+	return result, nil
+}
+
+// Handles errors received from the RPC server according to the specification.
+func handleRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if isTimeout(err) {
+		return ErrHTTPTimeout
+	}
+	e, ok := err.(gethRPC.Error)
+	if !ok {
+		return errors.Wrap(err, "got an unexpected error")
+	}
+	switch e.ErrorCode() {
+	case -32700:
+		return ErrParse
+	case -32600:
+		return ErrInvalidRequest
+	case -32601:
+		return ErrMethodNotFound
+	case -32602:
+		return ErrInvalidParams
+	case -32603:
+		return ErrInternal
+	case -32001:
+		return ErrUnknownPayload
+	case -32000:
+		// Only -32000 status codes are data errors in the RPC specification.
+		errWithData, ok := err.(gethRPC.DataError)
+		if !ok {
+			return errors.Wrap(err, "got an unexpected error")
+		}
+		return errors.Wrapf(ErrServer, "%v", errWithData.ErrorData())
+	default:
+		return err
+	}
+}
+
+type httpTimeoutError interface {
+	Error() string
+	Timeout() bool
+}
+
+// isTimeout returns true if the error is a context deadline or http.Client timeout error.
+func isTimeout(e error) bool {
+	if errors.Is(e, context.DeadlineExceeded) {
+		return true
+	}
+	t, ok := e.(httpTimeoutError)
+	return ok && t.Timeout()
+}
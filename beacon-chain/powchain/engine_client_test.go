@@ -0,0 +1,389 @@
+package powchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	mocks "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+var (
+	_ = EngineCaller(&Service{})
+	_ = EngineCaller(&mocks.EngineClient{})
+)
+
+// engineServer is a stand-in for an execution engine which answers every
+// JSON-RPC request for a method with a canned result.
+type engineServer struct {
+	t       *testing.T
+	results map[string]interface{}
+	errors  map[string]int
+	params  map[string][]json.RawMessage
+}
+
+func newEngineServer(t *testing.T) *engineServer {
+	return &engineServer{
+		t:       t,
+		results: make(map[string]interface{}),
+		errors:  make(map[string]int),
+		params:  make(map[string][]json.RawMessage),
+	}
+}
+
+func (e *engineServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	defer func() {
+		require.NoError(e.t, r.Body.Close())
+	}()
+	enc, err := ioutil.ReadAll(r.Body)
+	require.NoError(e.t, err)
+	req := struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}{}
+	require.NoError(e.t, json.Unmarshal(enc, &req))
+	e.params[req.Method] = req.Params
+	resp := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+	}
+	if code, ok := e.errors[req.Method]; ok {
+		resp["error"] = map[string]interface{}{
+			"code":    code,
+			"message": "engine error",
+		}
+	} else {
+		resp["result"] = e.results[req.Method]
+	}
+	require.NoError(e.t, json.NewEncoder(w).Encode(resp))
+}
+
+func (e *engineServer) service(t *testing.T, secret []byte) *Service {
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+	service := &Service{cfg: &config{jwtSecret: secret}}
+	client, err := service.newRPCClient(HttpEndpoint(srv.URL))
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	service.rpcClient = client
+	return service
+}
+
+func TestClient_NewPayload(t *testing.T) {
+	ctx := context.Background()
+	payload := fixturePayload()
+	validHash := bytesutil.PadTo([]byte("valid"), 32)
+
+	tests := []struct {
+		name       string
+		status     *pb.PayloadStatus
+		wantHash   []byte
+		wantErr    error
+		errCodeRPC int
+	}{
+		{
+			name:     "valid",
+			status:   &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: validHash},
+			wantHash: validHash,
+		},
+		{
+			name:    "syncing",
+			status:  &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING},
+			wantErr: ErrAcceptedSyncingPayloadStatus,
+		},
+		{
+			name:    "accepted",
+			status:  &pb.PayloadStatus{Status: pb.PayloadStatus_ACCEPTED},
+			wantErr: ErrAcceptedSyncingPayloadStatus,
+		},
+		{
+			name:     "invalid",
+			status:   &pb.PayloadStatus{Status: pb.PayloadStatus_INVALID, LatestValidHash: validHash},
+			wantHash: validHash,
+			wantErr:  ErrInvalidPayloadStatus,
+		},
+		{
+			name:    "invalid block hash",
+			status:  &pb.PayloadStatus{Status: pb.PayloadStatus_INVALID_BLOCK_HASH},
+			wantErr: ErrInvalidBlockHashPayloadStatus,
+		},
+		{
+			name:    "invalid terminal block",
+			status:  &pb.PayloadStatus{Status: pb.PayloadStatus_INVALID_TERMINAL_BLOCK},
+			wantErr: ErrInvalidTerminalBlockPayloadStatus,
+		},
+		{
+			name:    "unknown status",
+			status:  &pb.PayloadStatus{Status: pb.PayloadStatus_UNKNOWN},
+			wantErr: ErrUnknownPayloadStatus,
+		},
+		{
+			name:       "rpc error",
+			errCodeRPC: -32602,
+			wantErr:    ErrInvalidParams,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newEngineServer(t)
+			engine.results[NewPayloadMethod] = tt.status
+			if tt.errCodeRPC != 0 {
+				engine.errors[NewPayloadMethod] = tt.errCodeRPC
+			}
+			service := engine.service(t, nil)
+			hash, err := service.NewPayload(ctx, payload)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.DeepEqual(t, tt.wantHash, hash)
+		})
+	}
+}
+
+func TestClient_NewPayload_SendsEngineJSON(t *testing.T) {
+	engine := newEngineServer(t)
+	engine.results[NewPayloadMethod] = &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}
+	service := engine.service(t, nil)
+
+	payload := fixturePayload()
+	_, err := service.NewPayload(context.Background(), payload)
+	require.NoError(t, err)
+
+	params := engine.params[NewPayloadMethod]
+	require.Equal(t, 1, len(params))
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(params[0], &fields))
+	require.Equal(t, "0x1", fields["blockNumber"])
+	// The base fee is stored little-endian in the payload and sent as a big-endian quantity.
+	require.Equal(t, "0x100", fields["baseFeePerGas"])
+	require.Equal(t, fmt.Sprintf("%#x", payload.BlockHash), fields["blockHash"])
+}
+
+func TestClient_ForkchoiceUpdated(t *testing.T) {
+	ctx := context.Background()
+	state := &pb.ForkchoiceState{
+		HeadBlockHash:      bytesutil.PadTo([]byte("head"), 32),
+		SafeBlockHash:      bytesutil.PadTo([]byte("safe"), 32),
+		FinalizedBlockHash: bytesutil.PadTo([]byte("finalized"), 32),
+	}
+	attrs := &pb.PayloadAttributes{
+		Timestamp:             1,
+		Random:                bytesutil.PadTo([]byte("random"), 32),
+		SuggestedFeeRecipient: bytesutil.PadTo([]byte("fee"), 20),
+	}
+	validHash := bytesutil.PadTo([]byte("valid"), 32)
+
+	t.Run("valid with payload id", func(t *testing.T) {
+		engine := newEngineServer(t)
+		id := pb.PayloadIDBytes{1, 2, 3, 4, 5, 6, 7, 8}
+		engine.results[ForkchoiceUpdatedMethod] = &ForkchoiceUpdatedResponse{
+			Status:    &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: validHash},
+			PayloadId: &id,
+		}
+		service := engine.service(t, nil)
+		payloadID, hash, err := service.ForkchoiceUpdated(ctx, state, attrs)
+		require.NoError(t, err)
+		require.DeepEqual(t, &id, payloadID)
+		require.DeepEqual(t, validHash, hash)
+
+		params := engine.params[ForkchoiceUpdatedMethod]
+		require.Equal(t, 2, len(params))
+		gotAttrs := &pb.PayloadAttributes{}
+		require.NoError(t, json.Unmarshal(params[1], gotAttrs))
+		require.DeepEqual(t, attrs, gotAttrs)
+	})
+	t.Run("nil attributes are sent as null", func(t *testing.T) {
+		engine := newEngineServer(t)
+		engine.results[ForkchoiceUpdatedMethod] = &ForkchoiceUpdatedResponse{
+			Status: &pb.PayloadStatus{Status: pb.PayloadStatus_VALID, LatestValidHash: validHash},
+		}
+		service := engine.service(t, nil)
+		payloadID, _, err := service.ForkchoiceUpdated(ctx, state, nil)
+		require.NoError(t, err)
+		require.Equal(t, (*pb.PayloadIDBytes)(nil), payloadID)
+		require.Equal(t, "null", string(engine.params[ForkchoiceUpdatedMethod][1]))
+	})
+	t.Run("syncing", func(t *testing.T) {
+		engine := newEngineServer(t)
+		engine.results[ForkchoiceUpdatedMethod] = &ForkchoiceUpdatedResponse{
+			Status: &pb.PayloadStatus{Status: pb.PayloadStatus_SYNCING},
+		}
+		service := engine.service(t, nil)
+		_, _, err := service.ForkchoiceUpdated(ctx, state, attrs)
+		require.ErrorIs(t, err, ErrAcceptedSyncingPayloadStatus)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		engine := newEngineServer(t)
+		engine.results[ForkchoiceUpdatedMethod] = &ForkchoiceUpdatedResponse{
+			Status: &pb.PayloadStatus{Status: pb.PayloadStatus_INVALID, LatestValidHash: validHash},
+		}
+		service := engine.service(t, nil)
+		_, hash, err := service.ForkchoiceUpdated(ctx, state, attrs)
+		require.ErrorIs(t, err, ErrInvalidPayloadStatus)
+		require.DeepEqual(t, validHash, hash)
+	})
+	t.Run("nil response", func(t *testing.T) {
+		engine := newEngineServer(t)
+		engine.results[ForkchoiceUpdatedMethod] = &ForkchoiceUpdatedResponse{}
+		service := engine.service(t, nil)
+		_, _, err := service.ForkchoiceUpdated(ctx, state, attrs)
+		require.ErrorIs(t, err, ErrNilResponse)
+	})
+}
+
+func TestClient_GetPayload(t *testing.T) {
+	payload := fixturePayload()
+	engine := newEngineServer(t)
+	engine.results[GetPayloadMethod] = pb.NewExecutionPayloadJSON(payload)
+	service := engine.service(t, nil)
+
+	got, err := service.GetPayload(context.Background(), [8]byte{1})
+	require.NoError(t, err)
+	require.DeepEqual(t, payload, got)
+	require.Equal(t, `"0x0100000000000000"`, string(engine.params[GetPayloadMethod][0]))
+
+	engine.errors[GetPayloadMethod] = -32001
+	_, err = service.GetPayload(context.Background(), [8]byte{1})
+	require.ErrorIs(t, err, ErrUnknownPayload)
+}
+
+func TestClient_ExecutionBlocks(t *testing.T) {
+	block := &pb.ExecutionBlock{
+		BlockHash:       bytesutil.PadTo([]byte("hash"), 32),
+		ParentHash:      bytesutil.PadTo([]byte("parent"), 32),
+		Number:          100,
+		Timestamp:       200,
+		Difficulty:      []byte{1},
+		TotalDifficulty: []byte{1, 0},
+	}
+	engine := newEngineServer(t)
+	engine.results[ExecutionBlockByNumberMethod] = block
+	engine.results[ExecutionBlockByHashMethod] = block
+	service := engine.service(t, nil)
+
+	latest, err := service.LatestExecutionBlock(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, block, latest)
+	require.Equal(t, `"latest"`, string(engine.params[ExecutionBlockByNumberMethod][0]))
+
+	byHash, err := service.ExecutionBlockByHash(context.Background(), common.BytesToHash(block.BlockHash))
+	require.NoError(t, err)
+	require.DeepEqual(t, block, byHash)
+
+	engine.results[ExecutionBlockByHashMethod] = nil
+	_, err = service.ExecutionBlockByHash(context.Background(), common.BytesToHash(block.BlockHash))
+	require.ErrorIs(t, err, ErrNilResponse)
+}
+
+func TestClient_ClientVersion(t *testing.T) {
+	engine := newEngineServer(t)
+	engine.results[ClientVersionMethod] = "Geth/v1.10.17-stable/linux-amd64/go1.18"
+	service := engine.service(t, nil)
+
+	v, err := service.ClientVersion(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Geth/v1.10.17-stable/linux-amd64/go1.18", v)
+}
+
+func TestClient_JWTAuthentication(t *testing.T) {
+	var header string
+	engine := newEngineServer(t)
+	engine.results[NewPayloadMethod] = &pb.PayloadStatus{Status: pb.PayloadStatus_VALID}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		engine.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	service := &Service{cfg: &config{jwtSecret: bytesutil.PadTo([]byte("secret"), 32)}}
+	client, err := service.newRPCClient(HttpEndpoint(srv.URL))
+	require.NoError(t, err)
+	defer client.Close()
+	service.rpcClient = client
+
+	_, err = service.NewPayload(context.Background(), fixturePayload())
+	require.NoError(t, err)
+	require.Equal(t, true, strings.HasPrefix(header, "Bearer "))
+
+	_, err = service.newRPCClient(HttpEndpoint("ws://localhost:8551"))
+	require.ErrorContains(t, "JWT authentication is only supported for http endpoints", err)
+}
+
+func TestClient_NoConnection(t *testing.T) {
+	service := &Service{cfg: &config{}}
+	_, err := service.NewPayload(context.Background(), fixturePayload())
+	require.ErrorIs(t, err, errNoEngineClient)
+	_, _, err = service.ForkchoiceUpdated(context.Background(), &pb.ForkchoiceState{}, nil)
+	require.ErrorIs(t, err, errNoEngineClient)
+}
+
+func Test_handleRPCError(t *testing.T) {
+	tests := []struct {
+		code int
+		want error
+	}{
+		{code: -32700, want: ErrParse},
+		{code: -32600, want: ErrInvalidRequest},
+		{code: -32601, want: ErrMethodNotFound},
+		{code: -32602, want: ErrInvalidParams},
+		{code: -32603, want: ErrInternal},
+		{code: -32001, want: ErrUnknownPayload},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.code), func(t *testing.T) {
+			require.ErrorIs(t, handleRPCError(&rpcError{code: tt.code}), tt.want)
+		})
+	}
+	require.ErrorIs(t, handleRPCError(context.DeadlineExceeded), ErrHTTPTimeout)
+	require.ErrorContains(t, "got an unexpected error", handleRPCError(errors.New("foo")))
+	require.NoError(t, handleRPCError(nil))
+}
+
+type rpcError struct {
+	code int
+}
+
+func (r *rpcError) Error() string {
+	return "rpc error"
+}
+
+func (r *rpcError) ErrorCode() int {
+	return r.code
+}
+
+var _ gethRPC.Error = (*rpcError)(nil)
+
+func fixturePayload() *ethpb.ExecutionPayload {
+	return &ethpb.ExecutionPayload{
+		ParentHash:    bytesutil.PadTo([]byte("parent"), 32),
+		FeeRecipient:  bytesutil.PadTo([]byte("fee"), 20),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		ReceiptRoot:   bytesutil.PadTo([]byte("receipts"), 32),
+		LogsBloom:     bytesutil.PadTo([]byte("logs"), 256),
+		Random:        bytesutil.PadTo([]byte("random"), 32),
+		BlockNumber:   1,
+		GasLimit:      2,
+		GasUsed:       3,
+		Timestamp:     4,
+		ExtraData:     []byte("extra"),
+		BaseFeePerGas: bytesutil.PadTo([]byte{0, 1}, 32),
+		BlockHash:     bytesutil.PadTo([]byte("hash"), 32),
+		Transactions:  [][]byte{[]byte("tx1"), []byte("tx2")},
+	}
+}
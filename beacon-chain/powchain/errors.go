@@ -0,0 +1,36 @@
+package powchain
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrParse corresponds to JSON-RPC code -32700.
+	ErrParse = errors.New("invalid JSON was received by the server")
+	// ErrInvalidRequest corresponds to JSON-RPC code -32600.
+	ErrInvalidRequest = errors.New("JSON sent is not valid request object")
+	// ErrMethodNotFound corresponds to JSON-RPC code -32601.
+	ErrMethodNotFound = errors.New("method not found")
+	// ErrInvalidParams corresponds to JSON-RPC code -32602.
+	ErrInvalidParams = errors.New("invalid method parameter(s)")
+	// ErrInternal corresponds to JSON-RPC code -32603.
+	ErrInternal = errors.New("internal JSON-RPC error")
+	// ErrServer corresponds to JSON-RPC code -32000.
+	ErrServer = errors.New("client error while processing request")
+	// ErrUnknownPayload corresponds to JSON-RPC code -32001.
+	ErrUnknownPayload = errors.New("payload does not exist or is not available")
+	// ErrUnknownPayloadStatus when the payload status is unknown.
+	ErrUnknownPayloadStatus = errors.New("unknown payload status")
+	// ErrAcceptedSyncingPayloadStatus when the status of the payload is syncing or accepted.
+	ErrAcceptedSyncingPayloadStatus = errors.New("payload status is SYNCING or ACCEPTED")
+	// ErrInvalidPayloadStatus when the status of the payload is invalid.
+	ErrInvalidPayloadStatus = errors.New("payload status is INVALID")
+	// ErrInvalidBlockHashPayloadStatus when the status of the payload fails to validate block hash.
+	ErrInvalidBlockHashPayloadStatus = errors.New("payload status is INVALID_BLOCK_HASH")
+	// ErrInvalidTerminalBlockPayloadStatus when the status of the payload fails to validate the terminal block.
+	ErrInvalidTerminalBlockPayloadStatus = errors.New("payload status is INVALID_TERMINAL_BLOCK")
+	// ErrNilResponse when the response is nil.
+	ErrNilResponse = errors.New("nil response")
+	// ErrHTTPTimeout when the request to the execution engine timed out.
+	ErrHTTPTimeout = errors.New("timeout from http.Client")
+	// errNoEngineClient when the service has no connection to an execution engine.
+	errNoEngineClient = errors.New("no connection to an execution engine")
+)
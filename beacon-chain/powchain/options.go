@@ -95,3 +95,15 @@ func WithFinalizedStateAtStartup(st state.BeaconState) Option {
 		return nil
 	}
 }
+
+// WithJWTSecret sets the secret used to authenticate requests to the
+// execution engine API with JWT tokens.
+func WithJWTSecret(secret []byte) Option {
+	return func(s *Service) error {
+		if len(secret) == 0 {
+			return nil
+		}
+		s.cfg.jwtSecret = secret
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"net/url"
 	"reflect"
 	"runtime/debug"
 	"sort"
@@ -121,6 +122,7 @@ type RPCDataFetcher interface {
 // RPCClient defines the rpc methods required to interact with the eth1 node.
 type RPCClient interface {
 	BatchCall(b []gethRPC.BatchElem) error
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 }
 
 // config defines a config struct for dependencies into the service.
@@ -134,6 +136,7 @@ type config struct {
 	beaconNodeStatsUpdater  BeaconNodeStatsUpdater
 	httpEndpoints           []network.Endpoint
 	currHttpEndpoint        network.Endpoint
+	jwtSecret               []byte
 	finalizedStateAtStartup state.BeaconState
 }
 
@@ -428,7 +431,7 @@ func (s *Service) connectToPowChain() error {
 }
 
 func (s *Service) dialETH1Nodes(endpoint network.Endpoint) (*ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(endpoint.Url)
+	httpRPCClient, err := s.newRPCClient(endpoint)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -478,6 +481,23 @@ func (s *Service) dialETH1Nodes(endpoint network.Endpoint) (*ethclient.Client, *
 	return httpClient, httpRPCClient, nil
 }
 
+// newRPCClient dials the given endpoint. When a JWT secret is configured, every
+// request is authenticated with a token signed by that secret, as required by
+// the engine API of execution clients.
+func (s *Service) newRPCClient(endpoint network.Endpoint) (*gethRPC.Client, error) {
+	if len(s.cfg.jwtSecret) == 0 {
+		return gethRPC.Dial(endpoint.Url)
+	}
+	u, err := url.Parse(endpoint.Url)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("JWT authentication is only supported for http endpoints, got %q", u.Scheme)
+	}
+	return gethRPC.DialHTTPWithClient(endpoint.Url, network.NewHttpClientWithJWTSecret(s.cfg.jwtSecret))
+}
+
 func (s *Service) initializeConnection(
 	httpClient *ethclient.Client,
 	rpcClient *gethRPC.Client,
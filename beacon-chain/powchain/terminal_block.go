@@ -0,0 +1,114 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	"go.opencensus.io/trace"
+)
+
+// GetTerminalBlockHash returns the hash of the terminal proof-of-work block, which is the first
+// execution block whose total difficulty reaches the terminal total difficulty, and whether it
+// exists. If the terminal block hash is overridden, the block of that hash is the terminal block.
+//
+// Spec pseudocode definition:
+//  def get_pow_block_at_terminal_total_difficulty(pow_chain: Dict[Hash32, PowBlock]) -> Optional[PowBlock]:
+//    # `pow_chain` abstractly represents all blocks in the PoW chain
+//    for block in pow_chain:
+//        block_reached_ttd = block.total_difficulty >= TERMINAL_TOTAL_DIFFICULTY
+//        if block_reached_ttd:
+//            # If genesis block, no parent exists so reaching TTD alone qualifies as valid terminal block
+//            if block.parent_hash == Hash32():
+//                return block
+//            parent = pow_chain[block.parent_hash]
+//            parent_reached_ttd = parent.total_difficulty >= TERMINAL_TOTAL_DIFFICULTY
+//            if not parent_reached_ttd:
+//                return block
+//
+//    return None
+func (s *Service) GetTerminalBlockHash(ctx context.Context) ([]byte, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.GetTerminalBlockHash")
+	defer span.End()
+
+	if terminalBlockHash := params.BeaconConfig().TerminalBlockHash; terminalBlockHash != (common.Hash{}) {
+		if _, err := s.ExecutionBlockByHash(ctx, terminalBlockHash); err != nil {
+			if errors.Is(err, ErrNilResponse) {
+				return nil, false, nil
+			}
+			return nil, false, errors.Wrap(err, "could not get terminal block")
+		}
+		return terminalBlockHash.Bytes(), true, nil
+	}
+
+	blk, err := s.LatestExecutionBlock(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "could not get latest execution block")
+	}
+	if blk == nil {
+		return nil, false, ErrNilResponse
+	}
+	// Walk back from the latest block until the block whose parent is below the terminal total difficulty.
+	for reachedTerminalTotalDifficulty(blk) {
+		if ctx.Err() != nil {
+			return nil, false, ctx.Err()
+		}
+		parentHash := common.BytesToHash(blk.ParentHash)
+		if parentHash == (common.Hash{}) {
+			return blk.BlockHash, true, nil
+		}
+		parent, err := s.ExecutionBlockByHash(ctx, parentHash)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "could not get execution block %#x", parentHash)
+		}
+		if !reachedTerminalTotalDifficulty(parent) {
+			return blk.BlockHash, true, nil
+		}
+		blk = parent
+	}
+	return nil, false, nil
+}
+
+// IsValidTerminalBlockHash returns true if the execution block of the given hash is a valid terminal
+// proof-of-work block. If the terminal block hash is overridden, only the block of that hash is valid.
+//
+// Spec pseudocode definition:
+//  def is_valid_terminal_pow_block(block: PowBlock, parent: PowBlock) -> bool:
+//    is_total_difficulty_reached = block.total_difficulty >= TERMINAL_TOTAL_DIFFICULTY
+//    is_parent_total_difficulty_valid = parent.total_difficulty < TERMINAL_TOTAL_DIFFICULTY
+//    return is_total_difficulty_reached and is_parent_total_difficulty_valid
+func (s *Service) IsValidTerminalBlockHash(ctx context.Context, blkHash common.Hash) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.IsValidTerminalBlockHash")
+	defer span.End()
+
+	if terminalBlockHash := params.BeaconConfig().TerminalBlockHash; terminalBlockHash != (common.Hash{}) {
+		return blkHash == terminalBlockHash, nil
+	}
+
+	blk, err := s.ExecutionBlockByHash(ctx, blkHash)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get terminal block")
+	}
+	if !reachedTerminalTotalDifficulty(blk) {
+		return false, nil
+	}
+	parentHash := common.BytesToHash(blk.ParentHash)
+	if parentHash == (common.Hash{}) {
+		return true, nil
+	}
+	parent, err := s.ExecutionBlockByHash(ctx, parentHash)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get terminal block's parent")
+	}
+	return !reachedTerminalTotalDifficulty(parent), nil
+}
+
+// reachedTerminalTotalDifficulty returns true if the total difficulty of the given
+// execution block is at least the terminal total difficulty.
+func reachedTerminalTotalDifficulty(blk *pb.ExecutionBlock) bool {
+	ttd := new(big.Int).SetUint64(params.BeaconConfig().TerminalTotalDifficulty)
+	return new(big.Int).SetBytes(blk.TotalDifficulty).Cmp(ttd) >= 0
+}
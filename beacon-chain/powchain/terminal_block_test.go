@@ -0,0 +1,169 @@
+package powchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	mocks "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/network"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func executionChainService(t *testing.T, chain *mocks.ExecutionChain) *Service {
+	srv, err := chain.Server()
+	require.NoError(t, err)
+	t.Cleanup(srv.Close)
+	service := &Service{cfg: &config{}}
+	client, err := service.newRPCClient(network.Endpoint{Url: srv.URL})
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	service.rpcClient = client
+	return service
+}
+
+func setTerminalConfig(t *testing.T, ttd uint64, terminalBlockHash common.Hash) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.TerminalTotalDifficulty = ttd
+	cfg.TerminalBlockHash = terminalBlockHash
+	params.OverrideBeaconConfig(cfg)
+}
+
+func TestService_GetTerminalBlockHash(t *testing.T) {
+	ctx := context.Background()
+	// Blocks 0 to 5 have a total difficulty of 10, 20, 30, 40, 50 and 60.
+	chain := mocks.NewExecutionChain(10)
+	for i := 0; i < 5; i++ {
+		chain.AddBlock(10)
+	}
+	service := executionChainService(t, chain)
+
+	tests := []struct {
+		name              string
+		ttd               uint64
+		terminalBlockHash common.Hash
+		wantHash          []byte
+		wantExists        bool
+	}{
+		{
+			name: "terminal total difficulty not reached",
+			ttd:  61,
+		},
+		{
+			name:       "latest block is the terminal block",
+			ttd:        60,
+			wantHash:   chain.BlockByNumber(5).BlockHash,
+			wantExists: true,
+		},
+		{
+			name:       "terminal block in the past",
+			ttd:        25,
+			wantHash:   chain.BlockByNumber(2).BlockHash,
+			wantExists: true,
+		},
+		{
+			name:       "genesis is the terminal block",
+			ttd:        10,
+			wantHash:   chain.BlockByNumber(0).BlockHash,
+			wantExists: true,
+		},
+		{
+			name:              "terminal block hash override",
+			ttd:               1000,
+			terminalBlockHash: common.BytesToHash(chain.BlockByNumber(1).BlockHash),
+			wantHash:          chain.BlockByNumber(1).BlockHash,
+			wantExists:        true,
+		},
+		{
+			name:              "unknown terminal block hash override",
+			terminalBlockHash: common.HexToHash("0x1234"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTerminalConfig(t, tt.ttd, tt.terminalBlockHash)
+			h, exists, err := service.GetTerminalBlockHash(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantExists, exists)
+			assert.DeepEqual(t, tt.wantHash, h)
+		})
+	}
+}
+
+func TestService_IsValidTerminalBlockHash(t *testing.T) {
+	ctx := context.Background()
+	chain := mocks.NewExecutionChain(10)
+	for i := 0; i < 5; i++ {
+		chain.AddBlock(10)
+	}
+	service := executionChainService(t, chain)
+	blockHash := func(number uint64) common.Hash {
+		return common.BytesToHash(chain.BlockByNumber(number).BlockHash)
+	}
+
+	tests := []struct {
+		name              string
+		ttd               uint64
+		terminalBlockHash common.Hash
+		blkHash           common.Hash
+		want              bool
+		wantErr           string
+	}{
+		{
+			name:    "valid terminal block",
+			ttd:     25,
+			blkHash: blockHash(2),
+			want:    true,
+		},
+		{
+			name:    "terminal total difficulty not reached",
+			ttd:     25,
+			blkHash: blockHash(1),
+		},
+		{
+			name:    "parent reached terminal total difficulty",
+			ttd:     25,
+			blkHash: blockHash(3),
+		},
+		{
+			name:    "genesis reached terminal total difficulty",
+			ttd:     10,
+			blkHash: blockHash(0),
+			want:    true,
+		},
+		{
+			name:              "matches terminal block hash override",
+			ttd:               25,
+			terminalBlockHash: blockHash(4),
+			blkHash:           blockHash(4),
+			want:              true,
+		},
+		{
+			name:              "does not match terminal block hash override",
+			ttd:               25,
+			terminalBlockHash: blockHash(4),
+			blkHash:           blockHash(2),
+		},
+		{
+			name:    "unknown block",
+			ttd:     25,
+			blkHash: common.HexToHash("0x1234"),
+			wantErr: "could not get terminal block: nil response",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTerminalConfig(t, tt.ttd, tt.terminalBlockHash)
+			valid, err := service.IsValidTerminalBlockHash(ctx, tt.blkHash)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, valid)
+		})
+	}
+}
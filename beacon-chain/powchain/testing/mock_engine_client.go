@@ -0,0 +1,84 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// EngineClient --
+type EngineClient struct {
+	NewPayloadResp          []byte
+	PayloadIDBytes          *pb.PayloadIDBytes
+	ForkChoiceUpdatedResp   []byte
+	ExecutionPayload        *ethpb.ExecutionPayload
+	ErrLatestExecBlock      error
+	ErrExecBlockByHash      error
+	ErrForkchoiceUpdated    error
+	ErrNewPayload           error
+	ErrGetPayload           error
+	BlockByHashMap          map[[32]byte]*pb.ExecutionBlock
+	LatestBlock             *pb.ExecutionBlock
+	TerminalBlockHash       []byte
+	TerminalBlockHashExists bool
+	ErrTerminalBlockHash    error
+	ValidTerminalBlockHash  bool
+	ErrIsValidTerminalBlock error
+	ForkchoiceUpdatedStates []*pb.ForkchoiceState
+	PayloadAttributes       []*pb.PayloadAttributes
+	NewPayloads             []*ethpb.ExecutionPayload
+	Version                 string
+	ErrClientVersion        error
+}
+
+// NewPayload --
+func (e *EngineClient) NewPayload(_ context.Context, payload *ethpb.ExecutionPayload) ([]byte, error) {
+	e.NewPayloads = append(e.NewPayloads, payload)
+	return e.NewPayloadResp, e.ErrNewPayload
+}
+
+// ForkchoiceUpdated --
+func (e *EngineClient) ForkchoiceUpdated(
+	_ context.Context, state *pb.ForkchoiceState, attrs *pb.PayloadAttributes,
+) (*pb.PayloadIDBytes, []byte, error) {
+	e.ForkchoiceUpdatedStates = append(e.ForkchoiceUpdatedStates, state)
+	e.PayloadAttributes = append(e.PayloadAttributes, attrs)
+	return e.PayloadIDBytes, e.ForkChoiceUpdatedResp, e.ErrForkchoiceUpdated
+}
+
+// GetPayload --
+func (e *EngineClient) GetPayload(_ context.Context, _ [8]byte) (*ethpb.ExecutionPayload, error) {
+	return e.ExecutionPayload, e.ErrGetPayload
+}
+
+// LatestExecutionBlock --
+func (e *EngineClient) LatestExecutionBlock(_ context.Context) (*pb.ExecutionBlock, error) {
+	return e.LatestBlock, e.ErrLatestExecBlock
+}
+
+// ExecutionBlockByHash --
+func (e *EngineClient) ExecutionBlockByHash(_ context.Context, h common.Hash) (*pb.ExecutionBlock, error) {
+	b, ok := e.BlockByHashMap[h]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	return b, e.ErrExecBlockByHash
+}
+
+// GetTerminalBlockHash --
+func (e *EngineClient) GetTerminalBlockHash(_ context.Context) ([]byte, bool, error) {
+	return e.TerminalBlockHash, e.TerminalBlockHashExists, e.ErrTerminalBlockHash
+}
+
+// IsValidTerminalBlockHash --
+func (e *EngineClient) IsValidTerminalBlockHash(_ context.Context, _ common.Hash) (bool, error) {
+	return e.ValidTerminalBlockHash, e.ErrIsValidTerminalBlock
+}
+
+// ClientVersion --
+func (e *EngineClient) ClientVersion(_ context.Context) (string, error) {
+	return e.Version, e.ErrClientVersion
+}
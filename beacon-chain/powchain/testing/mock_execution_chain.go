@@ -0,0 +1,119 @@
+package testing
+
+import (
+	"encoding/binary"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	pb "github.com/prysmaticlabs/prysm/proto/engine/v1"
+)
+
+// ExecutionChain is a simulated proof-of-work execution chain which tracks the total
+// difficulty of its blocks. It can be served over JSON-RPC to exercise an execution
+// engine client against a chain approaching the terminal total difficulty.
+type ExecutionChain struct {
+	blocks   []*pb.ExecutionBlock
+	byHash   map[[32]byte]*pb.ExecutionBlock
+	lock     sync.RWMutex
+	BlockErr error
+}
+
+// NewExecutionChain creates a simulated execution chain with a genesis block
+// of the given difficulty.
+func NewExecutionChain(genesisDifficulty uint64) *ExecutionChain {
+	c := &ExecutionChain{
+		byHash: make(map[[32]byte]*pb.ExecutionBlock),
+	}
+	c.AddBlock(genesisDifficulty)
+	return c
+}
+
+// AddBlock mines a block of the given difficulty on top of the chain's head and returns it.
+func (c *ExecutionChain) AddBlock(difficulty uint64) *pb.ExecutionBlock {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	number := uint64(len(c.blocks))
+	parentHash := make([]byte, 32)
+	totalDifficulty := new(big.Int).SetUint64(difficulty)
+	if number > 0 {
+		parent := c.blocks[number-1]
+		parentHash = parent.BlockHash
+		totalDifficulty.Add(totalDifficulty, new(big.Int).SetBytes(parent.TotalDifficulty))
+	}
+	enc := make([]byte, 8)
+	binary.LittleEndian.PutUint64(enc, number)
+	blockHash := hash.Hash(enc)
+	blk := &pb.ExecutionBlock{
+		BlockHash:       blockHash[:],
+		ParentHash:      parentHash,
+		Number:          number,
+		Timestamp:       number * 14,
+		Difficulty:      new(big.Int).SetUint64(difficulty).Bytes(),
+		TotalDifficulty: totalDifficulty.Bytes(),
+	}
+	c.blocks = append(c.blocks, blk)
+	c.byHash[blockHash] = blk
+	return blk
+}
+
+// BlockByNumber returns the block of the given number, or nil if the chain is not that long.
+func (c *ExecutionChain) BlockByNumber(number uint64) *pb.ExecutionBlock {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if number >= uint64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[number]
+}
+
+// BlockByHash returns the block of the given hash, or nil if it is not part of the chain.
+func (c *ExecutionChain) BlockByHash(h common.Hash) *pb.ExecutionBlock {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.byHash[h]
+}
+
+// Head returns the latest block of the chain.
+func (c *ExecutionChain) Head() *pb.ExecutionBlock {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.blocks[len(c.blocks)-1]
+}
+
+// Server starts a JSON-RPC server answering the eth_getBlockByHash and eth_getBlockByNumber
+// methods with the blocks of the chain. The caller is responsible for closing the server.
+func (c *ExecutionChain) Server() (*httptest.Server, error) {
+	srv := gethRPC.NewServer()
+	if err := srv.RegisterName("eth", &executionChainAPI{chain: c}); err != nil {
+		return nil, err
+	}
+	return httptest.NewServer(srv), nil
+}
+
+// executionChainAPI is the eth namespace of the simulated execution chain's JSON-RPC server.
+type executionChainAPI struct {
+	chain *ExecutionChain
+}
+
+// GetBlockByHash --
+func (api *executionChainAPI) GetBlockByHash(h common.Hash, _ bool) (*pb.ExecutionBlock, error) {
+	if api.chain.BlockErr != nil {
+		return nil, api.chain.BlockErr
+	}
+	return api.chain.BlockByHash(h), nil
+}
+
+// GetBlockByNumber --
+func (api *executionChainAPI) GetBlockByNumber(number gethRPC.BlockNumber, _ bool) (*pb.ExecutionBlock, error) {
+	if api.chain.BlockErr != nil {
+		return nil, api.chain.BlockErr
+	}
+	if number < 0 {
+		return api.chain.Head(), nil
+	}
+	return api.chain.BlockByNumber(uint64(number)), nil
+}
@@ -180,6 +180,11 @@ func (r *RPCClient) BatchCall(b []rpc.BatchElem) error {
 	return nil
 }
 
+// CallContext --
+func (*RPCClient) CallContext(_ context.Context, _ interface{}, _ string, _ ...interface{}) error {
+	return nil
+}
+
 // InsertBlock adds provided block info into the chain.
 func (m *POWChain) InsertBlock(height int, time uint64, hash []byte) *POWChain {
 	m.HashesByHeight[height] = hash
@@ -190,6 +190,50 @@ func TestWrapBeaconCommitteeSubscriptionsArray(t *testing.T) {
 	})
 }
 
+func TestWrapFeeRecipientsArray(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &prepareBeaconProposerRequestJson{},
+		}
+		unwrappedRecipients := []*feeRecipientJson{{
+			ValidatorIndex: "1",
+			FeeRecipient:   "0x6e35733c5af9b61374a128e6f85f553af09ff89a",
+		}}
+		unwrappedRecipientsJson, err := json.Marshal(unwrappedRecipients)
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		_, err = body.Write(unwrappedRecipientsJson)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapFeeRecipientsArray(endpoint, nil, request)
+		require.Equal(t, true, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(true), runDefault)
+		wrappedRecipients := &prepareBeaconProposerRequestJson{}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(wrappedRecipients))
+		require.Equal(t, 1, len(wrappedRecipients.Recipients), "wrong number of wrapped items")
+		assert.Equal(t, "1", wrappedRecipients.Recipients[0].ValidatorIndex)
+		assert.Equal(t, "0x6e35733c5af9b61374a128e6f85f553af09ff89a", wrappedRecipients.Recipients[0].FeeRecipient)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		endpoint := &apimiddleware.Endpoint{
+			PostRequest: &prepareBeaconProposerRequestJson{},
+		}
+		var body bytes.Buffer
+		_, err := body.Write([]byte("invalid"))
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://foo.example", &body)
+
+		runDefault, errJson := wrapFeeRecipientsArray(endpoint, nil, request)
+		require.Equal(t, false, errJson == nil)
+		assert.Equal(t, apimiddleware.RunDefault(false), runDefault)
+		assert.Equal(t, true, strings.Contains(errJson.Msg(), "could not decode body"))
+		assert.Equal(t, http.StatusInternalServerError, errJson.StatusCode())
+	})
+}
+
 func TestWrapSyncCommitteeSubscriptionsArray(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		endpoint := &apimiddleware.Endpoint{
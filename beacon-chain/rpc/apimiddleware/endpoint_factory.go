@@ -37,6 +37,10 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/beacon/pool/proposer_slashings",
 		"/eth/v1/beacon/pool/voluntary_exits",
 		"/eth/v1/beacon/pool/sync_committees",
+		"/eth/v1/beacon/light_client/bootstrap/{block_root}",
+		"/eth/v1/beacon/light_client/updates",
+		"/eth/v1/beacon/light_client/finality_update",
+		"/eth/v1/beacon/light_client/optimistic_update",
 		"/eth/v1/node/identity",
 		"/eth/v1/node/peers",
 		"/eth/v1/node/peers/{peer_id}",
@@ -47,6 +51,7 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/debug/beacon/states/{state_id}",
 		"/eth/v2/debug/beacon/states/{state_id}",
 		"/eth/v1/debug/beacon/heads",
+		"/eth/v1/debug/fork_choice",
 		"/eth/v1/config/fork_schedule",
 		"/eth/v1/config/deposit_contract",
 		"/eth/v1/config/spec",
@@ -63,6 +68,8 @@ func (_ *BeaconEndpointFactory) Paths() []string {
 		"/eth/v1/validator/aggregate_and_proofs",
 		"/eth/v1/validator/sync_committee_contribution",
 		"/eth/v1/validator/contribution_and_proofs",
+		"/eth/v1/validator/prepare_beacon_proposer",
+		"/eth/v1/validator/liveness/{epoch}",
 	}
 }
 
@@ -141,6 +148,15 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapSyncCommitteeSignaturesArray,
 		}
+	case "/eth/v1/beacon/light_client/bootstrap/{block_root}":
+		endpoint.GetResponse = &lightClientBootstrapResponseJson{}
+	case "/eth/v1/beacon/light_client/updates":
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "start_period"}, {Name: "count"}}
+		endpoint.GetResponse = &lightClientUpdatesResponseJson{}
+	case "/eth/v1/beacon/light_client/finality_update":
+		endpoint.GetResponse = &lightClientFinalityUpdateResponseJson{}
+	case "/eth/v1/beacon/light_client/optimistic_update":
+		endpoint.GetResponse = &lightClientOptimisticUpdateResponseJson{}
 	case "/eth/v1/node/identity":
 		endpoint.GetResponse = &identityResponseJson{}
 	case "/eth/v1/node/peers":
@@ -168,6 +184,9 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.CustomHandlers = []apimiddleware.CustomHandler{handleGetBeaconStateSSZV2}
 	case "/eth/v1/debug/beacon/heads":
 		endpoint.GetResponse = &forkChoiceHeadsResponseJson{}
+	case "/eth/v1/debug/fork_choice":
+		endpoint.RequestQueryParams = []apimiddleware.QueryParam{{Name: "root", Hex: true}}
+		endpoint.GetResponse = &forkChoiceResponseJson{}
 	case "/eth/v1/config/fork_schedule":
 		endpoint.GetResponse = &forkScheduleResponseJson{}
 	case "/eth/v1/config/deposit_contract":
@@ -238,6 +257,18 @@ func (_ *BeaconEndpointFactory) Create(path string) (*apimiddleware.Endpoint, er
 		endpoint.Hooks = apimiddleware.HookCollection{
 			OnPreDeserializeRequestBodyIntoContainer: wrapSignedContributionAndProofsArray,
 		}
+	case "/eth/v1/validator/prepare_beacon_proposer":
+		endpoint.PostRequest = &prepareBeaconProposerRequestJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapFeeRecipientsArray,
+		}
+	case "/eth/v1/validator/liveness/{epoch}":
+		endpoint.PostRequest = &dutiesRequestJson{}
+		endpoint.PostResponse = &livenessResponseJson{}
+		endpoint.Err = &nodeSyncDetailsErrorJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: wrapValidatorIndicesArray,
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -132,6 +132,29 @@ type submitSyncCommitteeSignaturesRequestJson struct {
 	Data []*syncCommitteeMessageJson `json:"data"`
 }
 
+// lightClientBootstrapResponseJson is used in /beacon/light_client/bootstrap/{block_root} API endpoint.
+type lightClientBootstrapResponseJson struct {
+	Version string                    `json:"version" enum:"true"`
+	Data    *lightClientBootstrapJson `json:"data"`
+}
+
+// lightClientUpdatesResponseJson is used in /beacon/light_client/updates API endpoint.
+type lightClientUpdatesResponseJson struct {
+	Data []*lightClientUpdateWithVersionJson `json:"data"`
+}
+
+// lightClientFinalityUpdateResponseJson is used in /beacon/light_client/finality_update API endpoint.
+type lightClientFinalityUpdateResponseJson struct {
+	Version string                         `json:"version" enum:"true"`
+	Data    *lightClientFinalityUpdateJson `json:"data"`
+}
+
+// lightClientOptimisticUpdateResponseJson is used in /beacon/light_client/optimistic_update API endpoint.
+type lightClientOptimisticUpdateResponseJson struct {
+	Version string                           `json:"version" enum:"true"`
+	Data    *lightClientOptimisticUpdateJson `json:"data"`
+}
+
 // identityResponseJson is used in /node/identity API endpoint.
 type identityResponseJson struct {
 	Data *identityJson `json:"data"`
@@ -186,6 +209,13 @@ type forkChoiceHeadsResponseJson struct {
 	Data []*forkChoiceHeadJson `json:"data"`
 }
 
+// forkChoiceResponseJson is used in /debug/fork_choice API endpoint.
+type forkChoiceResponseJson struct {
+	JustifiedCheckpoint *checkpointJson       `json:"justified_checkpoint"`
+	FinalizedCheckpoint *checkpointJson       `json:"finalized_checkpoint"`
+	ForkChoiceNodes     []*forkChoiceNodeJson `json:"fork_choice_nodes"`
+}
+
 // forkScheduleResponseJson is used in /config/fork_schedule API endpoint.
 type forkScheduleResponseJson struct {
 	Data []*forkJson `json:"data"`
@@ -275,6 +305,28 @@ type submitAggregateAndProofsRequestJson struct {
 	Data []*signedAggregateAttestationAndProofJson `json:"data"`
 }
 
+// prepareBeaconProposerRequestJson is used in /validator/prepare_beacon_proposer API endpoint.
+type prepareBeaconProposerRequestJson struct {
+	Recipients []*feeRecipientJson `json:"recipients"`
+}
+
+// feeRecipientJson is used in /validator/prepare_beacon_proposer API endpoint.
+type feeRecipientJson struct {
+	ValidatorIndex string `json:"validator_index"`
+	FeeRecipient   string `json:"fee_recipient" hex:"true"`
+}
+
+// livenessResponseJson is used in /validator/liveness/{epoch} API endpoint.
+type livenessResponseJson struct {
+	Data []*livenessJson `json:"data"`
+}
+
+// livenessJson is used in /validator/liveness/{epoch} API endpoint.
+type livenessJson struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
 // produceSyncCommitteeContributionResponseJson is used in /validator/sync_committee_contribution API endpoint.
 type produceSyncCommitteeContributionResponseJson struct {
 	Data *syncCommitteeContributionJson `json:"data"`
@@ -635,6 +687,17 @@ type forkChoiceHeadJson struct {
 	Slot string `json:"slot"`
 }
 
+type forkChoiceNodeJson struct {
+	Slot                string `json:"slot"`
+	BlockRoot           string `json:"block_root" hex:"true"`
+	ParentRoot          string `json:"parent_root" hex:"true"`
+	JustifiedEpoch      string `json:"justified_epoch"`
+	FinalizedEpoch      string `json:"finalized_epoch"`
+	Weight              string `json:"weight"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	BestDescendant      string `json:"best_descendant" hex:"true"`
+}
+
 type depositContractJson struct {
 	ChainId string `json:"chain_id"`
 	Address string `json:"address"`
@@ -698,6 +761,41 @@ type syncCommitteeContributionJson struct {
 	Signature         string `json:"signature" hex:"true"`
 }
 
+type lightClientBootstrapJson struct {
+	Header                     *beaconBlockHeaderJson `json:"header"`
+	CurrentSyncCommittee       *syncCommitteeJson     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string               `json:"current_sync_committee_branch" hex:"true"`
+}
+
+type lightClientUpdateWithVersionJson struct {
+	Version string                 `json:"version" enum:"true"`
+	Data    *lightClientUpdateJson `json:"data"`
+}
+
+type lightClientUpdateJson struct {
+	AttestedHeader          *beaconBlockHeaderJson `json:"attested_header"`
+	NextSyncCommittee       *syncCommitteeJson     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string               `json:"next_sync_committee_branch" hex:"true"`
+	FinalizedHeader         *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch          []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate           *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot           string                 `json:"signature_slot"`
+}
+
+type lightClientFinalityUpdateJson struct {
+	AttestedHeader  *beaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate   *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type lightClientOptimisticUpdateJson struct {
+	AttestedHeader *beaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
 //----------------
 // SSZ
 // ---------------
@@ -101,6 +101,8 @@ func TestGetSpec(t *testing.T) {
 	config.TerminalBlockHashActivationEpoch = 72
 	config.TerminalTotalDifficulty = 73
 	config.FeeRecipient = common.HexToAddress("FeeRecipient")
+	config.ProposerScoreBoost = 74
+	config.IntervalsPerSlot = 75
 
 	var dbp [4]byte
 	copy(dbp[:], []byte{'0', '0', '0', '1'})
@@ -130,7 +132,7 @@ func TestGetSpec(t *testing.T) {
 	resp, err := server.GetSpec(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 
-	assert.Equal(t, 97, len(resp.Data))
+	assert.Equal(t, 99, len(resp.Data))
 	for k, v := range resp.Data {
 		switch k {
 		case "CONFIG_NAME":
@@ -337,6 +339,10 @@ func TestGetSpec(t *testing.T) {
 			assert.Equal(t, "32", v)
 		case "INACTIVITY_PENALTY_QUOTIENT_MERGE":
 			assert.Equal(t, "16777216", v)
+		case "PROPOSER_SCORE_BOOST":
+			assert.Equal(t, "74", v)
+		case "INTERVALS_PER_SLOT":
+			assert.Equal(t, "75", v)
 		default:
 			t.Errorf("Incorrect key: %s", k)
 		}
@@ -0,0 +1,106 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRequestLightClientUpdates is the maximum number of sync committee periods
+// for which light client updates can be requested at once.
+const maxRequestLightClientUpdates = 128
+
+// GetLightClientBootstrap retrieves the light client bootstrap object for the given trusted block root.
+func (bs *Server) GetLightClientBootstrap(ctx context.Context, req *ethpbv2.LightClientBootstrapRequest) (*ethpbv2.LightClientBootstrapResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.GetLightClientBootstrap")
+	defer span.End()
+
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block root must be 32 bytes long, got %d", len(req.BlockRoot))
+	}
+	bootstrap, err := bs.LightClientFetcher.Bootstrap(ctx, bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		if errors.Is(err, lightclient.ErrBlockNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Could not find block: %v", err)
+		}
+		if errors.Is(err, lightclient.ErrPreAltairBlock) {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not get light client bootstrap: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not get light client bootstrap: %v", err)
+	}
+	return &ethpbv2.LightClientBootstrapResponse{
+		Version: lightClientVersion(bootstrap.Header.Slot),
+		Data:    bootstrap,
+	}, nil
+}
+
+// ListLightClientUpdates retrieves the best light client update of each sync committee period
+// in the requested range, for periods which are known to the node.
+func (bs *Server) ListLightClientUpdates(ctx context.Context, req *ethpbv2.LightClientUpdatesByRangeRequest) (*ethpbv2.LightClientUpdatesByRangeResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon.ListLightClientUpdates")
+	defer span.End()
+
+	if req.Count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Count must be greater than 0")
+	}
+	count := req.Count
+	if count > maxRequestLightClientUpdates {
+		count = maxRequestLightClientUpdates
+	}
+	updates, err := bs.LightClientFetcher.BestUpdates(ctx, req.StartPeriod, count)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get light client updates: %v", err)
+	}
+	resp := &ethpbv2.LightClientUpdatesByRangeResponse{
+		Data: make([]*ethpbv2.LightClientUpdateWithVersion, len(updates)),
+	}
+	for i, update := range updates {
+		resp.Data[i] = &ethpbv2.LightClientUpdateWithVersion{
+			Version: lightClientVersion(update.AttestedHeader.Slot),
+			Data:    update,
+		}
+	}
+	return resp, nil
+}
+
+// GetLightClientFinalityUpdate retrieves the latest light client finality update known to the node.
+func (bs *Server) GetLightClientFinalityUpdate(_ context.Context, _ *empty.Empty) (*ethpbv2.LightClientFinalityUpdateResponse, error) {
+	update := bs.LightClientFetcher.FinalityUpdate()
+	if update == nil {
+		return nil, status.Error(codes.NotFound, "No light client finality update available")
+	}
+	return &ethpbv2.LightClientFinalityUpdateResponse{
+		Version: lightClientVersion(update.AttestedHeader.Slot),
+		Data:    update,
+	}, nil
+}
+
+// GetLightClientOptimisticUpdate retrieves the latest light client optimistic update known to the node.
+func (bs *Server) GetLightClientOptimisticUpdate(_ context.Context, _ *empty.Empty) (*ethpbv2.LightClientOptimisticUpdateResponse, error) {
+	update := bs.LightClientFetcher.OptimisticUpdate()
+	if update == nil {
+		return nil, status.Error(codes.NotFound, "No light client optimistic update available")
+	}
+	return &ethpbv2.LightClientOptimisticUpdateResponse{
+		Version: lightClientVersion(update.AttestedHeader.Slot),
+		Data:    update,
+	}, nil
+}
+
+// lightClientVersion returns the fork version of light client data whose attested header is at the given slot.
+func lightClientVersion(slot types.Slot) ethpbv2.Version {
+	if slots.ToEpoch(slot) >= params.BeaconConfig().BellatrixForkEpoch {
+		return ethpbv2.Version_MERGE
+	}
+	return ethpbv2.Version_ALTAIR
+}
@@ -0,0 +1,105 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/lightclient"
+	mocklightclient "github.com/prysmaticlabs/prysm/beacon-chain/lightclient/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestGetLightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	root := [32]byte{'a'}
+	bootstrap := &ethpbv2.LightClientBootstrap{Header: &ethpbv1.BeaconBlockHeader{Slot: 100}}
+	bs := &Server{
+		LightClientFetcher: &mocklightclient.Fetcher{
+			Bootstraps: map[[32]byte]*ethpbv2.LightClientBootstrap{root: bootstrap},
+		},
+	}
+
+	resp, err := bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: root[:]})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Version)
+	assert.DeepEqual(t, bootstrap, resp.Data)
+
+	_, err = bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: []byte{'a'}})
+	assert.ErrorContains(t, "Block root must be 32 bytes long", err)
+
+	missing := [32]byte{'b'}
+	_, err = bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: missing[:]})
+	assert.ErrorContains(t, "Could not find block", err)
+
+	bs.LightClientFetcher = &mocklightclient.Fetcher{BootstrapErr: errors.Wrap(lightclient.ErrPreAltairBlock, "bootstrap")}
+	_, err = bs.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: root[:]})
+	assert.ErrorContains(t, "older than the altair fork", err)
+}
+
+func TestListLightClientUpdates(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.BellatrixForkEpoch = 1000
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	updates := make(map[uint64]*ethpbv2.LightClientUpdate)
+	for period := uint64(0); period < 200; period++ {
+		slot := types.Slot(period) * 8192
+		updates[period] = &ethpbv2.LightClientUpdate{AttestedHeader: &ethpbv1.BeaconBlockHeader{Slot: slot}}
+	}
+	delete(updates, 2)
+	bs := &Server{LightClientFetcher: &mocklightclient.Fetcher{Updates: updates}}
+
+	resp, err := bs.ListLightClientUpdates(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 1, Count: 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, types.Slot(8192), resp.Data[0].Data.AttestedHeader.Slot)
+	assert.Equal(t, ethpbv2.Version_ALTAIR, resp.Data[0].Version)
+	assert.Equal(t, types.Slot(3*8192), resp.Data[1].Data.AttestedHeader.Slot)
+
+	resp, err = bs.ListLightClientUpdates(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 0, Count: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, maxRequestLightClientUpdates-1, len(resp.Data))
+	assert.Equal(t, ethpbv2.Version_MERGE, resp.Data[len(resp.Data)-1].Version)
+
+	_, err = bs.ListLightClientUpdates(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 0})
+	assert.ErrorContains(t, "Count must be greater than 0", err)
+}
+
+func TestGetLightClientFinalityUpdate(t *testing.T) {
+	ctx := context.Background()
+	fetcher := &mocklightclient.Fetcher{}
+	bs := &Server{LightClientFetcher: fetcher}
+
+	_, err := bs.GetLightClientFinalityUpdate(ctx, &emptypb.Empty{})
+	assert.ErrorContains(t, "No light client finality update available", err)
+
+	fetcher.LatestFinality = &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  &ethpbv1.BeaconBlockHeader{Slot: 100},
+		FinalizedHeader: &ethpbv1.BeaconBlockHeader{Slot: 64},
+	}
+	resp, err := bs.GetLightClientFinalityUpdate(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fetcher.LatestFinality, resp.Data)
+}
+
+func TestGetLightClientOptimisticUpdate(t *testing.T) {
+	ctx := context.Background()
+	fetcher := &mocklightclient.Fetcher{}
+	bs := &Server{LightClientFetcher: fetcher}
+
+	_, err := bs.GetLightClientOptimisticUpdate(ctx, &emptypb.Empty{})
+	assert.ErrorContains(t, "No light client optimistic update available", err)
+
+	fetcher.LatestOptimistic = &ethpbv2.LightClientOptimisticUpdate{AttestedHeader: &ethpbv1.BeaconBlockHeader{Slot: 100}}
+	resp, err := bs.GetLightClientOptimisticUpdate(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fetcher.LatestOptimistic, resp.Data)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/helpers"
 	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	statev2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/migration"
@@ -139,3 +141,50 @@ func (ds *Server) ListForkChoiceHeads(ctx context.Context, _ *emptypb.Empty) (*e
 
 	return resp, nil
 }
+
+// GetForkChoice dumps the nodes of the fork choice store. When a block root is requested, only the
+// subtree rooted at that block is dumped.
+func (ds *Server) GetForkChoice(ctx context.Context, req *ethpbv1.ForkChoiceRequest) (*ethpbv1.ForkChoiceResponse, error) {
+	_, span := trace.StartSpan(ctx, "debug.GetForkChoice")
+	defer span.End()
+
+	if len(req.Root) != 0 && len(req.Root) != fieldparams.RootLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid root length %d", len(req.Root))
+	}
+	fc := ds.HeadFetcher.ForkChoicer()
+	subtreeRoot := bytesutil.ToBytes32(req.Root)
+	if len(req.Root) != 0 && !fc.HasNode(subtreeRoot) {
+		return nil, status.Errorf(codes.NotFound, "Could not find block %#x in fork choice store", req.Root)
+	}
+
+	// Parents are listed before their children, so a single pass collects the whole subtree.
+	nodes := fc.ForkChoiceNodes()
+	inSubtree := make(map[[32]byte]bool, len(nodes))
+	justified := ds.FinalizationFetcher.CurrentJustifiedCheckpt()
+	finalized := ds.FinalizationFetcher.FinalizedCheckpt()
+	resp := &ethpbv1.ForkChoiceResponse{
+		JustifiedCheckpoint: &ethpbv1.Checkpoint{Epoch: justified.Epoch, Root: justified.Root},
+		FinalizedCheckpoint: &ethpbv1.Checkpoint{Epoch: finalized.Epoch, Root: finalized.Root},
+		ForkChoiceNodes:     make([]*ethpbv1.ForkChoiceNode, 0, len(nodes)),
+	}
+	for _, n := range nodes {
+		if len(req.Root) != 0 {
+			if n.Root != subtreeRoot && !inSubtree[n.ParentRoot] {
+				continue
+			}
+			inSubtree[n.Root] = true
+		}
+		root, parentRoot, bestDescendant := n.Root, n.ParentRoot, n.BestDescendant
+		resp.ForkChoiceNodes = append(resp.ForkChoiceNodes, &ethpbv1.ForkChoiceNode{
+			Slot:                n.Slot,
+			BlockRoot:           root[:],
+			ParentRoot:          parentRoot[:],
+			JustifiedEpoch:      n.JustifiedEpoch,
+			FinalizedEpoch:      n.FinalizedEpoch,
+			Weight:              n.Weight,
+			ExecutionOptimistic: n.Optimistic,
+			BestDescendant:      bestDescendant[:],
+		})
+	}
+	return resp, nil
+}
@@ -6,10 +6,12 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	blockchainmock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
@@ -154,3 +156,58 @@ func TestListForkChoiceHeads(t *testing.T) {
 		assert.Equal(t, true, found, "Expected head not found")
 	}
 }
+
+func TestGetForkChoice(t *testing.T) {
+	ctx := context.Background()
+	fc := protoarray.New(0, 0, [32]byte{'a'})
+	require.NoError(t, fc.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, fc.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, fc.ProcessBlock(ctx, 2, [32]byte{'c'}, [32]byte{'b'}, [32]byte{}, 0, 0))
+	require.NoError(t, fc.ProcessBlock(ctx, 1, [32]byte{'d'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	require.NoError(t, fc.SetOptimisticToValid(ctx, [32]byte{'b'}))
+	fc.ProcessAttestation(ctx, []uint64{0}, [32]byte{'c'}, 0)
+	_, err := fc.Head(ctx, 0, [32]byte{'a'}, []uint64{10}, 0)
+	require.NoError(t, err)
+
+	chainService := &blockchainmock.ChainService{
+		ForkChoiceStore:            fc,
+		CurrentJustifiedCheckPoint: &ethpbalpha.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte{'a'}, 32)},
+		FinalizedCheckPoint:        &ethpbalpha.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte{'a'}, 32)},
+	}
+	server := &Server{
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+	}
+
+	t.Run("whole store", func(t *testing.T) {
+		resp, err := server.GetForkChoice(ctx, &ethpbv1.ForkChoiceRequest{})
+		require.NoError(t, err)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), resp.JustifiedCheckpoint.Root)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), resp.FinalizedCheckpoint.Root)
+		require.Equal(t, 4, len(resp.ForkChoiceNodes))
+		root := resp.ForkChoiceNodes[0]
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), root.BlockRoot)
+		assert.Equal(t, uint64(10), root.Weight)
+		assert.Equal(t, false, root.ExecutionOptimistic)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'c'}, 32), root.BestDescendant)
+		leaf := resp.ForkChoiceNodes[2]
+		assert.Equal(t, types.Slot(2), leaf.Slot)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'b'}, 32), leaf.ParentRoot)
+		assert.Equal(t, true, leaf.ExecutionOptimistic)
+	})
+	t.Run("subtree", func(t *testing.T) {
+		resp, err := server.GetForkChoice(ctx, &ethpbv1.ForkChoiceRequest{Root: bytesutil.PadTo([]byte{'b'}, 32)})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.ForkChoiceNodes))
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'b'}, 32), resp.ForkChoiceNodes[0].BlockRoot)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{'c'}, 32), resp.ForkChoiceNodes[1].BlockRoot)
+	})
+	t.Run("unknown root", func(t *testing.T) {
+		_, err := server.GetForkChoice(ctx, &ethpbv1.ForkChoiceRequest{Root: bytesutil.PadTo([]byte{'z'}, 32)})
+		assert.ErrorContains(t, "Could not find block", err)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		_, err := server.GetForkChoice(ctx, &ethpbv1.ForkChoiceRequest{Root: []byte{'b'}})
+		assert.ErrorContains(t, "Invalid root length", err)
+	})
+}
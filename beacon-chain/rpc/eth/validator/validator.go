@@ -609,6 +609,57 @@ func (vs *Server) SubmitContributionAndProofs(ctx context.Context, req *ethpbv2.
 	return &empty.Empty{}, nil
 }
 
+// PrepareBeaconProposer registers the fee recipients of proposers, which are used in the execution
+// payloads of the blocks they propose.
+func (vs *Server) PrepareBeaconProposer(ctx context.Context, req *ethpbv1.PrepareBeaconProposerRequest) (*emptypb.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.PrepareBeaconProposer")
+	defer span.End()
+
+	v1alpha1Req := &ethpbalpha.PrepareBeaconProposerRequest{
+		Recipients: make([]*ethpbalpha.PrepareBeaconProposerRequest_FeeRecipientContainer, len(req.Recipients)),
+	}
+	for i, r := range req.Recipients {
+		v1alpha1Req.Recipients[i] = &ethpbalpha.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			FeeRecipient:   r.FeeRecipient,
+			ValidatorIndex: r.ValidatorIndex,
+		}
+	}
+	// We simply return err because it's already of a gRPC error type.
+	return vs.V1Alpha1Server.PrepareBeaconProposer(ctx, v1alpha1Req)
+}
+
+// GetLiveness indicates whether the requested validators were seen active in the requested epoch,
+// from the participation flags of the head state and from the attestations and blocks known to the
+// beacon node.
+func (vs *Server) GetLiveness(ctx context.Context, req *ethpbv1.GetLivenessRequest) (*ethpbv1.GetLivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetLiveness")
+	defer span.End()
+
+	if err := rpchelpers.ValidateSync(ctx, vs.SyncChecker, vs.HeadFetcher, vs.TimeFetcher); err != nil {
+		// We simply return the error because it's already a gRPC error.
+		return nil, err
+	}
+
+	v1alpha1Resp, err := vs.V1Alpha1Server.GetLiveness(ctx, &ethpbalpha.LivenessRequest{
+		Epoch:   req.Epoch,
+		Indices: req.Index,
+	})
+	if err != nil {
+		// We simply return err because it's already of a gRPC error type.
+		return nil, err
+	}
+	resp := &ethpbv1.GetLivenessResponse{
+		Data: make([]*ethpbv1.GetLivenessResponse_Liveness, len(v1alpha1Resp.Statuses)),
+	}
+	for i, s := range v1alpha1Resp.Statuses {
+		resp.Data[i] = &ethpbv1.GetLivenessResponse_Liveness{
+			Index:  s.Index,
+			IsLive: s.IsLive,
+		}
+	}
+	return resp, nil
+}
+
 // attestationDependentRoot is get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch - 1) - 1)
 // or the genesis block root in the case of underflow.
 func attestationDependentRoot(s state.BeaconState, epoch types.Epoch) ([]byte, error) {
@@ -1834,3 +1834,85 @@ func TestSubmitContributionAndProofs(t *testing.T) {
 		require.DeepEqual(t, expectedContributions, savedMsgs)
 	})
 }
+
+func TestPrepareBeaconProposer(t *testing.T) {
+	ctx := context.Background()
+	feeRecipientCache := cache.NewFeeRecipientCache()
+	server := &Server{
+		V1Alpha1Server: &v1alpha1validator.Server{FeeRecipientCache: feeRecipientCache},
+	}
+	feeRecipient := bytesutil.PadTo([]byte{'a'}, 20)
+
+	_, err := server.PrepareBeaconProposer(ctx, &ethpbv1.PrepareBeaconProposerRequest{
+		Recipients: []*ethpbv1.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: feeRecipient, ValidatorIndex: 1},
+		},
+	})
+	require.NoError(t, err)
+	got, ok := feeRecipientCache.FeeRecipient(1)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, feeRecipient, got.Bytes())
+
+	_, err = server.PrepareBeaconProposer(ctx, &ethpbv1.PrepareBeaconProposerRequest{
+		Recipients: []*ethpbv1.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: []byte{'a'}, ValidatorIndex: 2},
+		},
+	})
+	require.ErrorContains(t, "Invalid fee recipient of validator 2", err)
+}
+
+func TestGetLiveness(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateAltair(t, 4)
+	slot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetPreviousParticipationBits([]byte{1, 0, 0, 0}))
+	require.NoError(t, st.SetCurrentParticipationBits([]byte{0, 0, 7, 0}))
+	chainService := &mockChain.ChainService{State: st, Slot: &slot}
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		HeadFetcher: chainService,
+		TimeFetcher: chainService,
+		V1Alpha1Server: &v1alpha1validator.Server{
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+			HeadFetcher: chainService,
+			TimeFetcher: chainService,
+			BeaconDB:    dbutil.SetupDB(t),
+			AttPool:     attestations.NewPool(),
+		},
+	}
+
+	t.Run("previous epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 0, Index: []types.ValidatorIndex{0, 2}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, types.ValidatorIndex(0), resp.Data[0].Index)
+		assert.Equal(t, true, resp.Data[0].IsLive)
+		assert.Equal(t, types.ValidatorIndex(2), resp.Data[1].Index)
+		assert.Equal(t, false, resp.Data[1].IsLive)
+	})
+	t.Run("current epoch", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 1, Index: []types.ValidatorIndex{0, 2}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, false, resp.Data[0].IsLive)
+		assert.Equal(t, true, resp.Data[1].IsLive)
+	})
+	t.Run("future epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 2, Index: []types.ValidatorIndex{0}})
+		require.ErrorContains(t, "Requested epoch 2 is in the future", err)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 1, Index: []types.ValidatorIndex{4}})
+		require.ErrorContains(t, "Invalid validator index 4", err)
+	})
+	t.Run("syncing", func(t *testing.T) {
+		vs := &Server{
+			SyncChecker: &mockSync.Sync{IsSyncing: true},
+			HeadFetcher: chainService,
+			TimeFetcher: chainService,
+		}
+		_, err := vs.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: 1})
+		require.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+	})
+}
@@ -8,37 +8,50 @@ import (
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 )
 
-// GetProtoArrayForkChoice returns proto array fork choice store.
+// nonExistentNode is the index of a parent, best child or best descendant which is not in the store.
+const nonExistentNode = ^uint64(0)
+
+// GetProtoArrayForkChoice returns the nodes of the fork choice store in the proto array layout, where
+// parents, best children and best descendants are referred to by their index in the list of nodes.
 func (ds *Server) GetProtoArrayForkChoice(_ context.Context, _ *empty.Empty) (*pbrpc.ProtoArrayForkChoiceResponse, error) {
-	store := ds.HeadFetcher.ProtoArrayStore()
+	store := ds.HeadFetcher.ForkChoicer()
 
-	nodes := store.Nodes()
-	returnedNodes := make([]*pbrpc.ProtoArrayNode, len(nodes))
+	nodes := store.ForkChoiceNodes()
+	indices := make(map[[32]byte]uint64, len(nodes))
+	for i, n := range nodes {
+		indices[n.Root] = uint64(i)
+	}
+	indexOf := func(root [32]byte) uint64 {
+		if i, ok := indices[root]; ok {
+			return i
+		}
+		return nonExistentNode
+	}
 
-	for i := 0; i < len(returnedNodes); i++ {
-		r := nodes[i].Root()
+	returnedNodes := make([]*pbrpc.ProtoArrayNode, len(nodes))
+	for i, n := range nodes {
+		r := n.Root
 		returnedNodes[i] = &pbrpc.ProtoArrayNode{
-			Slot:           nodes[i].Slot(),
+			Slot:           n.Slot,
 			Root:           r[:],
-			Parent:         nodes[i].Parent(),
-			JustifiedEpoch: nodes[i].JustifiedEpoch(),
-			FinalizedEpoch: nodes[i].FinalizedEpoch(),
-			Weight:         nodes[i].Weight(),
-			BestChild:      nodes[i].BestChild(),
-			BestDescendant: nodes[i].BestDescendant(),
+			Parent:         indexOf(n.ParentRoot),
+			JustifiedEpoch: n.JustifiedEpoch,
+			FinalizedEpoch: n.FinalizedEpoch,
+			Weight:         n.Weight,
+			BestChild:      indexOf(n.BestChild),
+			BestDescendant: indexOf(n.BestDescendant),
 		}
 	}
 
-	indices := make(map[string]uint64, len(store.NodesIndices()))
-	for k, v := range store.NodesIndices() {
-		indices[hex.EncodeToString(k[:])] = v
+	hexIndices := make(map[string]uint64, len(indices))
+	for k, v := range indices {
+		hexIndices[hex.EncodeToString(k[:])] = v
 	}
 
 	return &pbrpc.ProtoArrayForkChoiceResponse{
-		PruneThreshold:  store.PruneThreshold(),
 		JustifiedEpoch:  store.JustifiedEpoch(),
 		FinalizedEpoch:  store.FinalizedEpoch(),
 		ProtoArrayNodes: returnedNodes,
-		Indices:         indices,
+		Indices:         hexIndices,
 	}, nil
 }
@@ -2,6 +2,7 @@ package debug
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -12,11 +13,20 @@ import (
 )
 
 func TestServer_GetForkChoice(t *testing.T) {
-	store := &protoarray.Store{}
+	ctx := context.Background()
+	store := protoarray.New(1, 1, [32]byte{'a'})
+	require.NoError(t, store.ProcessBlock(ctx, 32, [32]byte{'a'}, [32]byte{}, [32]byte{}, 1, 1))
+	require.NoError(t, store.ProcessBlock(ctx, 33, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 1, 1))
 	bs := &Server{HeadFetcher: &mock.ChainService{ForkChoiceStore: store}}
-	res, err := bs.GetProtoArrayForkChoice(context.Background(), &empty.Empty{})
+	res, err := bs.GetProtoArrayForkChoice(ctx, &empty.Empty{})
 	require.NoError(t, err)
-	assert.Equal(t, store.PruneThreshold(), res.PruneThreshold, "Did not get wanted prune threshold")
 	assert.Equal(t, store.JustifiedEpoch(), res.JustifiedEpoch, "Did not get wanted justified epoch")
 	assert.Equal(t, store.FinalizedEpoch(), res.FinalizedEpoch, "Did not get wanted finalized epoch")
+	require.Equal(t, 2, len(res.ProtoArrayNodes))
+	assert.Equal(t, nonExistentNode, res.ProtoArrayNodes[0].Parent)
+	assert.Equal(t, uint64(1), res.ProtoArrayNodes[0].BestChild)
+	assert.Equal(t, uint64(0), res.ProtoArrayNodes[1].Parent)
+	assert.Equal(t, nonExistentNode, res.ProtoArrayNodes[1].BestDescendant)
+	b := [32]byte{'b'}
+	assert.Equal(t, uint64(1), res.Indices[hex.EncodeToString(b[:])])
 }
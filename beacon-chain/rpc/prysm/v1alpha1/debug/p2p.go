@@ -6,6 +6,7 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"google.golang.org/grpc/codes"
@@ -35,6 +36,79 @@ func (ds *Server) ListPeers(_ context.Context, _ *empty.Empty) (*ethpb.DebugPeer
 	return &ethpb.DebugPeerResponses{Responses: responses}, nil
 }
 
+// AddPeer connects the host node to the peer of the provided multiaddress, and adds it to the
+// trusted peers if requested.
+func (ds *Server) AddPeer(ctx context.Context, req *ethpb.AddPeerRequest) (*empty.Empty, error) {
+	addr, err := multiaddr.NewMultiaddr(req.MultiAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided multiaddress: %v", err)
+	}
+	info, err := peer.AddrInfoFromP2pAddr(addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get peer info from multiaddress: %v", err)
+	}
+	peers := ds.PeersFetcher.Peers()
+	if req.Trusted {
+		peers.SetTrustedPeers([]peer.ID{info.ID})
+	} else if peers.IsBannedPeer(info.ID) {
+		return nil, status.Error(codes.FailedPrecondition, "Requested peer is banned")
+	}
+	if len(info.Addrs) > 0 {
+		peers.Add(nil /* ENR */, info.ID, info.Addrs[0], network.DirOutbound)
+	}
+	if err := ds.PeerManager.Host().Connect(ctx, *info); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not connect to peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// RemovePeer disconnects the host node from the peer defined by the provided peer id, and removes it
+// from the trusted peers.
+func (ds *Server) RemovePeer(_ context.Context, peerReq *ethpb.PeerRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(peerReq.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	ds.PeersFetcher.Peers().DeleteTrustedPeers([]peer.ID{pid})
+	if err := ds.PeerManager.Disconnect(pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetPeerTrusted adds the peer defined by the provided peer id to the trusted peers, which are
+// exempt from scoring and pruning, or removes it from them.
+func (ds *Server) SetPeerTrusted(_ context.Context, req *ethpb.SetPeerTrustedRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if req.Trusted {
+		ds.PeersFetcher.Peers().SetTrustedPeers([]peer.ID{pid})
+	} else {
+		ds.PeersFetcher.Peers().DeleteTrustedPeers([]peer.ID{pid})
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetPeerBanned bans the peer defined by the provided peer id, disconnecting the host node from it,
+// or lifts its ban.
+func (ds *Server) SetPeerBanned(_ context.Context, req *ethpb.SetPeerBannedRequest) (*empty.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if !req.Banned {
+		ds.PeersFetcher.Peers().DeleteBannedPeers([]peer.ID{pid})
+		return &empty.Empty{}, nil
+	}
+	ds.PeersFetcher.Peers().SetBannedPeers([]peer.ID{pid})
+	if err := ds.PeerManager.Disconnect(pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
 func (ds *Server) getPeer(pid peer.ID) (*ethpb.DebugPeerResponse, error) {
 	peers := ds.PeersFetcher.Peers()
 	peerStore := ds.PeerManager.Host().Peerstore()
@@ -155,6 +229,8 @@ func (ds *Server) getPeer(pid peer.ID) (*ethpb.DebugPeerResponse, error) {
 		PeerStatus:         pStatus,
 		LastUpdated:        unixTime,
 		ScoreInfo:          scoreInfo,
+		Trusted:            peers.IsTrustedPeer(pid),
+		Banned:             peers.IsBannedPeer(pid),
 	}, nil
 }
 
@@ -2,9 +2,11 @@ package debug
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/libp2p/go-libp2p-core/peer"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -57,3 +59,69 @@ func TestDebugServer_ListPeers(t *testing.T) {
 		t.Errorf("Expected 2nd peer to have a multiaddress, instead they have no addresses")
 	}
 }
+
+func TestDebugServer_AddPeer(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	remote := mockP2p.NewTestP2P(t)
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+	}
+	addr := fmt.Sprintf("%s/p2p/%s", remote.BHost.Addrs()[0], remote.BHost.ID())
+
+	_, err := ds.AddPeer(context.Background(), &ethpb.AddPeerRequest{MultiAddr: "/ip4/127.0.0.1"})
+	require.ErrorContains(t, "Unable to get peer info from multiaddress", err)
+
+	peersProvider.Peers().SetBannedPeers([]peer.ID{remote.BHost.ID()})
+	_, err = ds.AddPeer(context.Background(), &ethpb.AddPeerRequest{MultiAddr: addr})
+	require.ErrorContains(t, "Requested peer is banned", err)
+
+	// Trusting the peer lifts its ban.
+	_, err = ds.AddPeer(context.Background(), &ethpb.AddPeerRequest{MultiAddr: addr, Trusted: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(mP2P.BHost.Network().ConnsToPeer(remote.BHost.ID())))
+	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: remote.BHost.ID().String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Trusted)
+	assert.Equal(t, false, res.Banned)
+	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Direction)
+}
+
+func TestDebugServer_TrustAndBanPeer(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	mP2P := mockP2p.NewTestP2P(t)
+	ds := &Server{
+		PeersFetcher: peersProvider,
+		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
+	}
+	pid := peersProvider.Peers().All()[0]
+	ctx := context.Background()
+
+	_, err := ds.SetPeerTrusted(ctx, &ethpb.SetPeerTrustedRequest{PeerId: "foo", Trusted: true})
+	require.ErrorContains(t, "Unable to parse provided peer id", err)
+
+	_, err = ds.SetPeerTrusted(ctx, &ethpb.SetPeerTrustedRequest{PeerId: pid.String(), Trusted: true})
+	require.NoError(t, err)
+	res, err := ds.GetPeer(ctx, &ethpb.PeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Trusted)
+
+	_, err = ds.SetPeerBanned(ctx, &ethpb.SetPeerBannedRequest{PeerId: pid.String(), Banned: true})
+	require.NoError(t, err)
+	res, err = ds.GetPeer(ctx, &ethpb.PeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Trusted)
+	assert.Equal(t, true, res.Banned)
+	assert.Equal(t, true, peersProvider.Peers().IsBad(pid))
+
+	_, err = ds.SetPeerBanned(ctx, &ethpb.SetPeerBannedRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, false, peersProvider.Peers().IsBannedPeer(pid))
+
+	_, err = ds.SetPeerTrusted(ctx, &ethpb.SetPeerTrustedRequest{PeerId: pid.String(), Trusted: true})
+	require.NoError(t, err)
+	_, err = ds.RemovePeer(ctx, &ethpb.PeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, false, peersProvider.Peers().IsTrustedPeer(pid))
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/io/logs"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/runtime/version"
@@ -31,25 +33,34 @@ import (
 // providing RPC endpoints for verifying a beacon node's sync status, genesis and
 // version information, and services the node implements and runs.
 type Server struct {
-	LogsStreamer         logs.Streamer
-	StreamLogsBufferSize int
-	SyncChecker          sync.Checker
-	Server               *grpc.Server
-	BeaconDB             db.ReadOnlyDatabase
-	PeersFetcher         p2p.PeersProvider
-	PeerManager          p2p.PeerManager
-	GenesisTimeFetcher   blockchain.TimeFetcher
-	GenesisFetcher       blockchain.GenesisFetcher
-	POWChainInfoFetcher  powchain.ChainInfoFetcher
-	BeaconMonitoringHost string
-	BeaconMonitoringPort int
+	LogsStreamer          logs.Streamer
+	StreamLogsBufferSize  int
+	SyncChecker           sync.Checker
+	BackfillChecker       backfill.Checker
+	Server                *grpc.Server
+	BeaconDB              db.ReadOnlyDatabase
+	PeersFetcher          p2p.PeersProvider
+	PeerManager           p2p.PeerManager
+	BandwidthProvider     p2p.BandwidthProvider
+	GenesisTimeFetcher    blockchain.TimeFetcher
+	GenesisFetcher        blockchain.GenesisFetcher
+	POWChainInfoFetcher   powchain.ChainInfoFetcher
+	ExecutionEngineCaller powchain.EngineCaller
+	BeaconMonitoringHost  string
+	BeaconMonitoringPort  int
 }
 
-// GetSyncStatus checks the current network sync status of the node.
+// GetSyncStatus checks the current network sync status of the node, including the progress
+// of backfilling blocks below the origin block of a node started from a checkpoint.
 func (ns *Server) GetSyncStatus(_ context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
-	return &ethpb.SyncStatus{
+	res := &ethpb.SyncStatus{
 		Syncing: ns.SyncChecker.Syncing(),
-	}, nil
+	}
+	if ns.BackfillChecker != nil && ns.BackfillChecker.Backfilling() {
+		res.Backfilling = true
+		res.BackfillSlot = ns.BackfillChecker.BackfillSlot()
+	}
+	return res, nil
 }
 
 // GetGenesis fetches genesis chain information of Ethereum. Returns unix timestamp 0
@@ -76,11 +87,21 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *empty.Empty) (*ethpb.Genesi
 	}, nil
 }
 
-// GetVersion checks the version information of the beacon node.
-func (_ *Server) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
-	return &ethpb.Version{
+// GetVersion checks the version information of the beacon node, and of the execution client
+// connected to it when it can be reached.
+func (ns *Server) GetVersion(ctx context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	res := &ethpb.Version{
 		Version: version.Version(),
-	}, nil
+	}
+	if ns.ExecutionEngineCaller != nil {
+		executionClient, err := ns.ExecutionEngineCaller.ClientVersion(ctx)
+		if err != nil {
+			log.WithError(err).Debug("Could not get the version of the execution client")
+		} else {
+			res.ExecutionClient = executionClient
+		}
+	}
+	return res, nil
 }
 
 // ListImplementedServices lists the services implemented and enabled by this node.
@@ -159,13 +180,15 @@ func (ns *Server) GetPeer(_ context.Context, peerReq *ethpb.PeerRequest) (*ethpb
 			return nil, status.Errorf(codes.Internal, "Unable to serialize enr: %v", err)
 		}
 	}
-	return &ethpb.Peer{
+	res := &ethpb.Peer{
 		Address:         addr.String(),
 		Direction:       pbDirection,
 		ConnectionState: ethpb.ConnectionState(connState),
 		PeerId:          peerReq.PeerId,
 		Enr:             enr,
-	}, nil
+	}
+	ns.setPeerBandwidth(res, pid)
+	return res, nil
 }
 
 // ListPeers lists the peers connected to this node.
@@ -207,13 +230,15 @@ func (ns *Server) ListPeers(ctx context.Context, _ *empty.Empty) (*ethpb.Peers,
 		case network.DirOutbound:
 			pbDirection = ethpb.PeerDirection_OUTBOUND
 		}
-		res = append(res, &ethpb.Peer{
+		p := &ethpb.Peer{
 			Address:         address,
 			Direction:       pbDirection,
 			ConnectionState: ethpb.ConnectionState_CONNECTED,
 			PeerId:          pid.String(),
 			Enr:             enr,
-		})
+		}
+		ns.setPeerBandwidth(p, pid)
+		res = append(res, p)
 	}
 
 	return &ethpb.Peers{
@@ -221,6 +246,44 @@ func (ns *Server) ListPeers(ctx context.Context, _ *empty.Empty) (*ethpb.Peers,
 	}, nil
 }
 
+// GetBandwidth returns the bandwidth used by the node with its peers, over all the streams, and
+// per protocol and gossip topic.
+func (ns *Server) GetBandwidth(_ context.Context, _ *empty.Empty) (*ethpb.Bandwidth, error) {
+	if ns.BandwidthProvider == nil {
+		return nil, status.Error(codes.Unavailable, "Bandwidth is not being accounted for")
+	}
+	totals := ns.BandwidthProvider.BandwidthTotals()
+	return &ethpb.Bandwidth{
+		BytesIn:   totals.In,
+		BytesOut:  totals.Out,
+		Protocols: bandwidthUsages(ns.BandwidthProvider.BandwidthByProtocol()),
+		Topics:    bandwidthUsages(ns.BandwidthProvider.BandwidthByTopic()),
+	}, nil
+}
+
+func (ns *Server) setPeerBandwidth(p *ethpb.Peer, pid peer.ID) {
+	if ns.BandwidthProvider == nil {
+		return
+	}
+	bw := ns.BandwidthProvider.BandwidthForPeer(pid)
+	p.BytesIn = bw.In
+	p.BytesOut = bw.Out
+	p.Protocols = bandwidthUsages(ns.BandwidthProvider.BandwidthForPeerByProtocol(pid))
+	p.Topics = bandwidthUsages(ns.BandwidthProvider.BandwidthForPeerByTopic(pid))
+}
+
+// bandwidthUsages converts the bandwidth used by each protocol or topic, sorted by name.
+func bandwidthUsages(usages map[string]p2ptypes.Bandwidth) []*ethpb.BandwidthUsage {
+	res := make([]*ethpb.BandwidthUsage, 0, len(usages))
+	for name, bw := range usages {
+		res = append(res, &ethpb.BandwidthUsage{Name: name, BytesIn: bw.In, BytesOut: bw.Out})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
 // GetETH1ConnectionStatus gets data about the ETH1 endpoints.
 func (ns *Server) GetETH1ConnectionStatus(ctx context.Context, _ *empty.Empty) (*ethpb.ETH1ConnectionStatus, error) {
 	var errStrs []string
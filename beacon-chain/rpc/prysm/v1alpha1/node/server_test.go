@@ -9,11 +9,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
+	mockBackfill "github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -39,6 +44,12 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	res, err = ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, true, res.Syncing)
+	assert.Equal(t, false, res.Backfilling)
+	ns.BackfillChecker = &mockBackfill.Backfill{IsBackfilling: true, Slot: 100}
+	res, err = ns.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Backfilling)
+	assert.Equal(t, types.Slot(100), res.BackfillSlot)
 }
 
 func TestNodeServer_GetGenesis(t *testing.T) {
@@ -77,6 +88,12 @@ func TestNodeServer_GetVersion(t *testing.T) {
 	res, err := ns.GetVersion(context.Background(), &emptypb.Empty{})
 	require.NoError(t, err)
 	assert.Equal(t, v, res.Version)
+	assert.Equal(t, "", res.ExecutionClient)
+
+	ns.ExecutionEngineCaller = &mockPOW.EngineClient{Version: "Geth/v1.10.17-stable/linux-amd64/go1.18"}
+	res, err = ns.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "Geth/v1.10.17-stable/linux-amd64/go1.18", res.ExecutionClient)
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
@@ -150,6 +167,67 @@ func TestNodeServer_ListPeers(t *testing.T) {
 	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Peers[1].Direction)
 }
 
+func TestNodeServer_PeerBandwidth(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	firstPeer := peersProvider.Peers().All()[0]
+	ns := &Server{
+		PeersFetcher: peersProvider,
+		BandwidthProvider: &mockP2p.MockBandwidthProvider{
+			Peers: map[peer.ID]p2ptypes.Bandwidth{firstPeer: {In: 100, Out: 200}},
+			PeerProtocols: map[peer.ID]map[string]p2ptypes.Bandwidth{firstPeer: {
+				"/meshsub/1.1.0": {In: 80, Out: 150},
+				"/eth2/beacon_chain/req/status/1/ssz_snappy": {In: 20, Out: 50},
+			}},
+			PeerTopics: map[peer.ID]map[string]p2ptypes.Bandwidth{firstPeer: {
+				"/eth2/4a26c58b/beacon_block/ssz_snappy": {In: 60, Out: 120},
+			}},
+		},
+	}
+
+	res, err := ns.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), res.BytesIn)
+	assert.Equal(t, uint64(200), res.BytesOut)
+	assert.DeepSSZEqual(t, []*ethpb.BandwidthUsage{
+		{Name: "/eth2/beacon_chain/req/status/1/ssz_snappy", BytesIn: 20, BytesOut: 50},
+		{Name: "/meshsub/1.1.0", BytesIn: 80, BytesOut: 150},
+	}, res.Protocols)
+	assert.DeepSSZEqual(t, []*ethpb.BandwidthUsage{
+		{Name: "/eth2/4a26c58b/beacon_block/ssz_snappy", BytesIn: 60, BytesOut: 120},
+	}, res.Topics)
+}
+
+func TestNodeServer_GetBandwidth(t *testing.T) {
+	ns := &Server{
+		BandwidthProvider: &mockP2p.MockBandwidthProvider{
+			Totals: p2ptypes.Bandwidth{In: 1000, Out: 2000},
+			Protocols: map[string]p2ptypes.Bandwidth{
+				"/meshsub/1.1.0": {In: 800, Out: 1000},
+				"/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy": {In: 200, Out: 1000},
+			},
+			Topics: map[string]p2ptypes.Bandwidth{
+				"/eth2/4a26c58b/beacon_block/ssz_snappy": {In: 500, Out: 600},
+			},
+		},
+	}
+
+	res, err := ns.GetBandwidth(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), res.BytesIn)
+	assert.Equal(t, uint64(2000), res.BytesOut)
+	assert.DeepSSZEqual(t, []*ethpb.BandwidthUsage{
+		{Name: "/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy", BytesIn: 200, BytesOut: 1000},
+		{Name: "/meshsub/1.1.0", BytesIn: 800, BytesOut: 1000},
+	}, res.Protocols)
+	assert.DeepSSZEqual(t, []*ethpb.BandwidthUsage{
+		{Name: "/eth2/4a26c58b/beacon_block/ssz_snappy", BytesIn: 500, BytesOut: 600},
+	}, res.Topics)
+
+	ns.BandwidthProvider = nil
+	_, err = ns.GetBandwidth(context.Background(), &emptypb.Empty{})
+	assert.ErrorContains(t, "Bandwidth is not being accounted for", err)
+}
+
 func TestNodeServer_GetETH1ConnectionStatus(t *testing.T) {
 	server := grpc.NewServer()
 	eps := []string{"foo", "bar"}
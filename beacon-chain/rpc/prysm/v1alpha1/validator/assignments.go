@@ -141,6 +141,13 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 			}
 		}
 	}
+	if vs.ProposerSlotIndexCache != nil {
+		currentEpochStart, err := slots.EpochStart(currentEpoch)
+		if err != nil {
+			return nil, err
+		}
+		vs.ProposerSlotIndexCache.PrunePayloadIDs(currentEpochStart)
+	}
 	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(ctx, s, req.Epoch)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
@@ -170,6 +177,7 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 			assignment.ValidatorIndex = idx
 			assignment.Status = s
 			assignment.ProposerSlots = proposerIndexToSlots[idx]
+			vs.trackProposerSlots(idx, assignment.ProposerSlots)
 
 			// The next epoch has no lookup for proposer indexes.
 			nextAssignment.ValidatorIndex = idx
@@ -332,3 +340,18 @@ func subnetsFromCommittee(pubkey []byte, comm *ethpb.SyncCommittee) []uint64 {
 	}
 	return positions
 }
+
+// trackProposerSlots tracks the proposal slots of the validators requesting their duties,
+// which are the validators connected to this node, so that their payloads can be prepared
+// ahead of the proposals. The payload IDs already prepared for these slots are kept.
+func (vs *Server) trackProposerSlots(idx types.ValidatorIndex, proposerSlots []types.Slot) {
+	if vs.ProposerSlotIndexCache == nil {
+		return
+	}
+	for _, slot := range proposerSlots {
+		if proposerIdx, _, _, ok := vs.ProposerSlotIndexCache.GetProposerPayloadIDs(slot); ok && proposerIdx == idx {
+			continue
+		}
+		vs.ProposerSlotIndexCache.SetProposerAndPayloadIDs(slot, idx, [32]byte{}, [8]byte{})
+	}
+}
@@ -0,0 +1,180 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLiveness indicates whether the requested validators were seen active in the requested epoch.
+// A validator is live if the participation flags of the head state show it attested in the epoch,
+// if it proposed a block in the epoch, or if one of its attestations targeting the epoch is in a
+// block or in the attestation pool. Only the current and previous epochs are supported, as the
+// committees of the attestations are computed from the head state.
+func (vs *Server) GetLiveness(ctx context.Context, req *ethpb.LivenessRequest) (*ethpb.LivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetLiveness")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch %d is in the future, current epoch is %d",
+			req.Epoch,
+			currentEpoch,
+		)
+	}
+	if req.Epoch+1 < currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch %d is too old, only the current epoch %d and the previous one are supported",
+			req.Epoch,
+			currentEpoch,
+		)
+	}
+	st, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	for _, idx := range req.Indices {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d", idx)
+		}
+	}
+
+	live, err := liveFromParticipation(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get epoch participation: %v", err)
+	}
+	if err := vs.liveFromBlocks(ctx, st, req.Epoch, currentEpoch, live); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get liveness from blocks: %v", err)
+	}
+	unaggregated, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	pools := [][]*ethpb.Attestation{
+		vs.AttPool.AggregatedAttestations(),
+		unaggregated,
+		vs.AttPool.BlockAttestations(),
+	}
+	for _, atts := range pools {
+		if err := liveFromAttestations(ctx, st, req.Epoch, atts, live); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get liveness from attestation pool: %v", err)
+		}
+	}
+
+	resp := &ethpb.LivenessResponse{
+		Statuses: make([]*ethpb.LivenessResponse_Liveness, len(req.Indices)),
+	}
+	for i, idx := range req.Indices {
+		resp.Statuses[i] = &ethpb.LivenessResponse_Liveness{
+			Index:  idx,
+			IsLive: live[idx],
+		}
+	}
+	return resp, nil
+}
+
+// liveFromParticipation returns the validators with participation flags in the epoch. The flags
+// are only available in the state for the epoch of the state and the one before it, starting from
+// the Altair fork.
+func liveFromParticipation(st state.BeaconState, epoch types.Epoch) (map[types.ValidatorIndex]bool, error) {
+	live := make(map[types.ValidatorIndex]bool)
+	if st.Version() == version.Phase0 {
+		return live, nil
+	}
+	var participation []byte
+	var err error
+	stateEpoch := coreTime.CurrentEpoch(st)
+	switch {
+	case epoch == stateEpoch:
+		participation, err = st.CurrentEpochParticipation()
+	case epoch+1 == stateEpoch:
+		participation, err = st.PreviousEpochParticipation()
+	default:
+		return live, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for idx, flags := range participation {
+		if flags != 0 {
+			live[types.ValidatorIndex(idx)] = true
+		}
+	}
+	return live, nil
+}
+
+// liveFromBlocks marks the proposers of the blocks in the epoch, and the attesters of the
+// attestations targeting the epoch which were included in blocks. Attestations can be included
+// up to the end of the next epoch.
+func (vs *Server) liveFromBlocks(
+	ctx context.Context,
+	st state.ReadOnlyBeaconState,
+	epoch, currentEpoch types.Epoch,
+	live map[types.ValidatorIndex]bool,
+) error {
+	endEpoch := epoch + 1
+	if endEpoch > currentEpoch {
+		endEpoch = currentEpoch
+	}
+	blks, _, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(epoch).SetEndEpoch(endEpoch))
+	if err != nil {
+		return errors.Wrap(err, "could not get blocks")
+	}
+	for _, blk := range blks {
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		if slots.ToEpoch(blk.Block().Slot()) == epoch {
+			live[blk.Block().ProposerIndex()] = true
+		}
+		if err := liveFromAttestations(ctx, st, epoch, blk.Block().Body().Attestations(), live); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// liveFromAttestations marks the attesters of the attestations targeting the epoch.
+func liveFromAttestations(
+	ctx context.Context,
+	st state.ReadOnlyBeaconState,
+	epoch types.Epoch,
+	atts []*ethpb.Attestation,
+	live map[types.ValidatorIndex]bool,
+) error {
+	for _, att := range atts {
+		if att == nil || att.Data == nil || att.Data.Target == nil || att.Data.Target.Epoch != epoch {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return errors.Wrap(err, "could not get committee")
+		}
+		indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return errors.Wrap(err, "could not get attesting indices")
+		}
+		for _, idx := range indices {
+			live[types.ValidatorIndex(idx)] = true
+		}
+	}
+	return nil
+}
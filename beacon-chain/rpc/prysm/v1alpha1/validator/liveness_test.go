@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestGetLiveness(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+	st, _ := util.DeterministicGenesisStateAltair(t, 64)
+	slot := 2 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(slot))
+	previousParticipation := make([]byte, 64)
+	previousParticipation[0] = 1
+	currentParticipation := make([]byte, 64)
+	currentParticipation[1] = 7
+	require.NoError(t, st.SetPreviousParticipationBits(previousParticipation))
+	require.NoError(t, st.SetCurrentParticipationBits(currentParticipation))
+
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, true, len(committee) > 1)
+	newAtt := func(bit uint64) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		bits.SetBitAt(bit, true)
+		att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bits})
+		att.Data.Slot = 1
+		return att
+	}
+	// The attestation of the first member of the committee is included in a block proposed by
+	// validator 5, the one of the second member is only in the pool.
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 2
+	blk.Block.ProposerIndex = 5
+	blk.Block.Body.Attestations = []*ethpb.Attestation{newAtt(0)}
+	wsb, err := wrapper.WrappedSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveUnaggregatedAttestation(newAtt(1)))
+
+	chainService := &mockChain.ChainService{State: st, Slot: &slot}
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		HeadFetcher: chainService,
+		TimeFetcher: chainService,
+		BeaconDB:    db,
+		AttPool:     pool,
+	}
+
+	t.Run("participation flags", func(t *testing.T) {
+		resp, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 1, Indices: []types.ValidatorIndex{0, 1}})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Statuses))
+		assert.Equal(t, types.ValidatorIndex(0), resp.Statuses[0].Index)
+		assert.Equal(t, true, resp.Statuses[0].IsLive)
+		assert.Equal(t, types.ValidatorIndex(1), resp.Statuses[1].Index)
+		assert.Equal(t, false, resp.Statuses[1].IsLive)
+
+		resp, err = vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 2, Indices: []types.ValidatorIndex{0, 1}})
+		require.NoError(t, err)
+		assert.Equal(t, false, resp.Statuses[0].IsLive)
+		assert.Equal(t, true, resp.Statuses[1].IsLive)
+	})
+	t.Run("blocks and attestation pool", func(t *testing.T) {
+		// Epoch 0 is only supported up to epoch 1.
+		st := st.Copy()
+		slot := params.BeaconConfig().SlotsPerEpoch
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.SetPreviousParticipationBits(make([]byte, 64)))
+		chainService := &mockChain.ChainService{State: st, Slot: &slot}
+		vs := &Server{
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+			HeadFetcher: chainService,
+			TimeFetcher: chainService,
+			BeaconDB:    db,
+			AttPool:     pool,
+		}
+		var other types.ValidatorIndex
+		for other = 0; other < 64; other++ {
+			if other != 5 && other != committee[0] && other != committee[1] {
+				break
+			}
+		}
+		resp, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{
+			Epoch:   0,
+			Indices: []types.ValidatorIndex{5, committee[0], committee[1], other},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(resp.Statuses))
+		assert.Equal(t, true, resp.Statuses[0].IsLive)
+		assert.Equal(t, true, resp.Statuses[1].IsLive)
+		assert.Equal(t, true, resp.Statuses[2].IsLive)
+		assert.Equal(t, false, resp.Statuses[3].IsLive)
+	})
+	t.Run("future epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 3, Indices: []types.ValidatorIndex{0}})
+		require.ErrorContains(t, "Requested epoch 3 is in the future, current epoch is 2", err)
+	})
+	t.Run("out of range epoch", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 0, Indices: []types.ValidatorIndex{0}})
+		require.ErrorContains(t, "Requested epoch 0 is too old, only the current epoch 2 and the previous one are supported", err)
+	})
+	t.Run("invalid index", func(t *testing.T) {
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 2, Indices: []types.ValidatorIndex{64}})
+		require.ErrorContains(t, "Invalid validator index 64", err)
+	})
+	t.Run("syncing", func(t *testing.T) {
+		vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+		_, err := vs.GetLiveness(ctx, &ethpb.LivenessRequest{Epoch: 2})
+		require.ErrorContains(t, "Syncing to latest head, not ready to respond", err)
+	})
+}
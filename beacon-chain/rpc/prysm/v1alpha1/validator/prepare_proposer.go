@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PrepareBeaconProposer registers the fee recipients of proposers, which are used in the execution
+// payloads of the blocks they propose instead of the fee recipient configured in the node.
+// Registrations expire after a few epochs, so validator clients are expected to send them every epoch.
+func (vs *Server) PrepareBeaconProposer(ctx context.Context, req *ethpb.PrepareBeaconProposerRequest) (*emptypb.Empty, error) {
+	_, span := trace.StartSpan(ctx, "validator.PrepareBeaconProposer")
+	defer span.End()
+
+	if vs.FeeRecipientCache == nil {
+		return nil, status.Error(codes.Unimplemented, "Fee recipients per validator are not supported")
+	}
+	for _, r := range req.Recipients {
+		if len(r.FeeRecipient) != fieldparams.FeeRecipientLength {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Invalid fee recipient of validator %d: has length %d, expected %d",
+				r.ValidatorIndex,
+				len(r.FeeRecipient),
+				fieldparams.FeeRecipientLength,
+			)
+		}
+	}
+	for _, r := range req.Recipients {
+		feeRecipient := common.BytesToAddress(r.FeeRecipient)
+		vs.FeeRecipientCache.SetFeeRecipient(r.ValidatorIndex, feeRecipient)
+		log.WithFields(logrus.Fields{
+			"validatorIndex": r.ValidatorIndex,
+			"feeRecipient":   fmt.Sprintf("%#x", feeRecipient),
+		}).Debug("Registered fee recipient of proposer")
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestServer_PrepareBeaconProposer(t *testing.T) {
+	ctx := context.Background()
+	feeRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+
+	t.Run("fee recipient cache not configured", func(t *testing.T) {
+		vs := &Server{}
+		_, err := vs.PrepareBeaconProposer(ctx, &ethpb.PrepareBeaconProposerRequest{})
+		require.ErrorContains(t, "Fee recipients per validator are not supported", err)
+	})
+	t.Run("invalid fee recipient", func(t *testing.T) {
+		vs := &Server{FeeRecipientCache: cache.NewFeeRecipientCache()}
+		_, err := vs.PrepareBeaconProposer(ctx, &ethpb.PrepareBeaconProposerRequest{
+			Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+				{FeeRecipient: feeRecipient.Bytes(), ValidatorIndex: 1},
+				{FeeRecipient: []byte{1}, ValidatorIndex: 2},
+			},
+		})
+		require.ErrorContains(t, "Invalid fee recipient of validator 2", err)
+		// No fee recipient is registered when the request is invalid.
+		_, ok := vs.FeeRecipientCache.FeeRecipient(1)
+		require.Equal(t, false, ok)
+	})
+	t.Run("ok", func(t *testing.T) {
+		vs := &Server{FeeRecipientCache: cache.NewFeeRecipientCache()}
+		other := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+		_, err := vs.PrepareBeaconProposer(ctx, &ethpb.PrepareBeaconProposerRequest{
+			Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+				{FeeRecipient: feeRecipient.Bytes(), ValidatorIndex: 1},
+				{FeeRecipient: other.Bytes(), ValidatorIndex: 2},
+			},
+		})
+		require.NoError(t, err)
+		got, ok := vs.FeeRecipientCache.FeeRecipient(1)
+		require.Equal(t, true, ok)
+		require.Equal(t, feeRecipient, got)
+		got, ok = vs.FeeRecipientCache.FeeRecipient(2)
+		require.Equal(t, true, ok)
+		require.Equal(t, other, got)
+	})
+}
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// getExecutionPayload returns the execution payload of the block proposed by the given validator
+// on top of the given head at the given slot. The payload prepared ahead of the proposal is used
+// when available, else the execution engine is asked to build one with the fee recipient registered
+// by the validator, or the one configured in the node.
+// Until the terminal proof-of-work block is reached, an empty payload is returned.
+func (vs *Server) getExecutionPayload(
+	ctx context.Context,
+	slot types.Slot,
+	vIdx types.ValidatorIndex,
+	headRoot [32]byte,
+) (*ethpb.ExecutionPayload, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.getExecutionPayload")
+	defer span.End()
+
+	if vs.ExecutionEngineCaller == nil {
+		return nil, errors.New("no execution engine caller configured")
+	}
+	if vs.ProposerSlotIndexCache != nil {
+		proposerIdx, payloadHeadRoot, payloadID, ok := vs.ProposerSlotIndexCache.GetProposerPayloadIDs(slot)
+		if ok && proposerIdx == vIdx && payloadHeadRoot == headRoot && payloadID != [8]byte{} {
+			payload, err := vs.ExecutionEngineCaller.GetPayload(ctx, payloadID)
+			if err == nil {
+				return payload, nil
+			}
+			// The prepared payload may have been dropped by the execution engine, in which
+			// case a new payload is built below.
+			log.WithError(err).WithFields(logrus.Fields{
+				"slot":      slot,
+				"payloadID": fmt.Sprintf("%#x", payloadID),
+			}).Warn("Could not get prepared payload from execution engine")
+		}
+	}
+
+	st, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	var parentHash []byte
+	mergeComplete := false
+	// The head state may precede the Bellatrix fork when proposing the first blocks after it.
+	if st.Version() >= version.Bellatrix {
+		mergeComplete, err = blocks.MergeComplete(st)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if mergeComplete {
+		header, err := st.LatestExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		parentHash = header.BlockHash
+	} else {
+		terminalBlockHash, exists, err := vs.getTerminalBlockHashIfExists(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return emptyPayload(), nil
+		}
+		parentHash = terminalBlockHash
+	}
+
+	t, err := slots.ToTime(st.GenesisTime(), slot)
+	if err != nil {
+		return nil, err
+	}
+	// The randao mix of the head state's epoch is carried over to the next epochs
+	// until a block is processed, so it is the one the payload builds upon.
+	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
+	if err != nil {
+		return nil, err
+	}
+	finalizedBlockHash, err := vs.finalizedPayloadBlockHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fcs := &enginev1.ForkchoiceState{
+		HeadBlockHash:      parentHash,
+		SafeBlockHash:      parentHash,
+		FinalizedBlockHash: finalizedBlockHash,
+	}
+	attr := &enginev1.PayloadAttributes{
+		Timestamp:             uint64(t.Unix()),
+		Random:                random,
+		SuggestedFeeRecipient: vs.FeeRecipientCache.FeeRecipientOrDefault(vIdx).Bytes(),
+	}
+	payloadID, _, err := vs.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, attr)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not prepare payload")
+	}
+	if payloadID == nil {
+		return nil, errors.New("nil payload id")
+	}
+	return vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID)
+}
+
+// getTerminalBlockHashIfExists returns the hash of the terminal proof-of-work block, which is the
+// parent of the first execution payload, and whether it has been reached at the given slot.
+// An overridden terminal block hash is only considered once its activation epoch is reached.
+func (vs *Server) getTerminalBlockHashIfExists(ctx context.Context, slot types.Slot) ([]byte, bool, error) {
+	if params.BeaconConfig().TerminalBlockHash != (common.Hash{}) &&
+		slots.ToEpoch(slot) < params.BeaconConfig().TerminalBlockHashActivationEpoch {
+		return nil, false, nil
+	}
+	terminalBlockHash, exists, err := vs.ExecutionEngineCaller.GetTerminalBlockHash(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "could not get terminal block from execution engine")
+	}
+	return terminalBlockHash, exists, nil
+}
+
+// finalizedPayloadBlockHash returns the execution block hash of the finalized block's payload,
+// or the zero hash if the finalized block has no execution payload.
+func (vs *Server) finalizedPayloadBlockHash(ctx context.Context) ([]byte, error) {
+	finalizedRoot := bytesutil.ToBytes32(vs.FinalizationFetcher.FinalizedCheckpt().Root)
+	if finalizedRoot == params.BeaconConfig().ZeroHash {
+		return params.BeaconConfig().ZeroHash[:], nil
+	}
+	finalizedBlock, err := vs.BeaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.IsNil() || finalizedBlock.Version() < version.Bellatrix {
+		return params.BeaconConfig().ZeroHash[:], nil
+	}
+	payload, err := finalizedBlock.Block().Body().ExecutionPayload()
+	if err != nil {
+		return nil, err
+	}
+	return payload.BlockHash, nil
+}
+
+// emptyPayload returns an execution payload with every field zeroed, which is the
+// payload of the blocks proposed before the merge transition.
+func emptyPayload() *ethpb.ExecutionPayload {
+	return &ethpb.ExecutionPayload{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptRoot:   make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		Random:        make([]byte, fieldparams.RootLength),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     make([]byte, fieldparams.RootLength),
+		Transactions:  make([][]byte, 0),
+	}
+}
@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestServer_getExecutionPayload(t *testing.T) {
+	preMergeState, _ := util.DeterministicGenesisStateMerge(t, 1)
+	postMergeState, _ := util.DeterministicGenesisStateMerge(t, 1)
+	require.NoError(t, postMergeState.SetLatestExecutionPayloadHeader(&ethpb.ExecutionPayloadHeader{
+		ParentHash:       make([]byte, 32),
+		FeeRecipient:     make([]byte, 20),
+		StateRoot:        make([]byte, 32),
+		ReceiptRoot:      make([]byte, 32),
+		LogsBloom:        make([]byte, 256),
+		Random:           make([]byte, 32),
+		BaseFeePerGas:    make([]byte, 32),
+		BlockHash:        bytesutil.PadTo([]byte{'a'}, 32),
+		TransactionsRoot: make([]byte, 32),
+	}))
+	terminalHash := common.BytesToHash(bytesutil.PadTo([]byte{'b'}, 32))
+	headRoot := [32]byte{'c'}
+	preparedID := [8]byte{'d'}
+	builtPayload := &ethpb.ExecutionPayload{BlockHash: bytesutil.PadTo([]byte{'e'}, 32)}
+
+	tests := []struct {
+		name              string
+		merged            bool
+		terminalBlockHash common.Hash
+		activationEpoch   types.Epoch
+		terminalBlock     []byte
+		cachedHeadRoot    [32]byte
+		forkchoiceErr     error
+		feeRecipient      common.Address
+		wantEmpty         bool
+		wantPrepared      bool
+		wantHeadHash      []byte
+		wantErr           string
+	}{
+		{
+			name:           "prepared payload",
+			merged:         true,
+			cachedHeadRoot: headRoot,
+			wantPrepared:   true,
+		},
+		{
+			name:           "prepared payload for another head",
+			merged:         true,
+			cachedHeadRoot: [32]byte{'f'},
+			wantHeadHash:   bytesutil.PadTo([]byte{'a'}, 32),
+		},
+		{
+			name:         "merge complete",
+			merged:       true,
+			wantHeadHash: bytesutil.PadTo([]byte{'a'}, 32),
+		},
+		{
+			name:         "registered fee recipient",
+			merged:       true,
+			feeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
+			wantHeadHash: bytesutil.PadTo([]byte{'a'}, 32),
+		},
+		{
+			name:      "terminal block not reached",
+			wantEmpty: true,
+		},
+		{
+			name:              "terminal block hash activation epoch not reached",
+			terminalBlockHash: terminalHash,
+			activationEpoch:   10,
+			wantEmpty:         true,
+		},
+		{
+			name:              "terminal block hash override",
+			terminalBlockHash: terminalHash,
+			terminalBlock:     terminalHash.Bytes(),
+			wantHeadHash:      terminalHash.Bytes(),
+		},
+		{
+			name:          "terminal block reached",
+			terminalBlock: terminalHash.Bytes(),
+			wantHeadHash:  terminalHash.Bytes(),
+		},
+		{
+			name:          "forkchoice updated error",
+			merged:        true,
+			forkchoiceErr: errors.New("engine down"),
+			wantErr:       "could not prepare payload: engine down",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.TerminalBlockHash = tt.terminalBlockHash
+			cfg.TerminalBlockHashActivationEpoch = tt.activationEpoch
+			params.OverrideBeaconConfig(cfg)
+
+			st := preMergeState
+			if tt.merged {
+				st = postMergeState
+			}
+			pid := enginev1.PayloadIDBytes{'g'}
+			engine := &mockPOW.EngineClient{
+				PayloadIDBytes:          &pid,
+				ExecutionPayload:        builtPayload,
+				ErrForkchoiceUpdated:    tt.forkchoiceErr,
+				TerminalBlockHash:       tt.terminalBlock,
+				TerminalBlockHashExists: tt.terminalBlock != nil,
+			}
+			proposerCache := cache.NewProposerPayloadIDsCache()
+			proposerCache.SetProposerAndPayloadIDs(1, 2, tt.cachedHeadRoot, preparedID)
+			feeRecipientCache := cache.NewFeeRecipientCache()
+			wantFeeRecipient := params.BeaconConfig().FeeRecipient
+			if tt.feeRecipient != (common.Address{}) {
+				feeRecipientCache.SetFeeRecipient(2, tt.feeRecipient)
+				wantFeeRecipient = tt.feeRecipient
+			}
+			vs := &Server{
+				HeadFetcher:            &mock.ChainService{State: st},
+				FinalizationFetcher:    &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}},
+				BeaconDB:               dbutil.SetupDB(t),
+				ExecutionEngineCaller:  engine,
+				ProposerSlotIndexCache: proposerCache,
+				FeeRecipientCache:      feeRecipientCache,
+			}
+
+			payload, err := vs.getExecutionPayload(context.Background(), 1, 2, headRoot)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			switch {
+			case tt.wantEmpty:
+				assert.DeepEqual(t, emptyPayload(), payload)
+				assert.Equal(t, 0, len(engine.ForkchoiceUpdatedStates))
+			case tt.wantPrepared:
+				assert.Equal(t, builtPayload, payload)
+				assert.Equal(t, 0, len(engine.ForkchoiceUpdatedStates))
+			default:
+				assert.Equal(t, builtPayload, payload)
+				require.Equal(t, 1, len(engine.ForkchoiceUpdatedStates))
+				assert.DeepEqual(t, tt.wantHeadHash, engine.ForkchoiceUpdatedStates[0].HeadBlockHash)
+				assert.DeepEqual(t, params.BeaconConfig().ZeroHash[:], engine.ForkchoiceUpdatedStates[0].FinalizedBlockHash)
+				attr := engine.PayloadAttributes[0]
+				require.NotNil(t, attr)
+				assert.DeepEqual(t, wantFeeRecipient.Bytes(), attr.SuggestedFeeRecipient)
+				assert.Equal(t, st.GenesisTime()+params.BeaconConfig().SecondsPerSlot, attr.Timestamp)
+			}
+		})
+	}
+}
+
+func TestServer_trackProposerSlots(t *testing.T) {
+	proposerCache := cache.NewProposerPayloadIDsCache()
+	vs := &Server{ProposerSlotIndexCache: proposerCache}
+	proposerCache.SetProposerAndPayloadIDs(1, 2, [32]byte{'a'}, [8]byte{'b'})
+
+	vs.trackProposerSlots(2, []types.Slot{1, 3})
+	// The payload prepared for the same proposer is kept.
+	vIdx, root, pid, ok := proposerCache.GetProposerPayloadIDs(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.ValidatorIndex(2), vIdx)
+	assert.Equal(t, [32]byte{'a'}, root)
+	assert.Equal(t, [8]byte{'b'}, pid)
+	vIdx, _, pid, ok = proposerCache.GetProposerPayloadIDs(3)
+	require.Equal(t, true, ok)
+	assert.Equal(t, types.ValidatorIndex(2), vIdx)
+	assert.Equal(t, [8]byte{}, pid)
+}
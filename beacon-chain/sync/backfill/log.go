@@ -0,0 +1,7 @@
+package backfill
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "backfill")
@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_lowest_slot",
+		Help: "The slot of the lowest block backfilled below the origin block, or 0 once backfill is complete.",
+	})
+	backfilledBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_total",
+		Help: "Count of blocks backfilled below the origin block.",
+	})
+	backfillBatchFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_batch_failures_total",
+		Help: "Count of batches of blocks which could not be backfilled.",
+	})
+)
@@ -0,0 +1,351 @@
+// Package backfill fills in the blocks between genesis and the origin block of a beacon node
+// which was started from a checkpoint, by requesting them backwards from peers and verifying
+// that they form an unbroken chain of parent roots down to genesis.
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/rand"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+const (
+	// pollingInterval is how often the service checks whether initial sync has completed.
+	pollingInterval = 6 * time.Second
+	// retryInterval is how long the service waits before retrying a batch which could not be backfilled.
+	retryInterval = 2 * time.Second
+)
+
+var (
+	errNoPeersAvailable = errors.New("no peers available")
+	errInvalidBatch     = errors.New("blocks do not descend to the lowest backfilled block")
+)
+
+// Checker defines a struct which can report the progress of backfilling blocks.
+type Checker interface {
+	Backfilling() bool
+	BackfillSlot() types.Slot
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	Chain       blockchain.ChainInfoFetcher
+	InitialSync prysmsync.Checker
+}
+
+// Service requests blocks below the lowest block in the database from peers, until the
+// chain of blocks is complete down to genesis.
+type Service struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	rand        *rand.Rand
+	lock        sync.RWMutex
+	lowest      block.SignedBeaconBlock
+	backfilling bool
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		rand:   rand.NewGenerator(),
+	}
+}
+
+// Start the backfill service. It does nothing unless the node was started from an
+// origin block, and waits for initial sync to complete before backfilling.
+func (s *Service) Start() {
+	lowest, err := s.lowestBlock(s.ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			log.Debug("No origin block in database, not backfilling")
+			return
+		}
+		log.WithError(err).Error("Could not determine lowest block to backfill from")
+		return
+	}
+	complete, err := s.isComplete(s.ctx, lowest)
+	if err != nil {
+		log.WithError(err).Error("Could not determine whether backfill is complete")
+		return
+	}
+	if complete {
+		log.Debug("Blocks are already backfilled down to genesis")
+		return
+	}
+	s.setLowest(lowest)
+	s.lock.Lock()
+	s.backfilling = true
+	s.lock.Unlock()
+
+	if !s.waitForInitialSync() {
+		return
+	}
+	log.WithField("slot", lowest.Block().Slot()).Info("Backfilling blocks below the origin block")
+	if err := s.backfill(); err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		log.WithError(err).Error("Could not backfill blocks")
+		return
+	}
+	log.Info("Backfilled blocks down to genesis")
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Backfilling returns true if the service is filling in blocks below the origin block.
+func (s *Service) Backfilling() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.backfilling
+}
+
+// BackfillSlot returns the slot of the lowest backfilled block, or 0 if the service is not backfilling.
+func (s *Service) BackfillSlot() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if !s.backfilling || s.lowest == nil {
+		return 0
+	}
+	return s.lowest.Block().Slot()
+}
+
+// backfill requests batches of blocks below the lowest backfilled block until the
+// chain of blocks reaches genesis.
+func (s *Service) backfill() error {
+	// The requested window is tracked separately from the lowest block, as the slots
+	// right below the lowest block may have been skipped.
+	end := s.lowestSlot()
+	for {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		start := types.Slot(0)
+		if size := s.batchSize(); end > size {
+			start = end - size
+		}
+		blks, err := s.requestBatch(s.ctx, start, end)
+		if err == nil && len(blks) == 0 && start == 0 {
+			err = errors.Wrap(errInvalidBatch, "no blocks down to genesis")
+		}
+		if err != nil {
+			backfillBatchFailures.Inc()
+			log.WithError(err).WithFields(logrus.Fields{
+				"start": start,
+				"end":   end,
+			}).Debug("Could not backfill batch of blocks")
+			// Blocks may have been withheld in an earlier empty response, so start over from the lowest block.
+			end = s.lowestSlot()
+			select {
+			case <-s.ctx.Done():
+				return s.ctx.Err()
+			case <-time.After(retryInterval):
+			}
+			continue
+		}
+		if len(blks) == 0 {
+			end = start
+			continue
+		}
+		if err := s.saveBatch(s.ctx, blks); err != nil {
+			return err
+		}
+		end = s.lowestSlot()
+		complete, err := s.isComplete(s.ctx, blks[0])
+		if err != nil {
+			return err
+		}
+		if complete {
+			s.lock.Lock()
+			s.backfilling = false
+			s.lock.Unlock()
+			backfillSlot.Set(0)
+			return nil
+		}
+	}
+}
+
+// requestBatch requests the blocks of slots [start, end) from a peer which has finalized them,
+// and verifies that they descend to the lowest backfilled block.
+func (s *Service) requestBatch(ctx context.Context, start, end types.Slot) ([]block.SignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "backfill.requestBatch")
+	defer span.End()
+
+	lowest := s.lowestBlockInMemory()
+	_, peers := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, slots.ToEpoch(lowest.Block().Slot()))
+	if len(peers) == 0 {
+		return nil, errNoPeersAvailable
+	}
+	pid := peers[s.rand.Int()%len(peers)]
+	req := &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(end - start),
+		Step:      1,
+	}
+	blks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.cfg.Chain, s.cfg.P2P, pid, req, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	if err := verifyBatch(blks, bytesutil.ToBytes32(lowest.Block().ParentRoot())); err != nil {
+		s.downscorePeer(pid)
+		return nil, err
+	}
+	return blks, nil
+}
+
+// saveBatch saves a verified batch of blocks, indexes them as finalized, and records its first block
+// as the lowest backfilled block.
+func (s *Service) saveBatch(ctx context.Context, blks []block.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "backfill.saveBatch")
+	defer span.End()
+
+	if err := s.cfg.DB.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	childRoot, err := s.lowestBlockInMemory().Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	// Backfilled blocks are ancestors of the finalized origin block, so they are indexed as
+	// finalized and canonical, which allows serving them to peers.
+	if err := s.cfg.DB.SaveFinalizedBlockRoots(ctx, blks, childRoot); err != nil {
+		return errors.Wrap(err, "could not index backfilled blocks as finalized")
+	}
+	lowest := blks[0]
+	root, err := lowest.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := s.cfg.DB.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	s.setLowest(lowest)
+	backfilledBlocks.Add(float64(len(blks)))
+	backfillSlot.Set(float64(lowest.Block().Slot()))
+	log.WithFields(logrus.Fields{
+		"slot":   lowest.Block().Slot(),
+		"blocks": len(blks),
+	}).Debug("Backfilled batch of blocks")
+	return nil
+}
+
+// verifyBatch checks that, walking down from the last block, each block of the batch
+// has the root which its child block expects as parent root.
+func verifyBatch(blks []block.SignedBeaconBlock, parentRoot [32]byte) error {
+	for i := len(blks) - 1; i >= 0; i-- {
+		root, err := blks[i].Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if root != parentRoot {
+			return errors.Wrapf(errInvalidBatch, "block at slot %d has root %#x, wanted %#x", blks[i].Block().Slot(), root, parentRoot)
+		}
+		parentRoot = bytesutil.ToBytes32(blks[i].Block().ParentRoot())
+	}
+	return nil
+}
+
+// lowestBlock returns the lowest backfilled block in the database, or the origin block
+// if backfilling has not started yet.
+func (s *Service) lowestBlock(ctx context.Context) (block.SignedBeaconBlock, error) {
+	root, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		root, err = s.cfg.DB.OriginBlockRoot(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	blk, err := s.cfg.DB.Block(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Errorf("lowest block %#x not found in database", root)
+	}
+	return blk, nil
+}
+
+// isComplete returns true if the given block is the genesis block, or its parent is already in the database.
+func (s *Service) isComplete(ctx context.Context, blk block.SignedBeaconBlock) (bool, error) {
+	if blk.Block().Slot() == 0 {
+		return true, nil
+	}
+	return s.cfg.DB.HasBlock(ctx, bytesutil.ToBytes32(blk.Block().ParentRoot())), nil
+}
+
+// waitForInitialSync blocks until initial sync has completed. It returns false if the service was stopped.
+func (s *Service) waitForInitialSync() bool {
+	for s.cfg.InitialSync.Syncing() {
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-time.After(pollingInterval):
+		}
+	}
+	return true
+}
+
+func (s *Service) batchSize() types.Slot {
+	size := flags.Get().BlockBatchLimit
+	if max := params.BeaconNetworkConfig().MaxRequestBlocks; size == 0 || size > max {
+		size = max
+	}
+	return types.Slot(size)
+}
+
+func (s *Service) downscorePeer(pid peer.ID) {
+	s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+	log.WithField("peerID", pid).Debug("Peer returned blocks which do not descend to the lowest backfilled block")
+}
+
+func (s *Service) setLowest(blk block.SignedBeaconBlock) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lowest = blk
+}
+
+func (s *Service) lowestBlockInMemory() block.SignedBeaconBlock {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowest
+}
+
+func (s *Service) lowestSlot() types.Slot {
+	return s.lowestBlockInMemory().Block().Slot()
+}
@@ -0,0 +1,137 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+// chainOfBlocks returns blocks for the given increasing slots, each block being the parent of the next one.
+func chainOfBlocks(t *testing.T, slots ...types.Slot) []block.SignedBeaconBlock {
+	blks := make([]block.SignedBeaconBlock, 0, len(slots))
+	parentRoot := make([]byte, 32)
+	for _, slot := range slots {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parentRoot
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		parentRoot = root[:]
+		blks = append(blks, wrapper.WrappedPhase0SignedBeaconBlock(b))
+	}
+	return blks
+}
+
+func blockRoot(t *testing.T, blk block.SignedBeaconBlock) [32]byte {
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+func TestService_Start_NoOrigin(t *testing.T) {
+	s := NewService(context.Background(), &Config{DB: dbtest.SetupDB(t)})
+	s.Start()
+	assert.Equal(t, false, s.Backfilling())
+	assert.Equal(t, types.Slot(0), s.BackfillSlot())
+}
+
+func TestService_Start_AlreadyComplete(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blks := chainOfBlocks(t, 0, 1, 2)
+	require.NoError(t, beaconDB.SaveBlocks(ctx, blks))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, blockRoot(t, blks[2])))
+
+	s := NewService(ctx, &Config{DB: beaconDB})
+	s.Start()
+	assert.Equal(t, false, s.Backfilling())
+}
+
+func TestService_lowestBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blks := chainOfBlocks(t, 0, 5, 10)
+	require.NoError(t, beaconDB.SaveBlocks(ctx, blks[1:]))
+	s := NewService(ctx, &Config{DB: beaconDB})
+
+	_, err := s.lowestBlock(ctx)
+	require.ErrorContains(t, "not found in db", err)
+
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, blockRoot(t, blks[2])))
+	lowest, err := s.lowestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(10), lowest.Block().Slot())
+
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, blockRoot(t, blks[1])))
+	lowest, err = s.lowestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), lowest.Block().Slot())
+
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, blockRoot(t, blks[0])))
+	_, err = s.lowestBlock(ctx)
+	require.ErrorContains(t, "not found in database", err)
+}
+
+func TestVerifyBatch(t *testing.T) {
+	blks := chainOfBlocks(t, 0, 1, 3, 4, 8)
+	origin := blks[len(blks)-1]
+	parentRoot := bytesutil.ToBytes32(origin.Block().ParentRoot())
+
+	require.NoError(t, verifyBatch(blks[:4], parentRoot))
+	require.NoError(t, verifyBatch(blks[2:4], parentRoot))
+	require.NoError(t, verifyBatch(nil, parentRoot))
+
+	err := verifyBatch(blks[:3], parentRoot)
+	require.ErrorIs(t, err, errInvalidBatch)
+
+	gapped := []block.SignedBeaconBlock{blks[0], blks[2], blks[3]}
+	err = verifyBatch(gapped, parentRoot)
+	require.ErrorIs(t, err, errInvalidBatch)
+}
+
+func TestService_saveBatch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blks := chainOfBlocks(t, 0, 1, 2, 4, 5, 6)
+	origin := blks[len(blks)-1]
+	require.NoError(t, beaconDB.SaveBlocks(ctx, []block.SignedBeaconBlock{blks[0], origin}))
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, blockRoot(t, origin)))
+
+	s := NewService(ctx, &Config{DB: beaconDB})
+	s.setLowest(origin)
+	s.backfilling = true
+	assert.Equal(t, types.Slot(6), s.BackfillSlot())
+
+	require.NoError(t, s.saveBatch(ctx, blks[3:5]))
+	assert.Equal(t, types.Slot(4), s.BackfillSlot())
+	root, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot(t, blks[3]), root)
+	complete, err := s.isComplete(ctx, blks[3])
+	require.NoError(t, err)
+	assert.Equal(t, false, complete)
+
+	require.NoError(t, s.saveBatch(ctx, blks[1:3]))
+	assert.Equal(t, types.Slot(1), s.BackfillSlot())
+	for _, blk := range blks {
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, blockRoot(t, blk)))
+	}
+	// Backfilled blocks are indexed as finalized, so that they are served to peers.
+	for _, blk := range blks[1:5] {
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, blockRoot(t, blk)))
+	}
+	child, err := beaconDB.FinalizedChildBlock(ctx, blockRoot(t, blks[4]))
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot(t, origin), blockRoot(t, child))
+	complete, err = s.isComplete(ctx, blks[1])
+	require.NoError(t, err)
+	assert.Equal(t, true, complete)
+}
@@ -0,0 +1,23 @@
+// Package testing includes useful mocks for testing the
+// backfill status in unit tests.
+package testing
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// Backfill defines a mock for the backfill service.
+type Backfill struct {
+	IsBackfilling bool
+	Slot          types.Slot
+}
+
+// Backfilling --
+func (b *Backfill) Backfilling() bool {
+	return b.IsBackfilling
+}
+
+// BackfillSlot --
+func (b *Backfill) BackfillSlot() types.Slot {
+	return b.Slot
+}
@@ -56,6 +56,12 @@ var (
 		},
 		[]string{"topic"},
 	)
+	uploadLimiterWaits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_upload_limiter_waits_total",
+			Help: "Count the number of times serving blocks waited for the maximum upload rate.",
+		},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -1,8 +1,10 @@
 package sync
 
 import (
+	"context"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -19,9 +21,14 @@ const defaultBurstLimit = 5
 // Dummy topic to validate all incoming rpc requests.
 const rpcLimiterTopic = "rpc-limiter-topic"
 
+// Key of the single bucket of the upload limiter, shared by all peers.
+const uploadLimiterKey = "upload"
+
 type limiter struct {
 	limiterMap map[string]*leakybucket.Collector
-	p2p        p2p.P2P
+	// Limits the bytes of blocks served to all peers, if a maximum upload rate is set.
+	uploadLimiter *leakybucket.Collector
+	p2p           p2p.P2P
 	sync.RWMutex
 }
 
@@ -64,7 +71,12 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// General topic for all rpc requests.
 	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, false /* deleteEmptyBuckets */)
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	l := &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	if rate := flags.Get().MaxUploadRate * 1024; rate > 0 {
+		// Allow bursts of up to a second worth of uploads.
+		l.uploadLimiter = leakybucket.NewCollector(float64(rate), int64(rate), false /* deleteEmptyBuckets */)
+	}
+	return l
 }
 
 // Returns the current topic collector for the provided topic.
@@ -156,6 +168,31 @@ func (l *limiter) addRawStream(stream network.Stream) {
 	collector.Add(key, 1)
 }
 
+// waitForUpload blocks until the maximum upload rate allows sending the amount of bytes, or the
+// context is canceled.
+func (l *limiter) waitForUpload(ctx context.Context, amt int64) error {
+	if l.uploadLimiter == nil {
+		return nil
+	}
+	for {
+		amt -= l.uploadLimiter.Add(uploadLimiterKey, amt)
+		if amt <= 0 {
+			return nil
+		}
+		// The bucket is full, wait for it to leak the remaining bytes, or as much as it can hold.
+		wait := amt
+		if wait > l.uploadLimiter.Capacity() {
+			wait = l.uploadLimiter.Capacity()
+		}
+		uploadLimiterWaits.Inc()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(float64(wait) / l.uploadLimiter.Rate() * float64(time.Second))):
+		}
+	}
+}
+
 // frees all the collectors and removes them.
 func (l *limiter) free() {
 	l.Lock()
@@ -176,6 +213,9 @@ func (l *limiter) free() {
 		delete(l.limiterMap, t)
 		tempMap[ptr] = true
 	}
+	if l.uploadLimiter != nil {
+		l.uploadLimiter.Free()
+	}
 }
 
 // not to be used outside the rate limiter file as it is unsafe for concurrent usage
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
@@ -111,3 +112,37 @@ func Test_limiter_retrieveCollector_requiresLock(t *testing.T) {
 	_, err := l.retrieveCollector("")
 	require.ErrorContains(t, "caller must hold read/write lock", err)
 }
+
+func TestRateLimiter_WaitForUpload(t *testing.T) {
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	cfg := *resetFlags
+	cfg.MaxUploadRate = 1 // 1 KiB per second.
+	flags.Init(&cfg)
+
+	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
+	require.NotNil(t, rlimiter.uploadLimiter)
+	defer rlimiter.free()
+
+	// Up to a second worth of uploads is allowed immediately.
+	start := time.Now()
+	require.NoError(t, rlimiter.waitForUpload(context.Background(), 1024))
+	assert.Equal(t, true, time.Since(start) < 100*time.Millisecond, "upload within the burst should not wait")
+
+	// Beyond it, the upload waits for the bucket to leak.
+	start = time.Now()
+	require.NoError(t, rlimiter.waitForUpload(context.Background(), 256))
+	assert.Equal(t, true, time.Since(start) >= 200*time.Millisecond, "upload beyond the burst should wait")
+
+	// Waiting stops once the context is canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := rlimiter.waitForUpload(ctx, 1024)
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+}
+
+func TestRateLimiter_WaitForUpload_Unlimited(t *testing.T) {
+	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
+	assert.Equal(t, true, rlimiter.uploadLimiter == nil)
+	require.NoError(t, rlimiter.waitForUpload(context.Background(), 1<<30))
+}
@@ -162,6 +162,11 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 		if b == nil || b.IsNil() || b.Block().IsNil() {
 			continue
 		}
+		if err := s.rateLimiter.waitForUpload(ctx, int64(b.SizeSSZ())); err != nil {
+			log.WithError(err).Debug("Could not wait for the upload limit")
+			tracing.AnnotateError(span, err)
+			return err
+		}
 		if chunkErr := s.chunkBlockWriter(stream, b); chunkErr != nil {
 			log.WithError(chunkErr).Debug("Could not send a chunked response")
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
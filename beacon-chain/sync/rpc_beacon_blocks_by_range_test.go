@@ -11,18 +11,22 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	db2 "github.com/prysmaticlabs/prysm/beacon-chain/db"
 	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
@@ -86,6 +90,75 @@ func TestRPCBeaconBlocksByRange_RPCHandlerReturnsBlocks(t *testing.T) {
 	}
 }
 
+func TestRPCBeaconBlocksByRange_ServesBackfilledBlocks(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	ctx := context.Background()
+	d := db.SetupDB(t)
+
+	// The node was started from an origin block in epoch 2, and the blocks of epoch 1 were backfilled below it.
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var blks []block.SignedBeaconBlock
+	parentRoot := make([]byte, fieldparams.RootLength)
+	for i := slotsPerEpoch; i <= 2*slotsPerEpoch; i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = parentRoot
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		parentRoot = root[:]
+		blks = append(blks, wrapper.WrappedPhase0SignedBeaconBlock(blk))
+	}
+	backfilled, origin := blks[:len(blks)-1], blks[len(blks)-1]
+	originRoot, err := origin.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(ctx, origin))
+	require.NoError(t, d.SaveOriginBlockRoot(ctx, originRoot))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, originRoot))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: originRoot[:], Epoch: 2}))
+	// Save and index the backfilled blocks, as the backfill service does.
+	require.NoError(t, d.SaveBlocks(ctx, backfilled))
+	require.NoError(t, d.SaveFinalizedBlockRoots(ctx, backfilled, originRoot))
+
+	chain, err := blockchain.NewService(ctx,
+		blockchain.WithDatabase(d),
+		blockchain.WithStateGen(stategen.New(d)),
+		blockchain.WithForkChoiceStore(protoarray.New(0, 0, [32]byte{})),
+	)
+	require.NoError(t, err)
+	req := &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: slotsPerEpoch,
+		Step:      1,
+		Count:     uint64(slotsPerEpoch),
+	}
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, chain: chain}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(0.000001, int64(req.Count*10), false)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, blk := range backfilled {
+			expectSuccess(t, stream)
+			res := util.NewBeaconBlock()
+			assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, blk.Block().Slot(), res.Block.Slot)
+		}
+	})
+
+	stream1, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(ctx, req, stream1))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRPCBeaconBlocksByRange_ReturnCorrectNumberBack(t *testing.T) {
 	p1 := p2ptest.NewTestP2P(t)
 	p2 := p2ptest.NewTestP2P(t)
@@ -29,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
@@ -61,6 +62,7 @@ var (
 	earlyAttestationProcessingTolerance = params.BeaconNetworkConfig().MaximumGossipClockDisparity
 	errWrongMessage                     = errors.New("wrong pubsub message")
 	errNilMessage                       = errors.New("nil pubsub message")
+	errUnverifiedTerminalBlock          = errors.New("could not verify terminal block")
 )
 
 // Common type for functional p2p validation options.
@@ -83,6 +85,7 @@ type config struct {
 	stateGen                *stategen.State
 	slasherAttestationsFeed *event.Feed
 	slasherBlockHeadersFeed *event.Feed
+	executionEngineCaller   powchain.EngineCaller
 }
 
 // This defines the interface for interacting with block chain service
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
@@ -14,11 +15,13 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/config/features"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/sirupsen/logrus"
@@ -160,6 +163,10 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	}
 
 	if err := s.validateBeaconBlock(ctx, blk, blockRoot); err != nil {
+		// The block is not penalized when its terminal block could not be checked against the execution engine.
+		if errors.Is(err, errUnverifiedTerminalBlock) {
+			return pubsub.ValidationIgnore, err
+		}
 		return pubsub.ValidationReject, err
 	}
 
@@ -226,6 +233,63 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk block.SignedBeaco
 		return errors.New("incorrect proposer index")
 	}
 
+	if err := s.validateBellatrixBeaconBlock(ctx, parentState, blk.Block()); err != nil {
+		if !errors.Is(err, errUnverifiedTerminalBlock) {
+			s.setBadBlock(ctx, blockRoot)
+		}
+		return err
+	}
+	return nil
+}
+
+// validateBellatrixBeaconBlock validates the execution payload of a block against its parent state
+// advanced to the block's slot. The payload's timestamp has to match the block's slot, and the payload
+// of the merge transition block has to build upon a valid terminal proof-of-work block.
+func (s *Service) validateBellatrixBeaconBlock(ctx context.Context, parentState state.BeaconState, blk block.BeaconBlock) error {
+	if blk.Version() < version.Bellatrix || parentState.Version() < version.Bellatrix {
+		return nil
+	}
+	body := blk.Body()
+	executionEnabled, err := blocks.ExecutionEnabled(parentState, body)
+	if err != nil {
+		return err
+	}
+	if !executionEnabled {
+		return nil
+	}
+	payload, err := body.ExecutionPayload()
+	if err != nil {
+		return err
+	}
+	t, err := slots.ToTime(parentState.GenesisTime(), blk.Slot())
+	if err != nil {
+		return err
+	}
+	if payload.Timestamp != uint64(t.Unix()) {
+		return errors.New("incorrect timestamp")
+	}
+
+	mergeBlock, err := blocks.IsMergeBlock(parentState, body)
+	if err != nil {
+		return err
+	}
+	if !mergeBlock {
+		return nil
+	}
+	if params.BeaconConfig().TerminalBlockHash != (common.Hash{}) &&
+		slots.ToEpoch(blk.Slot()) < params.BeaconConfig().TerminalBlockHashActivationEpoch {
+		return errors.New("terminal block hash activation epoch not reached")
+	}
+	if s.cfg.executionEngineCaller == nil {
+		return errors.Wrap(errUnverifiedTerminalBlock, "no execution engine caller")
+	}
+	valid, err := s.cfg.executionEngineCaller.IsValidTerminalBlockHash(ctx, common.BytesToHash(payload.ParentHash))
+	if err != nil {
+		return errors.Wrap(errUnverifiedTerminalBlock, err.Error())
+	}
+	if !valid {
+		return errors.Errorf("invalid terminal block hash %#x", payload.ParentHash)
+	}
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	gcache "github.com/patrickmn/go-cache"
@@ -23,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
@@ -35,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/testing/util"
+	"github.com/prysmaticlabs/prysm/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -1046,3 +1049,95 @@ func TestService_isBlockQueueable(t *testing.T) {
 	result = isBlockQueueable(genesisTime, blockSlot, receivedTime)
 	assert.Equal(t, true, result)
 }
+
+func TestService_validateBellatrixBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateMerge(t, 1)
+	require.NoError(t, st.SetGenesisTime(uint64(time.Now().Unix())))
+	slot := types.Slot(1)
+	slotTime, err := slots.ToTime(st.GenesisTime(), slot)
+	require.NoError(t, err)
+	terminalHash := bytesutil.PadTo([]byte{'a'}, 32)
+
+	tests := []struct {
+		name              string
+		emptyPayload      bool
+		timestamp         uint64
+		terminalBlockHash common.Hash
+		activationEpoch   types.Epoch
+		validTerminal     bool
+		terminalErr       error
+		wantErr           string
+		wantUnverified    bool
+	}{
+		{
+			name:         "execution not enabled",
+			emptyPayload: true,
+		},
+		{
+			name:          "valid terminal block",
+			timestamp:     uint64(slotTime.Unix()),
+			validTerminal: true,
+		},
+		{
+			name:          "incorrect timestamp",
+			timestamp:     uint64(slotTime.Unix()) + 1,
+			validTerminal: true,
+			wantErr:       "incorrect timestamp",
+		},
+		{
+			name:      "invalid terminal block",
+			timestamp: uint64(slotTime.Unix()),
+			wantErr:   "invalid terminal block hash",
+		},
+		{
+			name:           "terminal block unavailable",
+			timestamp:      uint64(slotTime.Unix()),
+			terminalErr:    errors.New("engine down"),
+			wantErr:        "engine down",
+			wantUnverified: true,
+		},
+		{
+			name:              "terminal block hash activation epoch not reached",
+			timestamp:         uint64(slotTime.Unix()),
+			terminalBlockHash: common.BytesToHash(terminalHash),
+			activationEpoch:   1,
+			validTerminal:     true,
+			wantErr:           "terminal block hash activation epoch not reached",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+			cfg := params.BeaconConfig().Copy()
+			cfg.TerminalBlockHash = tt.terminalBlockHash
+			cfg.TerminalBlockHashActivationEpoch = tt.activationEpoch
+			params.OverrideBeaconConfig(cfg)
+
+			blk := util.NewBeaconBlockMerge()
+			blk.Block.Slot = slot
+			if !tt.emptyPayload {
+				blk.Block.Body.ExecutionPayload.ParentHash = terminalHash
+				blk.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{'b'}, 32)
+				blk.Block.Body.ExecutionPayload.Timestamp = tt.timestamp
+			}
+			wb, err := wrapper.WrappedMergeBeaconBlock(blk.Block)
+			require.NoError(t, err)
+			r := &Service{
+				cfg: &config{
+					executionEngineCaller: &mockPOW.EngineClient{
+						ValidTerminalBlockHash:  tt.validTerminal,
+						ErrIsValidTerminalBlock: tt.terminalErr,
+					},
+				},
+			}
+			err = r.validateBellatrixBeaconBlock(ctx, st, wb)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantUnverified, errors.Is(err, errUnverifiedTerminalBlock))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package checkpointcmd
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/checkpoint"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// FlagOptions returns the initializer of the database from checkpoint sync data configured
+// by the checkpoint sync flags, or nil if checkpoint sync is not enabled.
+func FlagOptions(c *cli.Context) (checkpoint.Initializer, error) {
+	ws, err := helpers.ParseWeakSubjectivityInputString(c.String(flags.WeakSubjectivityCheckpt.Name))
+	if err != nil {
+		return nil, err
+	}
+	url := c.String(flags.CheckpointSyncUrl.Name)
+	statePath := c.String(flags.CheckpointState.Name)
+	blockPath := c.String(flags.CheckpointBlock.Name)
+	switch {
+	case url != "" && (statePath != "" || blockPath != ""):
+		return nil, errors.Errorf("--%s cannot be used along with --%s or --%s",
+			flags.CheckpointSyncUrl.Name, flags.CheckpointState.Name, flags.CheckpointBlock.Name)
+	case url != "":
+		initializer, err := checkpoint.NewAPIInitializer(url, ws)
+		if err != nil {
+			return nil, err
+		}
+		return initializer, nil
+	case statePath != "" && blockPath != "":
+		initializer, err := checkpoint.NewFileInitializer(statePath, blockPath, ws)
+		if err != nil {
+			return nil, err
+		}
+		return initializer, nil
+	case statePath != "" || blockPath != "":
+		return nil, errors.Errorf("--%s and --%s must be used together",
+			flags.CheckpointState.Name, flags.CheckpointBlock.Name)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,93 @@
+package checkpointcmd
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/checkpoint"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFlagOptions(t *testing.T) {
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, file.WriteFile(statePath, []byte{}))
+	require.NoError(t, file.WriteFile(blockPath, []byte{}))
+	ws := "0x1111111111111111111111111111111111111111111111111111111111111111:10"
+
+	tests := []struct {
+		name      string
+		url       string
+		statePath string
+		blockPath string
+		ws        string
+		wantAPI   bool
+		wantFile  bool
+		wantErr   string
+	}{
+		{
+			name: "checkpoint sync disabled",
+		},
+		{
+			name:    "checkpoint sync url",
+			url:     "http://localhost:3500",
+			ws:      ws,
+			wantAPI: true,
+		},
+		{
+			name:      "checkpoint sync files",
+			statePath: statePath,
+			blockPath: blockPath,
+			wantFile:  true,
+		},
+		{
+			name:      "checkpoint sync url along with files",
+			url:       "http://localhost:3500",
+			statePath: statePath,
+			blockPath: blockPath,
+			wantErr:   "cannot be used along with",
+		},
+		{
+			name:      "checkpoint state without block",
+			statePath: statePath,
+			wantErr:   "must be used together",
+		},
+		{
+			name:    "invalid weak subjectivity checkpoint",
+			url:     "http://localhost:3500",
+			ws:      "0x11:ab",
+			wantErr: "block root is not length of 32",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := cli.App{}
+			set := flag.NewFlagSet("test", 0)
+			set.String(flags.CheckpointSyncUrl.Name, tt.url, "")
+			set.String(flags.CheckpointState.Name, tt.statePath, "")
+			set.String(flags.CheckpointBlock.Name, tt.blockPath, "")
+			set.String(flags.WeakSubjectivityCheckpt.Name, tt.ws, "")
+			ctx := cli.NewContext(&app, set, nil)
+
+			initializer, err := FlagOptions(ctx)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			_, isAPI := initializer.(*checkpoint.APIInitializer)
+			assert.Equal(t, tt.wantAPI, isAPI)
+			_, isFile := initializer.(*checkpoint.FileInitializer)
+			assert.Equal(t, tt.wantFile, isFile)
+			if !tt.wantAPI && !tt.wantFile {
+				assert.Equal(t, nil, initializer)
+			}
+		})
+	}
+}
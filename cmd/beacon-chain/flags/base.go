@@ -134,6 +134,12 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 10,
 	}
+	// MaxUploadRate specifies the maximum rate at which blocks are served to peers over BlocksByRange.
+	MaxUploadRate = &cli.Uint64Flag{
+		Name: "max-upload-rate",
+		Usage: "The maximum rate, in kilobytes per second, at which blocks are served to all peers together " +
+			"in response to blocks by range requests. The default of 0 does not limit it.",
+	}
 	// DisableSync disables a node from syncing at start-up. Instead the node enters regular sync
 	// immediately.
 	DisableSync = &cli.BoolFlag{
@@ -184,12 +190,53 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// CheckpointSyncUrl defines a flag to start the beacon chain from the finalized state of a trusted beacon node.
+	CheckpointSyncUrl = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. The state and block of the " +
+			"--weak-subjectivity-checkpoint are downloaded if it is set, else the finalized state and block are used. " +
+			"Checkpoint sync is only run on an empty database.",
+	}
+	// CheckpointState defines a flag to start the beacon chain from a checkpoint state file.
+	CheckpointState = &cli.StringFlag{
+		Name:  "checkpoint-state",
+		Usage: "Load the checkpoint sync state from an ssz file. Requires --checkpoint-block.",
+	}
+	// CheckpointBlock defines a flag to start the beacon chain from a checkpoint block file.
+	CheckpointBlock = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "Load the checkpoint sync block from an ssz file. Requires --checkpoint-state.",
+	}
+	// PruneHistory defines a flag to delete finalized blocks and states older than the retention period from the database.
+	PruneHistory = &cli.BoolFlag{
+		Name: "prune-history",
+		Usage: "Deletes finalized blocks and states older than --prune-history-epochs from the database in the background, " +
+			"to cap its disk usage. Pruned history can not be served to peers or over the API, and is not backfilled.",
+	}
+	// PruneHistoryEpochs defines a flag to set the number of epochs of finalized history kept when pruning history.
+	PruneHistoryEpochs = &cli.Uint64Flag{
+		Name: "prune-history-epochs",
+		Usage: "The number of epochs of finalized history kept in the database with --prune-history. " +
+			"The weak subjectivity period is kept instead if it is longer, which is also the default when the flag is not set.",
+	}
 	// MinPeersPerSubnet defines a flag to set the minimum number of peers that a node will attempt to peer with for a subnet.
 	MinPeersPerSubnet = &cli.Uint64Flag{
 		Name:  "minimum-peers-per-subnet",
-		Usage: "Sets the minimum number of peers that a node will attempt to peer with that are subscribed to a subnet.",
+		Usage: "Sets the minimum number of peers that a node will attempt to peer with that are subscribed to a subnet, and that are kept when pruning peers.",
 		Value: 6,
 	}
+	// GossipTraceFile defines a flag to set the file to which gossip messages are traced.
+	GossipTraceFile = &cli.StringFlag{
+		Name: "gossip-trace-file",
+		Usage: "Traces every gossip message received, validated, rejected, duplicated and delivered by the node " +
+			"to this file, as JSON lines. The capture can be summarized and replayed with tools/gossip-trace.",
+	}
+	// GossipTraceMaxSize defines a flag to set the size at which the gossip trace file is rotated.
+	GossipTraceMaxSize = &cli.Uint64Flag{
+		Name:  "gossip-trace-max-size",
+		Usage: "The size in megabytes at which the file of --gossip-trace-file is rotated. The 5 most recent rotated files are kept.",
+		Value: 100,
+	}
 	// TerminalTotalDifficultyOverride specifies the total difficulty to manual overrides the `TERMINAL_TOTAL_DIFFICULTY` parameter.
 	TerminalTotalDifficultyOverride = &cli.Uint64Flag{
 		Name: "terminal-total-difficulty-override",
@@ -211,6 +258,17 @@ var (
 			"WARNING: This flag should be used only if you have a clear understanding that community has decided to override the terminal block hash activation epoch. " +
 			"Incorrect usage will result in your node experience consensus failure.",
 	}
+	// ExecutionJWTSecretFlag provides a path to a file containing a hex-encoded string representing a 32 byte secret
+	// used to authenticate with an execution node via HTTP. This is required if using an HTTP connection, otherwise all requests
+	// to execution nodes for consensus-related calls will fail.
+	ExecutionJWTSecretFlag = &cli.StringFlag{
+		Name: "jwt-secret",
+		Usage: "REQUIRED if connecting to an execution node via HTTP. Provides a path to a file containing " +
+			"a hex-encoded string representing a 32 byte secret used for authentication with an execution node via " +
+			"HTTP. If this is not set, all requests to execution nodes via HTTP for consensus-related calls will fail, which " +
+			"will prevent your validators from performing their duties.",
+		Value: "",
+	}
 	// FeeRecipient specifies the fee recipient for the transaction fees.
 	FeeRecipient = &cli.StringFlag{
 		Name:  "fee-recipient",
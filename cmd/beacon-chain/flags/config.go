@@ -16,6 +16,7 @@ type GlobalFlags struct {
 	MinimumPeersPerSubnet      uint64
 	BlockBatchLimit            uint64
 	BlockBatchLimitBurstFactor uint64
+	MaxUploadRate              uint64
 }
 
 var globalConfig *GlobalFlags
@@ -52,6 +53,7 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Uint64(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Uint64(BlockBatchLimitBurstFactor.Name)
+	cfg.MaxUploadRate = ctx.Uint64(MaxUploadRate.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Uint64(MinPeersPerSubnet.Name)
 	configureMinimumPeers(ctx, cfg)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/cmd"
 	blockchaincmd "github.com/prysmaticlabs/prysm/cmd/beacon-chain/blockchain"
+	checkpointcmd "github.com/prysmaticlabs/prysm/cmd/beacon-chain/checkpoint"
 	dbcommands "github.com/prysmaticlabs/prysm/cmd/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	powchaincmd "github.com/prysmaticlabs/prysm/cmd/beacon-chain/powchain"
@@ -51,6 +52,7 @@ var appFlags = []cli.Flag{
 	flags.DisableDiscv5,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.MaxUploadRate,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
@@ -64,11 +66,19 @@ var appFlags = []cli.Flag{
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.CheckpointSyncUrl,
+	flags.CheckpointState,
+	flags.CheckpointBlock,
 	flags.MinPeersPerSubnet,
+	flags.GossipTraceFile,
+	flags.GossipTraceMaxSize,
+	flags.PruneHistory,
+	flags.PruneHistoryEpochs,
 	flags.TerminalTotalDifficultyOverride,
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
 	flags.FeeRecipient,
+	flags.ExecutionJWTSecretFlag,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -77,6 +87,7 @@ var appFlags = []cli.Flag{
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.TrustedPeers,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
@@ -239,9 +250,14 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		return nil
 	}
+	checkpointInitializer, err := checkpointcmd.FlagOptions(ctx)
+	if err != nil {
+		return err
+	}
 	opts := []node.Option{
 		node.WithBlockchainFlagOptions(blockchainFlagOpts),
 		node.WithPowchainFlagOptions(powchainFlagOpts),
+		node.WithCheckpointInitializer(checkpointInitializer),
 	}
 	beacon, err := node.New(ctx, opts...)
 	if err != nil {
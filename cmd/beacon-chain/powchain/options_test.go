@@ -2,9 +2,13 @@ package powchaincmd
 
 import (
 	"flag"
+	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -38,3 +42,69 @@ func TestPowchainPreregistration_EmptyWeb3Provider(t *testing.T) {
 	parseHttpEndpoints(ctx)
 	assert.LogsContain(t, hook, "No ETH1 node specified to run with the beacon node")
 }
+
+func TestParseJWTSecretFromFile(t *testing.T) {
+	t.Run("no flag value specified leads to nil secret", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionJWTSecretFlag.Name, "", "")
+		ctx := cli.NewContext(&app, set, nil)
+		secret, err := parseJWTSecretFromFile(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, []byte(nil), secret)
+	})
+	t.Run("flag specified but no file found", func(t *testing.T) {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionJWTSecretFlag.Name, "/tmp/askdjkajsd", "")
+		ctx := cli.NewContext(&app, set, nil)
+		_, err := parseJWTSecretFromFile(ctx)
+		require.ErrorContains(t, "no such file", err)
+	})
+	t.Run("empty string in file", func(t *testing.T) {
+		fullPath := filepath.Join(t.TempDir(), "foohex")
+		require.NoError(t, file.WriteFile(fullPath, []byte{}))
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
+		ctx := cli.NewContext(&app, set, nil)
+		_, err := parseJWTSecretFromFile(ctx)
+		require.ErrorContains(t, "provided JWT secret in file is empty", err)
+	})
+	t.Run("less than 32 bytes", func(t *testing.T) {
+		fullPath := filepath.Join(t.TempDir(), "foohex")
+		secret := bytesutil.PadTo([]byte("foo"), 31)
+		hexData := fmt.Sprintf("%#x", secret)
+		require.NoError(t, file.WriteFile(fullPath, []byte(hexData)))
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
+		ctx := cli.NewContext(&app, set, nil)
+		_, err := parseJWTSecretFromFile(ctx)
+		require.ErrorContains(t, "should be a hex string of at least 32 bytes", err)
+	})
+	t.Run("bad data", func(t *testing.T) {
+		fullPath := filepath.Join(t.TempDir(), "foohex")
+		secret := []byte("foo")
+		require.NoError(t, file.WriteFile(fullPath, secret))
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
+		ctx := cli.NewContext(&app, set, nil)
+		_, err := parseJWTSecretFromFile(ctx)
+		require.ErrorContains(t, "invalid byte", err)
+	})
+	t.Run("correct format", func(t *testing.T) {
+		fullPath := filepath.Join(t.TempDir(), "foohex")
+		secret := bytesutil.ToBytes32([]byte("foo"))
+		secretHex := fmt.Sprintf("%#x", secret)
+		require.NoError(t, file.WriteFile(fullPath, []byte(secretHex+"\n")))
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
+		ctx := cli.NewContext(&app, set, nil)
+		got, err := parseJWTSecretFromFile(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, secret[:], got)
+	})
+}
@@ -114,6 +114,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.MaxUploadRate,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
@@ -122,7 +123,14 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.CheckpointSyncUrl,
+			flags.CheckpointState,
+			flags.CheckpointBlock,
 			flags.MinPeersPerSubnet,
+			flags.GossipTraceFile,
+			flags.GossipTraceMaxSize,
+			flags.PruneHistory,
+			flags.PruneHistoryEpochs,
 		},
 	},
 	{
@@ -132,6 +140,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.TerminalBlockHashOverride,
 			flags.TerminalBlockHashActivationEpochOverride,
 			flags.FeeRecipient,
+			flags.ExecutionJWTSecretFlag,
 		},
 	},
 	{
@@ -146,6 +155,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
 		},
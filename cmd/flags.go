@@ -94,6 +94,11 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies a set of peers to connect to explicitly and exempt from scoring and pruning.
+	TrustedPeers = &cli.StringSliceFlag{
+		Name:  "trusted-peer",
+		Usage: "Connect with this peer, and keep it connected regardless of its score. This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
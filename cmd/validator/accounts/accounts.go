@@ -139,6 +139,35 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "threshold-keygen",
+			Description: `splits an EIP-2335 keystore into shares for threshold signing, writing the share of each ` +
+				`signer to its own directory to be imported in the signer's threshold wallet`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.KeystorePathFlag,
+				flags.AccountPasswordFileFlag,
+				flags.ThresholdFlag,
+				flags.NumSharesFlag,
+				flags.ThresholdSharesDirFlag,
+				features.Mainnet,
+				features.PyrmontTestnet,
+				features.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				features.ConfigureValidator(cliCtx)
+				if err := accounts.ThresholdKeygenCli(cliCtx); err != nil {
+					log.Fatalf("Could not split keystore: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
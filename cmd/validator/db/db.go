@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/runtime/tos"
 	validatordb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
@@ -31,6 +32,23 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "audit",
+			Description: `shows the entries of the signing audit log of the validator database, the most recent first`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.AuditLogPublicKeyFlag,
+				flags.AuditLogStartSlotFlag,
+				flags.AuditLogEndSlotFlag,
+				flags.AuditLogLimitFlag,
+			}),
+			Action: func(cliCtx *cli.Context) error {
+				if err := validatordb.Audit(cliCtx); err != nil {
+					log.Fatalf("Could not read signing audit log: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "migrate",
 			Category: "db",
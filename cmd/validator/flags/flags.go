@@ -38,6 +38,13 @@ var (
 		Usage: "Beacon node RPC gateway provider endpoint",
 		Value: "127.0.0.1:3500",
 	}
+	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
+	BeaconRESTApiProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint, e.g. http://127.0.0.1:3500. If set, the validator " +
+			"client talks to the beacon node over the standard beacon API instead of Prysm gRPC, which " +
+			"allows running against any beacon node implementation",
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -267,10 +274,79 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the URL of the web3signer holding the validator keys.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-url",
+		Usage: "URL of the web3signer holding the validator keys, enabling the web3signer keymanager instead of the wallet",
+		Value: "",
+	}
+	// Web3SignerPublicValidatorKeysFlag defines the public keys of the validators signing with the web3signer.
+	Web3SignerPublicValidatorKeysFlag = &cli.StringSliceFlag{
+		Name: "validators-external-signer-public-keys",
+		Usage: "Comma-separated list of the hex encoded public keys of the validators signing with the web3signer, " +
+			"or a URL returning them, which is then polled for changes",
+	}
+	// Web3SignerPublicKeysPollIntervalFlag defines how often the public keys are fetched again from the URL.
+	Web3SignerPublicKeysPollIntervalFlag = &cli.DurationFlag{
+		Name:  "validators-external-signer-public-keys-poll-interval",
+		Usage: "How often the public keys are fetched again when --validators-external-signer-public-keys is a URL",
+		Value: time.Minute,
+	}
+	// Web3SignerRemoteKeysFileFlag defines the file the keys imported through the remote keys API are persisted to.
+	Web3SignerRemoteKeysFileFlag = &cli.StringFlag{
+		Name: "validators-external-signer-remote-keys-file",
+		Usage: "The path to the file the public keys imported through the remote keys API are persisted to, " +
+			"remote-keys.json in the wallet directory by default",
+		Value: "",
+	}
+	// ThresholdPeersFlag defines the URLs of the signers holding the other shares of the keys of
+	// a threshold wallet.
+	ThresholdPeersFlag = &cli.StringSliceFlag{
+		Name:  "threshold-peers",
+		Usage: "Comma-separated list of the URLs of the signers holding the other shares of the keys of a threshold wallet",
+	}
+	// ThresholdListenAddressFlag defines the address on which a threshold wallet serves the partial
+	// signatures of its shares to its peers.
+	ThresholdListenAddressFlag = &cli.StringFlag{
+		Name:  "threshold-listen-address",
+		Usage: "Host:port on which a threshold wallet serves the partial signatures of its shares to its peers",
+		Value: "",
+	}
+	// ThresholdAuthTokenFileFlag defines the file holding the token the signers of a threshold wallet
+	// authenticate to each other with.
+	ThresholdAuthTokenFileFlag = &cli.StringFlag{
+		Name: "threshold-auth-token-file",
+		Usage: "Path to a file holding the bearer token shared by the signers of a threshold wallet, required to " +
+			"serve partial signatures with --threshold-listen-address",
+		Value: "",
+	}
+	// ThresholdSharesDirFlag defines the directory of the share files written by the threshold keygen
+	// command, and imported in a threshold wallet.
+	ThresholdSharesDirFlag = &cli.StringFlag{
+		Name:  "threshold-shares-dir",
+		Usage: "Directory of the key share files written by the threshold keygen command, and imported on threshold wallet creation",
+		Value: "",
+	}
+	// KeystorePathFlag defines the path of the EIP-2335 keystore to split into shares.
+	KeystorePathFlag = &cli.StringFlag{
+		Name:  "keystore-path",
+		Usage: "Path to the EIP-2335 keystore to split into shares",
+		Value: "",
+	}
+	// ThresholdFlag defines the number of shares needed to sign with a split key.
+	ThresholdFlag = &cli.Uint64Flag{
+		Name:  "threshold",
+		Usage: "Number of shares needed to sign with a split key",
+	}
+	// NumSharesFlag defines the number of shares a key is split into.
+	NumSharesFlag = &cli.Uint64Flag{
+		Name:  "num-shares",
+		Usage: "Number of shares to split a key into",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote or threshold, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation userprompt for sending a deposit to the deposit contract.
@@ -294,8 +370,68 @@ var (
 	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
-		Name:  "graffiti-file",
-		Usage: "The path to a YAML file with graffiti values",
+		Name: "graffiti-file",
+		Usage: "The path to a YAML file with graffiti values, which may contain the {index}, {pubkey}, {version}, " +
+			"{execution_client} and {epoch} placeholders. The file is reloaded when it changes",
+	}
+	// ProposerSettingsFileFlag specifies the file path to load the proposer settings of each validator from.
+	ProposerSettingsFileFlag = &cli.StringFlag{
+		Name: "proposer-settings-file",
+		Usage: "The path to a JSON or YAML file with the fee recipient of each validator, keyed by public key, " +
+			"and a default fee recipient for the validators which are not listed",
+	}
+	// SuggestedFeeRecipientFlag defines the fee recipient of the validators without one in the proposer settings file.
+	SuggestedFeeRecipientFlag = &cli.StringFlag{
+		Name: "suggested-fee-recipient",
+		Usage: "The address which receives the transaction fees of blocks proposed by the validators which have no " +
+			"fee recipient in the proposer settings file",
+	}
+	// SigningPolicyFileFlag specifies the file path to load the signing policy of each validator from.
+	SigningPolicyFileFlag = &cli.StringFlag{
+		Name: "signing-policy-file",
+		Usage: "The path to a JSON or YAML file with the signing policy of each validator, keyed by public key, " +
+			"such as how many slots ahead objects may be signed. Every signature is checked against the policy, " +
+			"and its refusals are recorded in the validator database",
+	}
+	// EnableSigningAuditLogFlag enables the audit log of every signing request and broadcast in the validator database.
+	EnableSigningAuditLogFlag = &cli.BoolFlag{
+		Name: "enable-signing-audit-log",
+		Usage: "Records every signing request, with its signature domain, signing root, slot, keymanager and outcome, " +
+			"and every broadcast of a signed object in an append-only audit log in the validator database",
+	}
+	// SigningAuditLogRetentionFlag defines how long the entries of the signing audit log are kept.
+	SigningAuditLogRetentionFlag = &cli.DurationFlag{
+		Name:  "signing-audit-log-retention",
+		Usage: "How long the entries of the signing audit log are kept before they are pruned, 0 keeping them forever",
+		Value: 30 * 24 * time.Hour,
+	}
+	// AuditLogPublicKeyFlag selects the entries of a public key from the signing audit log.
+	AuditLogPublicKeyFlag = &cli.StringFlag{
+		Name:  "public-key",
+		Usage: "Hex encoded public key of the validator whose signing audit log entries are shown, all validators if empty",
+	}
+	// AuditLogStartSlotFlag selects the entries of the signing audit log from a slot.
+	AuditLogStartSlotFlag = &cli.Uint64Flag{
+		Name:  "start-slot",
+		Usage: "Shows the signing audit log entries of objects from this slot",
+	}
+	// AuditLogEndSlotFlag selects the entries of the signing audit log up to a slot.
+	AuditLogEndSlotFlag = &cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "Shows the signing audit log entries of objects up to this slot, included, 0 meaning no upper bound",
+	}
+	// AuditLogLimitFlag defines how many entries of the signing audit log are shown.
+	AuditLogLimitFlag = &cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum number of signing audit log entries shown, the latest slots first, 0 meaning no limit",
+		Value: 100,
+	}
+	// DoppelgangerEpochsFlag defines the number of epochs in which a newly added key must be seen inactive before it signs.
+	DoppelgangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-epochs",
+		Usage: "The number of epochs in which the liveness of a newly added validator key is watched before it is " +
+			"allowed to sign, when doppelganger protection is enabled with --enable-doppelganger",
+		Value: 2,
 	}
 	// EnableDutyCountDown enables more verbose logging for counting down to duty.
 	EnableDutyCountDown = &cli.BoolFlag{
@@ -48,6 +48,7 @@ func startNode(ctx *cli.Context) error {
 
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BeaconRESTApiProviderFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
@@ -72,7 +73,17 @@ var appFlags = []cli.Flag{
 	flags.WalletPasswordFileFlag,
 	flags.WalletDirFlag,
 	flags.EnableWebFlag,
+	flags.Web3SignerURLFlag,
+	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerPublicKeysPollIntervalFlag,
+	flags.Web3SignerRemoteKeysFileFlag,
 	flags.GraffitiFileFlag,
+	flags.ProposerSettingsFileFlag,
+	flags.SuggestedFeeRecipientFlag,
+	flags.SigningPolicyFileFlag,
+	flags.EnableSigningAuditLogFlag,
+	flags.SigningAuditLogRetentionFlag,
+	flags.DoppelgangerEpochsFlag,
 	flags.EnableDutyCountDown,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
@@ -85,6 +85,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BeaconRESTApiProviderFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
@@ -104,7 +105,17 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisableAccountMetricsFlag,
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
+			flags.Web3SignerURLFlag,
+			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerPublicKeysPollIntervalFlag,
+			flags.Web3SignerRemoteKeysFileFlag,
 			flags.GraffitiFileFlag,
+			flags.ProposerSettingsFileFlag,
+			flags.SuggestedFeeRecipientFlag,
+			flags.SigningPolicyFileFlag,
+			flags.EnableSigningAuditLogFlag,
+			flags.SigningAuditLogRetentionFlag,
+			flags.DoppelgangerEpochsFlag,
 			flags.EnableDutyCountDown,
 		},
 	},
@@ -21,7 +21,7 @@ var Commands = &cli.Command{
 		{
 			Name: "create",
 			Usage: "creates a new wallet with a desired type of keymanager: " +
-				"either on-disk (imported), derived, or using remote credentials",
+				"either on-disk (imported), derived, using remote credentials, or threshold signing",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.KeymanagerKindFlag,
@@ -30,6 +30,11 @@ var Commands = &cli.Command{
 				flags.RemoteSignerCertPathFlag,
 				flags.RemoteSignerKeyPathFlag,
 				flags.RemoteSignerCACertPathFlag,
+				flags.ThresholdPeersFlag,
+				flags.ThresholdListenAddressFlag,
+				flags.ThresholdAuthTokenFileFlag,
+				flags.ThresholdSharesDirFlag,
+				flags.AccountPasswordFileFlag,
 				flags.WalletPasswordFileFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
@@ -53,6 +53,7 @@ type Flags struct {
 	EnableGetBlockOptimizations         bool // EnableGetBlockOptimizations optimizes some elements of the GetBlock() function.
 	EnableBatchVerification             bool // EnableBatchVerification enables batch signature verification on gossip messages.
 	EnableBalanceTrieComputation        bool // EnableBalanceTrieComputation enables our beacon state to use balance tries for hash tree root operations.
+	EnableForkChoiceDoublyLinkedTree    bool // EnableForkChoiceDoublyLinkedTree uses the doubly linked tree fork choice store instead of proto array.
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 
@@ -228,6 +229,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		logEnabled(enableBalanceTrieComputation)
 		cfg.EnableBalanceTrieComputation = true
 	}
+	if ctx.Bool(enableForkChoiceDoublyLinkedTree.Name) {
+		logEnabled(enableForkChoiceDoublyLinkedTree)
+		cfg.EnableForkChoiceDoublyLinkedTree = true
+	}
 	Init(cfg)
 }
 
@@ -139,6 +139,10 @@ var (
 		Name:  "enable-batch-gossip-verification",
 		Usage: "This enables batch verification of signatures received over gossip.",
 	}
+	enableForkChoiceDoublyLinkedTree = &cli.BoolFlag{
+		Name:  "enable-forkchoice-doubly-linked-tree",
+		Usage: "Enables the experimental doubly linked tree fork choice store in place of proto array.",
+	}
 	enableBalanceTrieComputation = &cli.BoolFlag{
 		Name:  "enable-balance-trie-computation",
 		Usage: "This enables optimized hash tree root operations for our balance field.",
@@ -198,6 +202,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	disableActiveBalanceCache,
 	enableBatchGossipVerification,
 	enableBalanceTrieComputation,
+	enableForkChoiceDoublyLinkedTree,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
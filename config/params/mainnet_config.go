@@ -110,6 +110,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	Eth1FollowDistance:               2048,
 	SafeSlotsToUpdateJustified:       8,
 
+	// Fork choice parameters.
+	ProposerScoreBoost: 40,
+	IntervalsPerSlot:   3,
+
 	// Ethereum PoW parameters.
 	DepositChainID:         1, // Chain ID of eth1 mainnet.
 	DepositNetworkID:       1, // Network ID of eth1 mainnet.
@@ -239,4 +243,5 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 
 	// Merge
 	TerminalBlockHashActivationEpoch: math.MaxUint64,
+	SafeSlotsToImportOptimistically:  128,
 }
@@ -0,0 +1,88 @@
+package herumi
+
+import (
+	"math"
+
+	"github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+)
+
+// SplitSecretKey splits a serialized secret key into shares with Shamir's secret sharing. The share
+// of index i, starting at 1, is the evaluation at i of a random polynomial of degree threshold-1
+// whose constant term is the secret key.
+func SplitSecretKey(secretKey []byte, threshold, numShares uint64) ([][]byte, error) {
+	if numShares > math.MaxInt64 {
+		return nil, errors.New("too many shares")
+	}
+	var sk bls.SecretKey
+	if err := sk.Deserialize(secretKey); err != nil {
+		return nil, errors.Wrap(err, "could not deserialize secret key")
+	}
+	coefficients := make([]bls.Fr, threshold)
+	coefficients[0] = *bls.CastFromSecretKey(&sk)
+	for i := uint64(1); i < threshold; i++ {
+		coefficients[i].SetByCSPRNG()
+	}
+	shares := make([][]byte, numShares)
+	for i := range shares {
+		var x, y bls.Fr
+		x.SetInt64(int64(i + 1))
+		if err := bls.FrEvaluatePolynomial(&y, coefficients, &x); err != nil {
+			return nil, errors.Wrap(err, "could not evaluate polynomial")
+		}
+		shares[i] = bls.CastToSecretKey(&y).Serialize()
+	}
+	return shares, nil
+}
+
+// RecoverSignature interpolates the signature of a secret key from the serialized signatures of
+// its shares, signatures[i] being the signature of the share of index indices[i].
+func RecoverSignature(signatures [][]byte, indices []uint64) ([]byte, error) {
+	if len(signatures) != len(indices) {
+		return nil, errors.New("number of signatures and indices do not match")
+	}
+	points := make([]bls.G2, len(signatures))
+	for i, s := range signatures {
+		var sig bls.Sign
+		if err := sig.Deserialize(s); err != nil {
+			return nil, errors.Wrap(err, "could not deserialize signature")
+		}
+		points[i] = *bls.CastFromSign(&sig)
+	}
+	coefficients, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	var out bls.G2
+	bls.G2MulVec(&out, points, coefficients)
+	return bls.CastToSign(&out).Serialize(), nil
+}
+
+// lagrangeCoefficients evaluates the Lagrange basis polynomials of the indices at 0, that is
+// l_i(0) = prod_{j != i} x_j / (x_j - x_i).
+func lagrangeCoefficients(indices []uint64) ([]bls.Fr, error) {
+	xs := make([]bls.Fr, len(indices))
+	for i, idx := range indices {
+		if idx == 0 || idx > math.MaxInt64 {
+			return nil, errors.Errorf("invalid share index %d", idx)
+		}
+		xs[i].SetInt64(int64(idx))
+	}
+	coefficients := make([]bls.Fr, len(xs))
+	for i := range xs {
+		coefficients[i].SetInt64(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			if xs[i].IsEqual(&xs[j]) {
+				return nil, errors.Errorf("duplicate share index %d", indices[i])
+			}
+			var diff, term bls.Fr
+			bls.FrSub(&diff, &xs[j], &xs[i])
+			bls.FrDiv(&term, &xs[j], &diff)
+			bls.FrMul(&coefficients[i], &coefficients[i], &term)
+		}
+	}
+	return coefficients, nil
+}
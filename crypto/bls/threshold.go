@@ -0,0 +1,41 @@
+package bls
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/crypto/bls/herumi"
+)
+
+// SplitSecretKey splits a secret key into numShares shares, of which any threshold can produce a
+// signature of the secret key with RecoverSignature. The shares have the indices 1 to numShares.
+func SplitSecretKey(secretKey SecretKey, threshold, numShares uint64) ([]SecretKey, error) {
+	if threshold == 0 || threshold > numShares {
+		return nil, errors.Errorf("threshold must be between 1 and the number of shares %d, got %d", numShares, threshold)
+	}
+	rawShares, err := herumi.SplitSecretKey(secretKey.Marshal(), threshold, numShares)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]SecretKey, len(rawShares))
+	for i, raw := range rawShares {
+		shares[i], err = SecretKeyFromBytes(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert share %d", i+1)
+		}
+	}
+	return shares, nil
+}
+
+// RecoverSignature combines the signatures of at least threshold shares of a secret key into the
+// signature of the secret key, by Lagrange interpolation. The signature sigs[i] must have been
+// produced by the share of index shareIndices[i].
+func RecoverSignature(sigs []Signature, shareIndices []uint64) (Signature, error) {
+	rawSigs := make([][]byte, len(sigs))
+	for i, sig := range sigs {
+		rawSigs[i] = sig.Marshal()
+	}
+	raw, err := herumi.RecoverSignature(rawSigs, shareIndices)
+	if err != nil {
+		return nil, err
+	}
+	return SignatureFromBytes(raw)
+}
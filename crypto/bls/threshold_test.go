@@ -0,0 +1,60 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	sk, err := RandKey()
+	require.NoError(t, err)
+	msg := []byte("hello")
+	shares, err := SplitSecretKey(sk, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	sigs := make([]Signature, len(shares))
+	for i, share := range shares {
+		sigs[i] = share.Sign(msg)
+	}
+	want := sk.Sign(msg).Marshal()
+
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 3, 1}, {2, 4, 5}, {1, 2, 3, 4, 5}} {
+		subset := make([]Signature, len(indices))
+		for i, idx := range indices {
+			subset[i] = sigs[idx-1]
+		}
+		got, err := RecoverSignature(subset, indices)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, got.Marshal())
+		assert.Equal(t, true, got.Verify(sk.PublicKey(), msg))
+	}
+
+	// Fewer signatures than the threshold give a different signature.
+	got, err := RecoverSignature(sigs[:2], []uint64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, false, got.Verify(sk.PublicKey(), msg))
+}
+
+func TestSplitSecretKey_InvalidThreshold(t *testing.T) {
+	sk, err := RandKey()
+	require.NoError(t, err)
+	_, err = SplitSecretKey(sk, 0, 3)
+	require.ErrorContains(t, "threshold must be between 1 and the number of shares 3, got 0", err)
+	_, err = SplitSecretKey(sk, 4, 3)
+	require.ErrorContains(t, "threshold must be between 1 and the number of shares 3, got 4", err)
+}
+
+func TestRecoverSignature_InvalidIndices(t *testing.T) {
+	sk, err := RandKey()
+	require.NoError(t, err)
+	sig := sk.Sign([]byte("hello"))
+	_, err = RecoverSignature([]Signature{sig, sig}, []uint64{1, 1})
+	require.ErrorContains(t, "duplicate share index 1", err)
+	_, err = RecoverSignature([]Signature{sig}, []uint64{0})
+	require.ErrorContains(t, "invalid share index 0", err)
+	_, err = RecoverSignature([]Signature{sig}, []uint64{1, 2})
+	require.ErrorContains(t, "number of signatures and indices do not match", err)
+}
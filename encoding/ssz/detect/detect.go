@@ -0,0 +1,129 @@
+// Package detect identifies the fork of SSZ encoded beacon states and blocks, so that
+// they can be unmarshaled without knowing their fork beforehand.
+package detect
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/time/slots"
+)
+
+const (
+	// The fields preceding the fork's current version in a beacon state are the genesis time,
+	// the genesis validators root, the slot and the fork's previous version.
+	stateForkCurrentVersionOffset = 8 + 32 + 8 + 4
+	stateForkCurrentVersionLength = 4
+	// The fields preceding a block's slot in a signed beacon block are the offset
+	// of the block and the signature.
+	signedBlockSlotOffset = 4 + 96
+	signedBlockSlotLength = 8
+)
+
+var errTooShort = errors.New("marshaled value is too short")
+
+// StateFork returns the fork of an SSZ encoded beacon state, identified by the current
+// version of the state's fork in the beacon chain config.
+func StateFork(marshaled []byte) (int, error) {
+	if len(marshaled) < stateForkCurrentVersionOffset+stateForkCurrentVersionLength {
+		return 0, errors.Wrap(errTooShort, "could not read state fork version")
+	}
+	cv := marshaled[stateForkCurrentVersionOffset : stateForkCurrentVersionOffset+stateForkCurrentVersionLength]
+	cfg := params.BeaconConfig()
+	switch {
+	case bytes.Equal(cv, cfg.GenesisForkVersion):
+		return version.Phase0, nil
+	case bytes.Equal(cv, cfg.AltairForkVersion):
+		return version.Altair, nil
+	case bytes.Equal(cv, cfg.BellatrixForkVersion):
+		return version.Bellatrix, nil
+	default:
+		return 0, errors.Errorf("state fork version %#x is unknown to the %s config", cv, cfg.ConfigName)
+	}
+}
+
+// BlockFork returns the fork of an SSZ encoded signed beacon block, which is the fork
+// scheduled at the block's slot in the beacon chain config.
+func BlockFork(marshaled []byte) (int, error) {
+	if len(marshaled) < signedBlockSlotOffset+signedBlockSlotLength {
+		return 0, errors.Wrap(errTooShort, "could not read block slot")
+	}
+	slot := types.Slot(bytesutil.FromBytes8(marshaled[signedBlockSlotOffset : signedBlockSlotOffset+signedBlockSlotLength]))
+	epoch := slots.ToEpoch(slot)
+	cfg := params.BeaconConfig()
+	switch {
+	case epoch >= cfg.BellatrixForkEpoch:
+		return version.Bellatrix, nil
+	case epoch >= cfg.AltairForkEpoch:
+		return version.Altair, nil
+	default:
+		return version.Phase0, nil
+	}
+}
+
+// UnmarshalState unmarshals an SSZ encoded beacon state of any fork.
+func UnmarshalState(marshaled []byte) (state.BeaconState, error) {
+	fork, err := StateFork(marshaled)
+	if err != nil {
+		return nil, err
+	}
+	switch fork {
+	case version.Phase0:
+		st := &ethpb.BeaconState{}
+		if err := st.UnmarshalSSZ(marshaled); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal phase0 state")
+		}
+		return v1.InitializeFromProtoUnsafe(st)
+	case version.Altair:
+		st := &ethpb.BeaconStateAltair{}
+		if err := st.UnmarshalSSZ(marshaled); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal altair state")
+		}
+		return v2.InitializeFromProtoUnsafe(st)
+	default:
+		st := &ethpb.BeaconStateBellatrix{}
+		if err := st.UnmarshalSSZ(marshaled); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal bellatrix state")
+		}
+		return v3.InitializeFromProtoUnsafe(st)
+	}
+}
+
+// UnmarshalBlock unmarshals an SSZ encoded signed beacon block of any fork.
+func UnmarshalBlock(marshaled []byte) (block.SignedBeaconBlock, error) {
+	fork, err := BlockFork(marshaled)
+	if err != nil {
+		return nil, err
+	}
+	switch fork {
+	case version.Phase0:
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := blk.UnmarshalSSZ(marshaled); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal phase0 block")
+		}
+		return wrapper.WrappedPhase0SignedBeaconBlock(blk), nil
+	case version.Altair:
+		blk := &ethpb.SignedBeaconBlockAltair{}
+		if err := blk.UnmarshalSSZ(marshaled); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal altair block")
+		}
+		return wrapper.WrappedAltairSignedBeaconBlock(blk)
+	default:
+		blk := &ethpb.SignedBeaconBlockMerge{}
+		if err := blk.UnmarshalSSZ(marshaled); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal bellatrix block")
+		}
+		return wrapper.WrappedMergeSignedBeaconBlock(blk)
+	}
+}
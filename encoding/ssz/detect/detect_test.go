@@ -0,0 +1,118 @@
+package detect
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestUnmarshalState(t *testing.T) {
+	ctx := context.Background()
+	phase0State, _ := util.DeterministicGenesisState(t, 4)
+	altairState, _ := util.DeterministicGenesisStateAltair(t, 4)
+	require.NoError(t, altairState.SetFork(&ethpb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+	}))
+	bellatrixState, _ := util.DeterministicGenesisStateMerge(t, 4)
+	require.NoError(t, bellatrixState.SetFork(&ethpb.Fork{
+		PreviousVersion: params.BeaconConfig().AltairForkVersion,
+		CurrentVersion:  params.BeaconConfig().BellatrixForkVersion,
+	}))
+
+	for _, st := range []state.BeaconState{phase0State, altairState, bellatrixState} {
+		marshaled, err := st.MarshalSSZ()
+		require.NoError(t, err)
+		fork, err := StateFork(marshaled)
+		require.NoError(t, err)
+		assert.Equal(t, st.Version(), fork)
+
+		unmarshaled, err := UnmarshalState(marshaled)
+		require.NoError(t, err)
+		assert.Equal(t, st.Version(), unmarshaled.Version())
+		want, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		got, err := unmarshaled.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestUnmarshalState_UnknownFork(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 4)
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		PreviousVersion: []byte{0xff, 0xff, 0xff, 0xff},
+		CurrentVersion:  []byte{0xff, 0xff, 0xff, 0xff},
+	}))
+	marshaled, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	_, err = UnmarshalState(marshaled)
+	require.ErrorContains(t, "state fork version 0xffffffff is unknown", err)
+
+	_, err = UnmarshalState([]byte{1, 2, 3})
+	require.ErrorContains(t, "marshaled value is too short", err)
+}
+
+func TestUnmarshalBlock(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	cfg.BellatrixForkEpoch = 2
+	params.OverrideBeaconConfig(cfg)
+
+	phase0Block := wrapper.WrappedPhase0SignedBeaconBlock(util.NewBeaconBlock())
+	altair := util.NewBeaconBlockAltair()
+	altair.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	altairBlock, err := wrapper.WrappedAltairSignedBeaconBlock(altair)
+	require.NoError(t, err)
+	bellatrix := util.NewBeaconBlockMerge()
+	bellatrix.Block.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	bellatrixBlock, err := wrapper.WrappedMergeSignedBeaconBlock(bellatrix)
+	require.NoError(t, err)
+
+	for _, blk := range []block.SignedBeaconBlock{phase0Block, altairBlock, bellatrixBlock} {
+		marshaled, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		unmarshaled, err := UnmarshalBlock(marshaled)
+		require.NoError(t, err)
+		assert.Equal(t, blk.Version(), unmarshaled.Version())
+		assert.Equal(t, blk.Block().Slot(), unmarshaled.Block().Slot())
+		want, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		got, err := unmarshaled.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestBlockFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	cfg.BellatrixForkEpoch = 2
+	params.OverrideBeaconConfig(cfg)
+
+	blk := util.NewBeaconBlock()
+	for slot, want := range map[types.Slot]int{
+		0:                                       version.Phase0,
+		params.BeaconConfig().SlotsPerEpoch:     version.Altair,
+		2 * params.BeaconConfig().SlotsPerEpoch: version.Bellatrix,
+	} {
+		blk.Block.Slot = slot
+		marshaled, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		fork, err := BlockFork(marshaled)
+		require.NoError(t, err)
+		assert.Equal(t, want, fork)
+	}
+}
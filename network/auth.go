@@ -0,0 +1,42 @@
+package network
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/pkg/errors"
+)
+
+// This authorization method is used by the engine API of execution clients,
+// which authenticate every request with a short-lived JWT token signed with
+// a secret shared between the consensus and execution clients.
+type jwtTransport struct {
+	underlyingTransport http.RoundTripper
+	jwtSecret           []byte
+}
+
+// RoundTrip signs a fresh JWT token containing the current time as the
+// issued-at claim and injects it into the request's authorization header.
+func (t *jwtTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat": time.Now().Unix(),
+	})
+	tokenString, err := token.SignedString(t.jwtSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not produce signed JWT token")
+	}
+	req.Header.Set("Authorization", "Bearer "+tokenString)
+	return t.underlyingTransport.RoundTrip(req)
+}
+
+// NewHttpClientWithJWTSecret returns an HTTP client which authenticates
+// every request it sends with a JWT token signed by the given secret.
+func NewHttpClientWithJWTSecret(secret []byte) *http.Client {
+	return &http.Client{
+		Transport: &jwtTransport{
+			underlyingTransport: http.DefaultTransport,
+			jwtSecret:           secret,
+		},
+	}
+}
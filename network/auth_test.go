@@ -0,0 +1,43 @@
+package network
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestNewHttpClientWithJWTSecret(t *testing.T) {
+	secret := []byte("this-is-a-32-byte-long-jwt-secre")
+	var received string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := NewHttpClientWithJWTSecret(secret)
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	require.Equal(t, true, strings.HasPrefix(received, "Bearer "))
+	token, err := jwt.Parse(strings.TrimPrefix(received, "Bearer "), func(token *jwt.Token) (interface{}, error) {
+		return secret, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, token.Valid)
+	claims, ok := token.Claims.(jwt.MapClaims)
+	require.Equal(t, true, ok)
+	_, ok = claims["iat"]
+	assert.Equal(t, true, ok)
+
+	_, err = jwt.Parse(strings.TrimPrefix(received, "Bearer "), func(token *jwt.Token) (interface{}, error) {
+		return []byte("wrong secret"), nil
+	})
+	assert.ErrorContains(t, "signature is invalid", err)
+}
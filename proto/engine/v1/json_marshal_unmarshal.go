@@ -0,0 +1,256 @@
+package enginev1
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// PayloadIDBytes defines a custom type for the payload ID used by the
+// execution engine to identify a payload which is being built.
+type PayloadIDBytes [8]byte
+
+// MarshalJSON --
+func (b PayloadIDBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutil.Bytes(b[:]))
+}
+
+// UnmarshalJSON --
+func (b *PayloadIDBytes) UnmarshalJSON(enc []byte) error {
+	var dec hexutil.Bytes
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	if len(dec) != len(b) {
+		return errors.Errorf("payload id is %d bytes, expected %d", len(dec), len(b))
+	}
+	copy(b[:], dec)
+	return nil
+}
+
+type executionBlockJSON struct {
+	Hash            common.Hash    `json:"hash"`
+	ParentHash      common.Hash    `json:"parentHash"`
+	Number          hexutil.Uint64 `json:"number"`
+	Timestamp       hexutil.Uint64 `json:"timestamp"`
+	Difficulty      *hexutil.Big   `json:"difficulty"`
+	TotalDifficulty *hexutil.Big   `json:"totalDifficulty"`
+}
+
+// MarshalJSON defines a custom json.Marshaler interface implementation
+// that uses custom json.Marshalers for the hexutil.Bytes and hexutil.Uint64 types.
+func (e *ExecutionBlock) MarshalJSON() ([]byte, error) {
+	return json.Marshal(executionBlockJSON{
+		Hash:            common.BytesToHash(e.BlockHash),
+		ParentHash:      common.BytesToHash(e.ParentHash),
+		Number:          hexutil.Uint64(e.Number),
+		Timestamp:       hexutil.Uint64(e.Timestamp),
+		Difficulty:      (*hexutil.Big)(new(big.Int).SetBytes(e.Difficulty)),
+		TotalDifficulty: (*hexutil.Big)(new(big.Int).SetBytes(e.TotalDifficulty)),
+	})
+}
+
+// UnmarshalJSON defines a custom json.Unmarshaler interface implementation
+// that uses custom json.Unmarshalers for the hexutil.Bytes and hexutil.Uint64 types.
+func (e *ExecutionBlock) UnmarshalJSON(enc []byte) error {
+	dec := executionBlockJSON{}
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	*e = ExecutionBlock{}
+	e.BlockHash = dec.Hash.Bytes()
+	e.ParentHash = dec.ParentHash.Bytes()
+	e.Number = uint64(dec.Number)
+	e.Timestamp = uint64(dec.Timestamp)
+	if dec.Difficulty != nil {
+		e.Difficulty = dec.Difficulty.ToInt().Bytes()
+	}
+	if dec.TotalDifficulty != nil {
+		e.TotalDifficulty = dec.TotalDifficulty.ToInt().Bytes()
+	}
+	return nil
+}
+
+type payloadAttributesJSON struct {
+	Timestamp             hexutil.Uint64 `json:"timestamp"`
+	Random                hexutil.Bytes  `json:"random"`
+	SuggestedFeeRecipient hexutil.Bytes  `json:"suggestedFeeRecipient"`
+}
+
+// MarshalJSON --
+func (p *PayloadAttributes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(payloadAttributesJSON{
+		Timestamp:             hexutil.Uint64(p.Timestamp),
+		Random:                p.Random,
+		SuggestedFeeRecipient: p.SuggestedFeeRecipient,
+	})
+}
+
+// UnmarshalJSON --
+func (p *PayloadAttributes) UnmarshalJSON(enc []byte) error {
+	dec := payloadAttributesJSON{}
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	*p = PayloadAttributes{}
+	p.Timestamp = uint64(dec.Timestamp)
+	p.Random = dec.Random
+	p.SuggestedFeeRecipient = dec.SuggestedFeeRecipient
+	return nil
+}
+
+type payloadStatusJSON struct {
+	LatestValidHash *common.Hash `json:"latestValidHash"`
+	Status          string       `json:"status"`
+	ValidationError *string      `json:"validationError"`
+}
+
+// MarshalJSON --
+func (p *PayloadStatus) MarshalJSON() ([]byte, error) {
+	var hash *common.Hash
+	if p.LatestValidHash != nil {
+		h := common.BytesToHash(p.LatestValidHash)
+		hash = &h
+	}
+	var validationErr *string
+	if p.ValidationError != "" {
+		validationErr = &p.ValidationError
+	}
+	return json.Marshal(payloadStatusJSON{
+		LatestValidHash: hash,
+		Status:          p.Status.String(),
+		ValidationError: validationErr,
+	})
+}
+
+// UnmarshalJSON --
+func (p *PayloadStatus) UnmarshalJSON(enc []byte) error {
+	dec := payloadStatusJSON{}
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	*p = PayloadStatus{}
+	if dec.LatestValidHash != nil {
+		p.LatestValidHash = dec.LatestValidHash.Bytes()
+	}
+	if dec.ValidationError != nil {
+		p.ValidationError = *dec.ValidationError
+	}
+	p.Status = PayloadStatus_Status(PayloadStatus_Status_value[dec.Status])
+	return nil
+}
+
+type forkchoiceStateJSON struct {
+	HeadBlockHash      hexutil.Bytes `json:"headBlockHash"`
+	SafeBlockHash      hexutil.Bytes `json:"safeBlockHash"`
+	FinalizedBlockHash hexutil.Bytes `json:"finalizedBlockHash"`
+}
+
+// MarshalJSON --
+func (f *ForkchoiceState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(forkchoiceStateJSON{
+		HeadBlockHash:      f.HeadBlockHash,
+		SafeBlockHash:      f.SafeBlockHash,
+		FinalizedBlockHash: f.FinalizedBlockHash,
+	})
+}
+
+// UnmarshalJSON --
+func (f *ForkchoiceState) UnmarshalJSON(enc []byte) error {
+	dec := forkchoiceStateJSON{}
+	if err := json.Unmarshal(enc, &dec); err != nil {
+		return err
+	}
+	*f = ForkchoiceState{}
+	f.HeadBlockHash = dec.HeadBlockHash
+	f.SafeBlockHash = dec.SafeBlockHash
+	f.FinalizedBlockHash = dec.FinalizedBlockHash
+	return nil
+}
+
+// ExecutionPayloadJSON is the JSON representation of an execution payload used by the
+// engine API, where numeric fields are hex encoded quantities.
+type ExecutionPayloadJSON struct {
+	ParentHash    common.Hash     `json:"parentHash"`
+	FeeRecipient  common.Address  `json:"feeRecipient"`
+	StateRoot     common.Hash     `json:"stateRoot"`
+	ReceiptsRoot  common.Hash     `json:"receiptsRoot"`
+	LogsBloom     hexutil.Bytes   `json:"logsBloom"`
+	Random        common.Hash     `json:"random"`
+	BlockNumber   hexutil.Uint64  `json:"blockNumber"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	ExtraData     hexutil.Bytes   `json:"extraData"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlockHash     common.Hash     `json:"blockHash"`
+	Transactions  []hexutil.Bytes `json:"transactions"`
+}
+
+// NewExecutionPayloadJSON converts an execution payload into its engine API JSON representation.
+// The base fee per gas is stored little-endian in the beacon chain and sent as a big-endian
+// quantity to the execution engine.
+func NewExecutionPayloadJSON(p *ethpb.ExecutionPayload) *ExecutionPayloadJSON {
+	transactions := make([]hexutil.Bytes, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		transactions[i] = tx
+	}
+	baseFee := new(big.Int).SetBytes(bytesutil.ReverseByteOrder(p.BaseFeePerGas))
+	return &ExecutionPayloadJSON{
+		ParentHash:    common.BytesToHash(p.ParentHash),
+		FeeRecipient:  common.BytesToAddress(p.FeeRecipient),
+		StateRoot:     common.BytesToHash(p.StateRoot),
+		ReceiptsRoot:  common.BytesToHash(p.ReceiptRoot),
+		LogsBloom:     p.LogsBloom,
+		Random:        common.BytesToHash(p.Random),
+		BlockNumber:   hexutil.Uint64(p.BlockNumber),
+		GasLimit:      hexutil.Uint64(p.GasLimit),
+		GasUsed:       hexutil.Uint64(p.GasUsed),
+		Timestamp:     hexutil.Uint64(p.Timestamp),
+		ExtraData:     p.ExtraData,
+		BaseFeePerGas: (*hexutil.Big)(baseFee),
+		BlockHash:     common.BytesToHash(p.BlockHash),
+		Transactions:  transactions,
+	}
+}
+
+// ToProto converts the engine API JSON representation of a payload back into
+// an execution payload as it is stored in a beacon block.
+func (j *ExecutionPayloadJSON) ToProto() (*ethpb.ExecutionPayload, error) {
+	if j.BaseFeePerGas == nil {
+		return nil, errors.New("missing base fee per gas")
+	}
+	baseFee := j.BaseFeePerGas.ToInt()
+	if baseFee.Sign() < 0 || baseFee.BitLen() > 256 {
+		return nil, errors.New("base fee per gas does not fit in 32 bytes")
+	}
+	transactions := make([][]byte, len(j.Transactions))
+	for i, tx := range j.Transactions {
+		transactions[i] = tx
+	}
+	extraData := j.ExtraData
+	if extraData == nil {
+		extraData = []byte{}
+	}
+	return &ethpb.ExecutionPayload{
+		ParentHash:    j.ParentHash.Bytes(),
+		FeeRecipient:  j.FeeRecipient.Bytes(),
+		StateRoot:     j.StateRoot.Bytes(),
+		ReceiptRoot:   j.ReceiptsRoot.Bytes(),
+		LogsBloom:     bytesutil.PadTo(j.LogsBloom, 256),
+		Random:        j.Random.Bytes(),
+		BlockNumber:   uint64(j.BlockNumber),
+		GasLimit:      uint64(j.GasLimit),
+		GasUsed:       uint64(j.GasUsed),
+		Timestamp:     uint64(j.Timestamp),
+		ExtraData:     extraData,
+		BaseFeePerGas: bytesutil.ReverseByteOrder(baseFee.FillBytes(make([]byte, 32))),
+		BlockHash:     j.BlockHash.Bytes(),
+		Transactions:  transactions,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package enginev1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestJsonMarshalUnmarshal(t *testing.T) {
+	t.Run("payload attributes", func(t *testing.T) {
+		want := &enginev1.PayloadAttributes{
+			Timestamp:             1,
+			Random:                bytesutil.PadTo([]byte("random"), 32),
+			SuggestedFeeRecipient: bytesutil.PadTo([]byte("fee"), 20),
+		}
+		enc, err := json.Marshal(want)
+		require.NoError(t, err)
+		got := &enginev1.PayloadAttributes{}
+		require.NoError(t, json.Unmarshal(enc, got))
+		require.DeepEqual(t, want, got)
+	})
+	t.Run("payload status", func(t *testing.T) {
+		want := &enginev1.PayloadStatus{
+			Status:          enginev1.PayloadStatus_INVALID,
+			LatestValidHash: bytesutil.PadTo([]byte("valid"), 32),
+			ValidationError: "failed validation",
+		}
+		enc, err := json.Marshal(want)
+		require.NoError(t, err)
+		got := &enginev1.PayloadStatus{}
+		require.NoError(t, json.Unmarshal(enc, got))
+		require.DeepEqual(t, want, got)
+	})
+	t.Run("forkchoice state", func(t *testing.T) {
+		want := &enginev1.ForkchoiceState{
+			HeadBlockHash:      bytesutil.PadTo([]byte("head"), 32),
+			SafeBlockHash:      bytesutil.PadTo([]byte("safe"), 32),
+			FinalizedBlockHash: bytesutil.PadTo([]byte("finalized"), 32),
+		}
+		enc, err := json.Marshal(want)
+		require.NoError(t, err)
+		got := &enginev1.ForkchoiceState{}
+		require.NoError(t, json.Unmarshal(enc, got))
+		require.DeepEqual(t, want, got)
+	})
+	t.Run("execution block", func(t *testing.T) {
+		enc := []byte(`{"hash":"0x0100000000000000000000000000000000000000000000000000000000000000",` +
+			`"parentHash":"0x0200000000000000000000000000000000000000000000000000000000000000",` +
+			`"number":"0x10","timestamp":"0x20","difficulty":"0x1","totalDifficulty":"0x100","miner":"0x00"}`)
+		got := &enginev1.ExecutionBlock{}
+		require.NoError(t, json.Unmarshal(enc, got))
+		require.Equal(t, uint64(16), got.Number)
+		require.Equal(t, uint64(32), got.Timestamp)
+		require.DeepEqual(t, []byte{1}, got.Difficulty)
+		require.DeepEqual(t, []byte{1, 0}, got.TotalDifficulty)
+		require.DeepEqual(t, bytesutil.PadTo([]byte{1}, 32), got.BlockHash)
+		require.DeepEqual(t, bytesutil.PadTo([]byte{2}, 32), got.ParentHash)
+	})
+	t.Run("payload id", func(t *testing.T) {
+		id := enginev1.PayloadIDBytes{1, 2, 3, 4, 5, 6, 7, 8}
+		enc, err := json.Marshal(id)
+		require.NoError(t, err)
+		require.Equal(t, `"0x0102030405060708"`, string(enc))
+		got := enginev1.PayloadIDBytes{}
+		require.NoError(t, json.Unmarshal(enc, &got))
+		require.DeepEqual(t, id, got)
+		require.ErrorContains(t, "payload id is 2 bytes", json.Unmarshal([]byte(`"0x0102"`), &got))
+	})
+	t.Run("execution payload", func(t *testing.T) {
+		want := &ethpb.ExecutionPayload{
+			ParentHash:    bytesutil.PadTo([]byte("parent"), 32),
+			FeeRecipient:  bytesutil.PadTo([]byte("fee"), 20),
+			StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+			ReceiptRoot:   bytesutil.PadTo([]byte("receipts"), 32),
+			LogsBloom:     bytesutil.PadTo([]byte("logs"), 256),
+			Random:        bytesutil.PadTo([]byte("random"), 32),
+			BlockNumber:   1,
+			GasLimit:      2,
+			GasUsed:       3,
+			Timestamp:     4,
+			ExtraData:     []byte{},
+			BaseFeePerGas: bytesutil.PadTo([]byte{7, 1}, 32),
+			BlockHash:     bytesutil.PadTo([]byte("hash"), 32),
+			Transactions:  [][]byte{[]byte("tx")},
+		}
+		enc, err := json.Marshal(enginev1.NewExecutionPayloadJSON(want))
+		require.NoError(t, err)
+		dec := &enginev1.ExecutionPayloadJSON{}
+		require.NoError(t, json.Unmarshal(enc, dec))
+		require.Equal(t, "0x107", dec.BaseFeePerGas.String())
+		got, err := dec.ToProto()
+		require.NoError(t, err)
+		require.DeepEqual(t, want, got)
+	})
+}
@@ -53,7 +53,7 @@ var file_proto_eth_service_beacon_debug_service_proto_rawDesc = []byte{
 	0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
 	0x32, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf8, 0x05, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x44,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xff, 0x06, 0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x44,
 	0x65, 0x62, 0x75, 0x67, 0x12, 0x8e, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63,
 	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
 	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
@@ -100,28 +100,39 @@ var file_proto_eth_service_beacon_debug_service_proto_rawDesc = []byte{
 	0x68, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x69, 0x6e, 0x74,
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x73, 0x42,
-	0x95, 0x01, 0x0a, 0x18, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x17, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74,
-	0x68, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xaa, 0x02, 0x14, 0x45, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0xca, 0x02, 0x14, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x67, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x68, 0x65, 0x61, 0x64, 0x73, 0x12,
+	0x84, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x12, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x12, 0x22, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x65, 0x74,
+	0x68, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x66, 0x6f, 0x72, 0x6b, 0x5f,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x95, 0x01, 0x0a, 0x18, 0x6f, 0x72, 0x67, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x42, 0x17, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0xaa, 0x02, 0x14, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xca, 0x02, 0x14, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_proto_eth_service_beacon_debug_service_proto_goTypes = []interface{}{
 	(*v1.StateRequest)(nil),             // 0: ethereum.eth.v1.StateRequest
 	(*v2.StateRequestV2)(nil),           // 1: ethereum.eth.v2.StateRequestV2
 	(*empty.Empty)(nil),                 // 2: google.protobuf.Empty
-	(*v1.BeaconStateResponse)(nil),      // 3: ethereum.eth.v1.BeaconStateResponse
-	(*v1.BeaconStateSSZResponse)(nil),   // 4: ethereum.eth.v1.BeaconStateSSZResponse
-	(*v2.BeaconStateResponseV2)(nil),    // 5: ethereum.eth.v2.BeaconStateResponseV2
-	(*v2.BeaconStateSSZResponseV2)(nil), // 6: ethereum.eth.v2.BeaconStateSSZResponseV2
-	(*v1.ForkChoiceHeadsResponse)(nil),  // 7: ethereum.eth.v1.ForkChoiceHeadsResponse
+	(*v1.ForkChoiceRequest)(nil),        // 3: ethereum.eth.v1.ForkChoiceRequest
+	(*v1.BeaconStateResponse)(nil),      // 4: ethereum.eth.v1.BeaconStateResponse
+	(*v1.BeaconStateSSZResponse)(nil),   // 5: ethereum.eth.v1.BeaconStateSSZResponse
+	(*v2.BeaconStateResponseV2)(nil),    // 6: ethereum.eth.v2.BeaconStateResponseV2
+	(*v2.BeaconStateSSZResponseV2)(nil), // 7: ethereum.eth.v2.BeaconStateSSZResponseV2
+	(*v1.ForkChoiceHeadsResponse)(nil),  // 8: ethereum.eth.v1.ForkChoiceHeadsResponse
+	(*v1.ForkChoiceResponse)(nil),       // 9: ethereum.eth.v1.ForkChoiceResponse
 }
 var file_proto_eth_service_beacon_debug_service_proto_depIdxs = []int32{
 	0, // 0: ethereum.eth.service.BeaconDebug.GetBeaconState:input_type -> ethereum.eth.v1.StateRequest
@@ -129,13 +140,15 @@ var file_proto_eth_service_beacon_debug_service_proto_depIdxs = []int32{
 	1, // 2: ethereum.eth.service.BeaconDebug.GetBeaconStateV2:input_type -> ethereum.eth.v2.StateRequestV2
 	1, // 3: ethereum.eth.service.BeaconDebug.GetBeaconStateSSZV2:input_type -> ethereum.eth.v2.StateRequestV2
 	2, // 4: ethereum.eth.service.BeaconDebug.ListForkChoiceHeads:input_type -> google.protobuf.Empty
-	3, // 5: ethereum.eth.service.BeaconDebug.GetBeaconState:output_type -> ethereum.eth.v1.BeaconStateResponse
-	4, // 6: ethereum.eth.service.BeaconDebug.GetBeaconStateSSZ:output_type -> ethereum.eth.v1.BeaconStateSSZResponse
-	5, // 7: ethereum.eth.service.BeaconDebug.GetBeaconStateV2:output_type -> ethereum.eth.v2.BeaconStateResponseV2
-	6, // 8: ethereum.eth.service.BeaconDebug.GetBeaconStateSSZV2:output_type -> ethereum.eth.v2.BeaconStateSSZResponseV2
-	7, // 9: ethereum.eth.service.BeaconDebug.ListForkChoiceHeads:output_type -> ethereum.eth.v1.ForkChoiceHeadsResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
+	3, // 5: ethereum.eth.service.BeaconDebug.GetForkChoice:input_type -> ethereum.eth.v1.ForkChoiceRequest
+	4, // 6: ethereum.eth.service.BeaconDebug.GetBeaconState:output_type -> ethereum.eth.v1.BeaconStateResponse
+	5, // 7: ethereum.eth.service.BeaconDebug.GetBeaconStateSSZ:output_type -> ethereum.eth.v1.BeaconStateSSZResponse
+	6, // 8: ethereum.eth.service.BeaconDebug.GetBeaconStateV2:output_type -> ethereum.eth.v2.BeaconStateResponseV2
+	7, // 9: ethereum.eth.service.BeaconDebug.GetBeaconStateSSZV2:output_type -> ethereum.eth.v2.BeaconStateSSZResponseV2
+	8, // 10: ethereum.eth.service.BeaconDebug.ListForkChoiceHeads:output_type -> ethereum.eth.v1.ForkChoiceHeadsResponse
+	9, // 11: ethereum.eth.service.BeaconDebug.GetForkChoice:output_type -> ethereum.eth.v1.ForkChoiceResponse
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -182,6 +195,7 @@ type BeaconDebugClient interface {
 	GetBeaconStateV2(ctx context.Context, in *v2.StateRequestV2, opts ...grpc.CallOption) (*v2.BeaconStateResponseV2, error)
 	GetBeaconStateSSZV2(ctx context.Context, in *v2.StateRequestV2, opts ...grpc.CallOption) (*v2.BeaconStateSSZResponseV2, error)
 	ListForkChoiceHeads(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.ForkChoiceHeadsResponse, error)
+	GetForkChoice(ctx context.Context, in *v1.ForkChoiceRequest, opts ...grpc.CallOption) (*v1.ForkChoiceResponse, error)
 }
 
 type beaconDebugClient struct {
@@ -237,6 +251,15 @@ func (c *beaconDebugClient) ListForkChoiceHeads(ctx context.Context, in *empty.E
 	return out, nil
 }
 
+func (c *beaconDebugClient) GetForkChoice(ctx context.Context, in *v1.ForkChoiceRequest, opts ...grpc.CallOption) (*v1.ForkChoiceResponse, error) {
+	out := new(v1.ForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.service.BeaconDebug/GetForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BeaconDebugServer is the server API for BeaconDebug service.
 type BeaconDebugServer interface {
 	GetBeaconState(context.Context, *v1.StateRequest) (*v1.BeaconStateResponse, error)
@@ -244,6 +267,7 @@ type BeaconDebugServer interface {
 	GetBeaconStateV2(context.Context, *v2.StateRequestV2) (*v2.BeaconStateResponseV2, error)
 	GetBeaconStateSSZV2(context.Context, *v2.StateRequestV2) (*v2.BeaconStateSSZResponseV2, error)
 	ListForkChoiceHeads(context.Context, *empty.Empty) (*v1.ForkChoiceHeadsResponse, error)
+	GetForkChoice(context.Context, *v1.ForkChoiceRequest) (*v1.ForkChoiceResponse, error)
 }
 
 // UnimplementedBeaconDebugServer can be embedded to have forward compatible implementations.
@@ -265,6 +289,9 @@ func (*UnimplementedBeaconDebugServer) GetBeaconStateSSZV2(context.Context, *v2.
 func (*UnimplementedBeaconDebugServer) ListForkChoiceHeads(context.Context, *empty.Empty) (*v1.ForkChoiceHeadsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListForkChoiceHeads not implemented")
 }
+func (*UnimplementedBeaconDebugServer) GetForkChoice(context.Context, *v1.ForkChoiceRequest) (*v1.ForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForkChoice not implemented")
+}
 
 func RegisterBeaconDebugServer(s *grpc.Server, srv BeaconDebugServer) {
 	s.RegisterService(&_BeaconDebug_serviceDesc, srv)
@@ -360,6 +387,24 @@ func _BeaconDebug_ListForkChoiceHeads_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconDebug_GetForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ForkChoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconDebugServer).GetForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.service.BeaconDebug/GetForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconDebugServer).GetForkChoice(ctx, req.(*v1.ForkChoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BeaconDebug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.eth.service.BeaconDebug",
 	HandlerType: (*BeaconDebugServer)(nil),
@@ -384,6 +429,10 @@ var _BeaconDebug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListForkChoiceHeads",
 			Handler:    _BeaconDebug_ListForkChoiceHeads_Handler,
 		},
+		{
+			MethodName: "GetForkChoice",
+			Handler:    _BeaconDebug_GetForkChoice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/eth/service/beacon_debug_service.proto",
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.15.8
+// source: proto/prysm/v1alpha1/peer_reputation.proto
+
+package eth
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type PeerReputation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerId           string  `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Address          string  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Enr              []byte  `protobuf:"bytes,3,opt,name=enr,proto3" json:"enr,omitempty"`
+	BadResponses     uint64  `protobuf:"varint,4,opt,name=bad_responses,json=badResponses,proto3" json:"bad_responses,omitempty"`
+	ProcessedBlocks  uint64  `protobuf:"varint,5,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
+	GossipScore      float64 `protobuf:"fixed64,6,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
+	BehaviourPenalty float64 `protobuf:"fixed64,7,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	SavedAt          int64   `protobuf:"varint,8,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+}
+
+func (x *PeerReputation) Reset() {
+	*x = PeerReputation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_peer_reputation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReputation) ProtoMessage() {}
+
+func (x *PeerReputation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_peer_reputation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReputation.ProtoReflect.Descriptor instead.
+func (*PeerReputation) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeerReputation) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *PeerReputation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerReputation) GetEnr() []byte {
+	if x != nil {
+		return x.Enr
+	}
+	return nil
+}
+
+func (x *PeerReputation) GetBadResponses() uint64 {
+	if x != nil {
+		return x.BadResponses
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetProcessedBlocks() uint64 {
+	if x != nil {
+		return x.ProcessedBlocks
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetGossipScore() float64 {
+	if x != nil {
+		return x.GossipScore
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetBehaviourPenalty() float64 {
+	if x != nil {
+		return x.BehaviourPenalty
+	}
+	return 0
+}
+
+func (x *PeerReputation) GetSavedAt() int64 {
+	if x != nil {
+		return x.SavedAt
+	}
+	return 0
+}
+
+var File_proto_prysm_v1alpha1_peer_reputation_proto protoreflect.FileDescriptor
+
+var file_proto_prysm_v1alpha1_peer_reputation_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x65, 0x6e, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x62, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x5f, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x75, 0x72, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x61, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
+	0x61, 0x76, 0x65, 0x64, 0x41, 0x74, 0x42, 0x9b, 0x01, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x42, 0x13, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69,
+	0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x3b, 0x65, 0x74, 0x68, 0xaa, 0x02, 0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x45, 0x74, 0x68, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x15, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescOnce sync.Once
+	file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescData = file_proto_prysm_v1alpha1_peer_reputation_proto_rawDesc
+)
+
+func file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescGZIP() []byte {
+	file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescOnce.Do(func() {
+		file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescData)
+	})
+	return file_proto_prysm_v1alpha1_peer_reputation_proto_rawDescData
+}
+
+var file_proto_prysm_v1alpha1_peer_reputation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_prysm_v1alpha1_peer_reputation_proto_goTypes = []interface{}{
+	(*PeerReputation)(nil), // 0: ethereum.eth.v1alpha1.PeerReputation
+}
+var file_proto_prysm_v1alpha1_peer_reputation_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_prysm_v1alpha1_peer_reputation_proto_init() }
+func file_proto_prysm_v1alpha1_peer_reputation_proto_init() {
+	if File_proto_prysm_v1alpha1_peer_reputation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_prysm_v1alpha1_peer_reputation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReputation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_prysm_v1alpha1_peer_reputation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_prysm_v1alpha1_peer_reputation_proto_goTypes,
+		DependencyIndexes: file_proto_prysm_v1alpha1_peer_reputation_proto_depIdxs,
+		MessageInfos:      file_proto_prysm_v1alpha1_peer_reputation_proto_msgTypes,
+	}.Build()
+	File_proto_prysm_v1alpha1_peer_reputation_proto = out.File
+	file_proto_prysm_v1alpha1_peer_reputation_proto_rawDesc = nil
+	file_proto_prysm_v1alpha1_peer_reputation_proto_goTypes = nil
+	file_proto_prysm_v1alpha1_peer_reputation_proto_depIdxs = nil
+}
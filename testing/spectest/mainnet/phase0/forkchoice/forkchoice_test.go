@@ -0,0 +1,12 @@
+package forkchoice
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/spectest/shared/common/forkchoice"
+)
+
+func TestMainnet_Phase0_Forkchoice(t *testing.T) {
+	forkchoice.Run(t, "mainnet", version.Phase0)
+}
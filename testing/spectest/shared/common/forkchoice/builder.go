@@ -0,0 +1,112 @@
+package forkchoice
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// Builder drives a blockchain service through the steps of a fork choice spec test.
+type Builder struct {
+	service     *blockchain.Service
+	genesisTime int64
+	lastTick    int64
+}
+
+// NewBuilder starts a blockchain service from the anchor state and block of a fork choice
+// spec test, with its clock set to the start of the anchor slot.
+func NewBuilder(t testing.TB, initialState state.BeaconState, initialBlock block.SignedBeaconBlock) *Builder {
+	service := startChainService(t, initialState, initialBlock)
+	bb := &Builder{
+		service:     service,
+		genesisTime: int64(initialState.GenesisTime()),
+	}
+	bb.Tick(t, bb.genesisTime+int64(uint64(initialState.Slot())*params.BeaconConfig().SecondsPerSlot))
+	return bb
+}
+
+// Tick moves the clock of the service to the given store time, resetting the proposer boost
+// when a new slot starts.
+func (bb *Builder) Tick(t testing.TB, tick int64) {
+	previousSlot := bb.slotAt(bb.lastTick)
+	bb.service.SetGenesisTime(time.Unix(time.Now().Unix()-(tick-bb.genesisTime), 0))
+	if bb.slotAt(tick) > previousSlot {
+		require.NoError(t, bb.service.ForkChoicer().ResetBoostedProposerRoot(context.Background()))
+	}
+	bb.lastTick = tick
+}
+
+// ValidBlock receives a block which is expected to be imported.
+func (bb *Builder) ValidBlock(t testing.TB, b block.SignedBeaconBlock) {
+	r, err := b.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, bb.service.ReceiveBlock(context.Background(), b, r))
+}
+
+// InvalidBlock receives a block which is expected to be rejected.
+func (bb *Builder) InvalidBlock(t testing.TB, b block.SignedBeaconBlock) {
+	r, err := b.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NotNil(t, bb.service.ReceiveBlock(context.Background(), b, r))
+}
+
+// Attestation receives an attestation which is expected to be applied to fork choice.
+func (bb *Builder) Attestation(t testing.TB, a *ethpb.Attestation) {
+	require.NoError(t, bb.service.ReceiveAttestationNoPubsub(context.Background(), a))
+}
+
+// InvalidAttestation receives an attestation which is expected to be rejected.
+func (bb *Builder) InvalidAttestation(t testing.TB, a *ethpb.Attestation) {
+	require.NotNil(t, bb.service.ReceiveAttestationNoPubsub(context.Background(), a))
+}
+
+// Check compares the fork choice store of the service with the expected one.
+func (bb *Builder) Check(t testing.TB, c *Check) {
+	if c == nil {
+		return
+	}
+	ctx := context.Background()
+	if c.Head != nil {
+		require.NoError(t, bb.service.UpdateHeadWithBalances(ctx))
+		r, err := bb.service.HeadRoot(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, fromHex(t, c.Head.Root), r, "head root mismatch")
+		require.Equal(t, types.Slot(c.Head.Slot), bb.service.HeadSlot(), "head slot mismatch")
+	}
+	if c.Time != nil {
+		require.Equal(t, int64(*c.Time), bb.lastTick, "store time mismatch")
+	}
+	if c.JustifiedCheckPoint != nil {
+		cp := bb.service.CurrentJustifiedCheckpt()
+		require.Equal(t, types.Epoch(c.JustifiedCheckPoint.Epoch), cp.Epoch, "justified epoch mismatch")
+		require.DeepEqual(t, fromHex(t, c.JustifiedCheckPoint.Root), cp.Root, "justified root mismatch")
+	}
+	if c.FinalizedCheckPoint != nil {
+		cp := bb.service.FinalizedCheckpt()
+		require.Equal(t, types.Epoch(c.FinalizedCheckPoint.Epoch), cp.Epoch, "finalized epoch mismatch")
+		require.DeepEqual(t, fromHex(t, c.FinalizedCheckPoint.Root), cp.Root, "finalized root mismatch")
+	}
+	if c.ProposerBoostRoot != nil {
+		r := bb.service.ForkChoicer().ProposerBoost()
+		require.DeepEqual(t, fromHex(t, *c.ProposerBoostRoot), r[:], "proposer boost root mismatch")
+	}
+}
+
+func (bb *Builder) slotAt(tick int64) types.Slot {
+	return types.Slot(uint64(tick-bb.genesisTime) / params.BeaconConfig().SecondsPerSlot)
+}
+
+func fromHex(t testing.TB, s string) []byte {
+	b, err := hex.DecodeString(s[2:])
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,172 @@
+package forkchoice
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	v3 "github.com/prysmaticlabs/prysm/beacon-chain/state/v3"
+	"github.com/prysmaticlabs/prysm/config/features"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func init() {
+	transition.SkipSlotCache.Disable()
+}
+
+// testTypes are the fork choice test handlers run against the fork choice store. The
+// on_merge_block handler is not run as it needs a proof-of-work chain.
+var testTypes = []string{"get_head", "on_block"}
+
+// Run executes the fork choice spec tests of a fork against every fork choice store implementation.
+func Run(t *testing.T, config string, fork int) {
+	backends := []struct {
+		name  string
+		flags *features.Flags
+	}{
+		{name: "ProtoArray", flags: &features.Flags{}},
+		{name: "DoublyLinkedTree", flags: &features.Flags{EnableForkChoiceDoublyLinkedTree: true}},
+	}
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			resetCfg := features.InitWithReset(backend.flags)
+			defer resetCfg()
+			for _, testType := range testTypes {
+				runTest(t, config, fork, testType)
+			}
+		})
+	}
+}
+
+func runTest(t *testing.T, config string, fork int, testType string) {
+	require.NoError(t, utils.SetConfig(t, config))
+	testFolders, testsFolderPath := utils.TestFolders(t, config, version.String(fork), path.Join("fork_choice", testType, "pyspec_tests"))
+
+	for _, folder := range testFolders {
+		t.Run(path.Join(testType, folder.Name()), func(t *testing.T) {
+			helpers.ClearCache()
+			file, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "steps.yaml")
+			require.NoError(t, err)
+			var steps []Step
+			require.NoError(t, utils.UnmarshalYaml(file, &steps))
+
+			anchorState := unmarshalState(t, fork, snappyFile(t, testsFolderPath, folder.Name(), "anchor_state.ssz_snappy"))
+			anchorBlock := unmarshalBlock(t, fork, snappyFile(t, testsFolderPath, folder.Name(), "anchor_block.ssz_snappy"))
+			builder := NewBuilder(t, anchorState, anchorBlock)
+
+			for _, step := range steps {
+				if step.Tick != nil {
+					builder.Tick(t, int64(*step.Tick))
+				}
+				if step.Block != nil {
+					blk := unmarshalSignedBlock(t, fork, snappyFile(t, testsFolderPath, folder.Name(), fmt.Sprint(*step.Block, ".ssz_snappy")))
+					if step.Valid != nil && !*step.Valid {
+						builder.InvalidBlock(t, blk)
+					} else {
+						builder.ValidBlock(t, blk)
+					}
+				}
+				if step.Attestation != nil {
+					att := &ethpb.Attestation{}
+					require.NoError(t, att.UnmarshalSSZ(snappyFile(t, testsFolderPath, folder.Name(), fmt.Sprint(*step.Attestation, ".ssz_snappy"))), "Failed to unmarshal")
+					if step.Valid != nil && !*step.Valid {
+						builder.InvalidAttestation(t, att)
+					} else {
+						builder.Attestation(t, att)
+					}
+				}
+				builder.Check(t, step.Check)
+			}
+		})
+	}
+}
+
+func snappyFile(t *testing.T, testsFolderPath, folder, filename string) []byte {
+	file, err := util.BazelFileBytes(testsFolderPath, folder, filename)
+	require.NoError(t, err)
+	sszBytes, err := snappy.Decode(nil /* dst */, file)
+	require.NoError(t, err, "Failed to decompress")
+	return sszBytes
+}
+
+func unmarshalState(t *testing.T, fork int, raw []byte) state.BeaconState {
+	var st state.BeaconState
+	var err error
+	switch fork {
+	case version.Phase0:
+		base := &ethpb.BeaconState{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		st, err = v1.InitializeFromProto(base)
+	case version.Altair:
+		base := &ethpb.BeaconStateAltair{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		st, err = v2.InitializeFromProto(base)
+	case version.Bellatrix:
+		base := &ethpb.BeaconStateBellatrix{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		st, err = v3.InitializeFromProto(base)
+	default:
+		t.Fatalf("unsupported fork %s", version.String(fork))
+	}
+	require.NoError(t, err)
+	return st
+}
+
+// unmarshalBlock unmarshals an anchor block, which is stored without its signature.
+func unmarshalBlock(t *testing.T, fork int, raw []byte) block.SignedBeaconBlock {
+	var blk block.SignedBeaconBlock
+	var err error
+	switch fork {
+	case version.Phase0:
+		base := &ethpb.BeaconBlock{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		blk = wrapper.WrappedPhase0SignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: base, Signature: make([]byte, 96)})
+	case version.Altair:
+		base := &ethpb.BeaconBlockAltair{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		blk, err = wrapper.WrappedAltairSignedBeaconBlock(&ethpb.SignedBeaconBlockAltair{Block: base, Signature: make([]byte, 96)})
+	case version.Bellatrix:
+		base := &ethpb.BeaconBlockMerge{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		blk, err = wrapper.WrappedMergeSignedBeaconBlock(&ethpb.SignedBeaconBlockMerge{Block: base, Signature: make([]byte, 96)})
+	default:
+		t.Fatalf("unsupported fork %s", version.String(fork))
+	}
+	require.NoError(t, err)
+	return blk
+}
+
+func unmarshalSignedBlock(t *testing.T, fork int, raw []byte) block.SignedBeaconBlock {
+	var blk block.SignedBeaconBlock
+	var err error
+	switch fork {
+	case version.Phase0:
+		base := &ethpb.SignedBeaconBlock{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		blk = wrapper.WrappedPhase0SignedBeaconBlock(base)
+	case version.Altair:
+		base := &ethpb.SignedBeaconBlockAltair{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		blk, err = wrapper.WrappedAltairSignedBeaconBlock(base)
+	case version.Bellatrix:
+		base := &ethpb.SignedBeaconBlockMerge{}
+		require.NoError(t, base.UnmarshalSSZ(raw), "Failed to unmarshal")
+		blk, err = wrapper.WrappedMergeSignedBeaconBlock(base)
+	default:
+		t.Fatalf("unsupported fork %s", version.String(fork))
+	}
+	require.NoError(t, err)
+	return blk
+}
@@ -0,0 +1,59 @@
+package forkchoice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	coreTime "github.com/prysmaticlabs/prysm/beacon-chain/core/time"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+// startChainService starts a blockchain service whose finalized and justified checkpoints are
+// the anchor block and state of a fork choice spec test.
+func startChainService(t testing.TB, st state.BeaconState, blk block.SignedBeaconBlock) *blockchain.Service {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+	r, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, r))
+	require.NoError(t, beaconDB.SaveState(ctx, st, r))
+	cp := &ethpb.Checkpoint{
+		Epoch: coreTime.CurrentEpoch(st),
+		Root:  r[:],
+	}
+	require.NoError(t, beaconDB.SaveJustifiedCheckpoint(ctx, cp))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, cp))
+
+	attService, err := attestations.NewService(ctx, &attestations.Config{Pool: attestations.NewPool()})
+	require.NoError(t, err)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+
+	opts := []blockchain.Option{
+		blockchain.WithFinalizedStateAtStartUp(st),
+		blockchain.WithDatabase(beaconDB),
+		blockchain.WithStateGen(stategen.New(beaconDB)),
+		blockchain.WithAttestationService(attService),
+		blockchain.WithAttestationPool(attestations.NewPool()),
+		blockchain.WithExitPool(voluntaryexits.NewPool()),
+		blockchain.WithSlashingPool(slashings.NewPool()),
+		blockchain.WithDepositCache(depositCache),
+		blockchain.WithStateNotifier(&mock.MockStateNotifier{}),
+	}
+	service, err := blockchain.NewService(ctx, opts...)
+	require.NoError(t, err)
+	require.NoError(t, service.StartFromSavedState(st))
+	return service
+}
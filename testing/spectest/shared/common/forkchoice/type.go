@@ -0,0 +1,33 @@
+package forkchoice
+
+// Step is a single step of a fork choice spec test, as read from steps.yaml.
+type Step struct {
+	Tick        *int    `json:"tick"`
+	Block       *string `json:"block"`
+	Valid       *bool   `json:"valid"`
+	Attestation *string `json:"attestation"`
+	PowBlock    *string `json:"pow_block"`
+	Check       *Check  `json:"checks"`
+}
+
+// Check is the expected state of the fork choice store after the steps preceding it.
+type Check struct {
+	Time                *int       `json:"time"`
+	GenesisTime         int        `json:"genesis_time"`
+	ProposerBoostRoot   *string    `json:"proposer_boost_root"`
+	Head                *SlotRoot  `json:"head"`
+	JustifiedCheckPoint *EpochRoot `json:"justified_checkpoint"`
+	FinalizedCheckPoint *EpochRoot `json:"finalized_checkpoint"`
+}
+
+// SlotRoot is a block identified by its slot and root.
+type SlotRoot struct {
+	Slot int    `json:"slot"`
+	Root string `json:"root"`
+}
+
+// EpochRoot is a checkpoint identified by its epoch and root.
+type EpochRoot struct {
+	Epoch int    `json:"epoch"`
+	Root  string `json:"root"`
+}
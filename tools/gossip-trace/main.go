@@ -0,0 +1,103 @@
+// This tool summarizes and replays a capture of the gossip messages traced by a beacon node
+// started with --gossip-trace-file.
+//
+// Usage:
+//
+//	gossip-trace -file=/path/to/trace.jsonl -command=summarize
+//	gossip-trace -file=/path/to/trace.jsonl -command=replay -topic=beacon_block -speed=1
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/gossiptrace"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	captureFile = flag.String("file", "", "Path to the gossip trace file. Its rotated files are read as well.")
+	command     = flag.String("command", "summarize", "Command to execute: summarize or replay.")
+	topic       = flag.String("topic", "", "Only consider the records of topics containing this string.")
+	speed       = flag.Float64("speed", 0, "Replay speed relative to the capture, 0 replaying as fast as possible.")
+)
+
+func main() {
+	flag.Parse()
+
+	if *captureFile == "" {
+		log.Fatal("No capture file given")
+	}
+	files := gossiptrace.Files(*captureFile)
+	if len(files) == 0 {
+		log.Fatalf("No capture file at %s", *captureFile)
+	}
+
+	switch *command {
+	case "summarize":
+		s := newSummary()
+		if err := readCapture(files, func(r *gossiptrace.Record) error {
+			s.add(r)
+			return nil
+		}); err != nil {
+			log.Fatal(err)
+		}
+		s.print(os.Stdout)
+	case "replay":
+		if err := readCapture(files, replayer(*speed)); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("Unknown command %q", *command)
+	}
+}
+
+// readCapture calls the function with the records of the capture files matching the topic filter.
+func readCapture(files []string, fn func(r *gossiptrace.Record) error) error {
+	for _, f := range files {
+		if err := gossiptrace.ReadFile(f, func(r *gossiptrace.Record) error {
+			if !strings.Contains(r.Topic, *topic) {
+				return nil
+			}
+			return fn(r)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayer returns a function printing the records, waiting between them for the time elapsed
+// between their events divided by the speed.
+func replayer(speed float64) func(r *gossiptrace.Record) error {
+	var last int64
+	return func(r *gossiptrace.Record) error {
+		if speed > 0 && last != 0 && r.Time > last {
+			time.Sleep(time.Duration(float64(r.Time-last) / speed))
+		}
+		last = r.Time
+		fmt.Println(formatRecord(r))
+		return nil
+	}
+}
+
+// formatRecord formats the record as a single line.
+func formatRecord(r *gossiptrace.Record) string {
+	line := fmt.Sprintf("%s %-13s %s id=%s", time.Unix(0, r.Time).UTC().Format(time.RFC3339Nano), r.Event, r.Topic, r.MessageID)
+	if r.Peer != "" {
+		line += " peer=" + r.Peer
+	}
+	if r.Size > 0 {
+		line += fmt.Sprintf(" size=%d", r.Size)
+	}
+	if r.Result != "" {
+		line += fmt.Sprintf(" result=%q", r.Result)
+	}
+	if r.Latency > 0 {
+		line += " latency=" + time.Duration(r.Latency).String()
+	}
+	return line
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/gossiptrace"
+)
+
+// maxSummaryPeers is the number of peers with the most rejected messages listed in a summary.
+const maxSummaryPeers = 10
+
+var summaryEvents = []string{
+	gossiptrace.EventReceived,
+	gossiptrace.EventDelivered,
+	gossiptrace.EventRejected,
+	gossiptrace.EventDuplicated,
+	gossiptrace.EventUndeliverable,
+}
+
+// summary aggregates the records of a capture.
+type summary struct {
+	records    uint64
+	first      int64
+	last       int64
+	topics     map[string]*topicSummary
+	rejections map[string]uint64
+}
+
+// topicSummary aggregates the records of a topic.
+type topicSummary struct {
+	events    map[string]uint64
+	results   map[string]uint64
+	bytes     uint64
+	latencies []time.Duration
+}
+
+func newSummary() *summary {
+	return &summary{
+		topics:     make(map[string]*topicSummary),
+		rejections: make(map[string]uint64),
+	}
+}
+
+func (s *summary) add(r *gossiptrace.Record) {
+	s.records++
+	if s.first == 0 || r.Time < s.first {
+		s.first = r.Time
+	}
+	if r.Time > s.last {
+		s.last = r.Time
+	}
+	t, ok := s.topics[r.Topic]
+	if !ok {
+		t = &topicSummary{
+			events:  make(map[string]uint64),
+			results: make(map[string]uint64),
+		}
+		s.topics[r.Topic] = t
+	}
+	t.events[r.Event]++
+	if r.Event == gossiptrace.EventReceived {
+		t.bytes += uint64(r.Size)
+	}
+	if r.Result != "" {
+		t.results[r.Result]++
+	}
+	if r.Latency > 0 {
+		t.latencies = append(t.latencies, time.Duration(r.Latency))
+	}
+	if r.Event == gossiptrace.EventRejected && r.Result != gossiptrace.ResultIgnore && r.Peer != "" {
+		s.rejections[r.Peer]++
+	}
+}
+
+func (s *summary) print(w io.Writer) {
+	if s.records == 0 {
+		fmt.Fprintln(w, "No records")
+		return
+	}
+	fmt.Fprintf(w, "%d records from %s to %s\n\n", s.records,
+		time.Unix(0, s.first).UTC().Format(time.RFC3339), time.Unix(0, s.last).UTC().Format(time.RFC3339))
+
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, "TOPIC")
+	for _, event := range summaryEvents {
+		fmt.Fprintf(tw, "\t%s", event)
+	}
+	fmt.Fprintln(tw, "\tbytes\tlatency mean\tp50\tp99")
+	for _, topic := range topics {
+		t := s.topics[topic]
+		fmt.Fprint(tw, topic)
+		for _, event := range summaryEvents {
+			fmt.Fprintf(tw, "\t%d", t.events[event])
+		}
+		mean, p50, p99 := t.latencyStats()
+		fmt.Fprintf(tw, "\t%d\t%s\t%s\t%s\n", t.bytes, mean, p50, p99)
+	}
+	if err := tw.Flush(); err != nil {
+		return
+	}
+
+	fmt.Fprintln(w, "\nResults:")
+	for _, topic := range topics {
+		t := s.topics[topic]
+		results := make([]string, 0, len(t.results))
+		for result := range t.results {
+			results = append(results, result)
+		}
+		sort.Strings(results)
+		for _, result := range results {
+			fmt.Fprintf(w, "  %s %q: %d\n", topic, result, t.results[result])
+		}
+	}
+
+	if len(s.rejections) > 0 {
+		fmt.Fprintln(w, "\nPeers with the most rejected messages:")
+		for _, p := range s.topRejectedPeers(maxSummaryPeers) {
+			fmt.Fprintf(w, "  %s: %d\n", p, s.rejections[p])
+		}
+	}
+}
+
+// topRejectedPeers returns the peers with the most rejected messages, in decreasing order.
+func (s *summary) topRejectedPeers(n int) []string {
+	peers := make([]string, 0, len(s.rejections))
+	for p := range s.rejections {
+		peers = append(peers, p)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		if s.rejections[peers[i]] == s.rejections[peers[j]] {
+			return peers[i] < peers[j]
+		}
+		return s.rejections[peers[i]] > s.rejections[peers[j]]
+	})
+	if len(peers) > n {
+		peers = peers[:n]
+	}
+	return peers
+}
+
+// latencyStats returns the mean, median and 99th percentile of the validation latencies of the topic.
+func (t *topicSummary) latencyStats() (mean, p50, p99 time.Duration) {
+	if len(t.latencies) == 0 {
+		return 0, 0, 0
+	}
+	sorted := make([]time.Duration, len(t.latencies))
+	copy(sorted, t.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total time.Duration
+	for _, l := range sorted {
+		total += l
+	}
+	return total / time.Duration(len(sorted)), percentile(sorted, 50), percentile(sorted, 99)
+}
+
+// percentile returns the p-th percentile of the sorted durations, using the nearest rank.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
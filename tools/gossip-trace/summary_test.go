@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/gossiptrace"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSummary(t *testing.T) {
+	const (
+		blockTopic = "/eth2/4a26c58b/beacon_block/ssz_snappy"
+		aggTopic   = "/eth2/4a26c58b/beacon_aggregate_and_proof/ssz_snappy"
+		peerA      = "16Uiu2HAkyKhpwhAEjKpRNdmVshJYXhKQDW9a1g2ALzCxQwW7YhfN"
+		peerB      = "16Uiu2HAkv54NSq4WcAp99M6FmEEc4MrKvNPScK9aiwsSt6i1SjfU"
+	)
+	s := newSummary()
+	for i := 1; i <= 100; i++ {
+		s.add(&gossiptrace.Record{Time: int64(i), Event: gossiptrace.EventReceived, Topic: blockTopic, Peer: peerA, Size: 10})
+		s.add(&gossiptrace.Record{
+			Time:    int64(i),
+			Event:   gossiptrace.EventDelivered,
+			Topic:   blockTopic,
+			Result:  gossiptrace.ResultAccept,
+			Latency: int64(time.Duration(i) * time.Millisecond),
+		})
+	}
+	s.add(&gossiptrace.Record{Time: 200, Event: gossiptrace.EventRejected, Topic: aggTopic, Peer: peerB, Result: gossiptrace.ResultReject})
+	s.add(&gossiptrace.Record{Time: 201, Event: gossiptrace.EventRejected, Topic: aggTopic, Peer: peerB, Result: gossiptrace.ResultReject})
+	s.add(&gossiptrace.Record{Time: 202, Event: gossiptrace.EventRejected, Topic: aggTopic, Peer: peerA, Result: gossiptrace.ResultReject})
+	s.add(&gossiptrace.Record{Time: 203, Event: gossiptrace.EventRejected, Topic: aggTopic, Peer: peerA, Result: gossiptrace.ResultIgnore})
+	s.add(&gossiptrace.Record{Time: 204, Event: gossiptrace.EventDuplicated, Topic: blockTopic, Peer: peerB})
+
+	assert.Equal(t, uint64(205), s.records)
+	assert.Equal(t, int64(1), s.first)
+	assert.Equal(t, int64(204), s.last)
+
+	block := s.topics[blockTopic]
+	require.NotNil(t, block)
+	assert.Equal(t, uint64(100), block.events[gossiptrace.EventReceived])
+	assert.Equal(t, uint64(100), block.events[gossiptrace.EventDelivered])
+	assert.Equal(t, uint64(1), block.events[gossiptrace.EventDuplicated])
+	assert.Equal(t, uint64(1000), block.bytes)
+	mean, p50, p99 := block.latencyStats()
+	assert.Equal(t, 50500*time.Microsecond, mean)
+	assert.Equal(t, 50*time.Millisecond, p50)
+	assert.Equal(t, 99*time.Millisecond, p99)
+
+	agg := s.topics[aggTopic]
+	require.NotNil(t, agg)
+	assert.Equal(t, uint64(3), agg.results[gossiptrace.ResultReject])
+	assert.Equal(t, uint64(1), agg.results[gossiptrace.ResultIgnore])
+
+	// Ignored messages are not counted against peers.
+	assert.DeepEqual(t, []string{peerB, peerA}, s.topRejectedPeers(10))
+	assert.DeepEqual(t, []string{peerB}, s.topRejectedPeers(1))
+
+	var buf bytes.Buffer
+	s.print(&buf)
+	assert.Equal(t, true, strings.Contains(buf.String(), "205 records"))
+	assert.Equal(t, true, strings.Contains(buf.String(), blockTopic))
+	assert.Equal(t, true, strings.Contains(buf.String(), peerB+": 2"))
+}
+
+func TestSummary_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	newSummary().print(&buf)
+	assert.Equal(t, "No records\n", buf.String())
+}
@@ -115,6 +115,8 @@ func BackupAccountsCli(cliCtx *cli.Context) error {
 		}
 	case keymanager.Remote:
 		return errors.New("backing up keys is not supported for a remote keymanager")
+	case keymanager.Threshold:
+		return errors.New("backing up keys is not supported for a threshold keymanager")
 	default:
 		return fmt.Errorf(errKeymanagerNotSupported, w.KeymanagerKind())
 	}
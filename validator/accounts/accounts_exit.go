@@ -9,20 +9,24 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/io/prompt"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -35,6 +39,26 @@ type PerformExitCfg struct {
 	Keymanager       keymanager.IKeymanager
 	RawPubKeys       [][]byte
 	FormattedPubKeys []string
+	// SigningPolicy, if set, must allow the exits before they are signed.
+	SigningPolicy *signingpolicy.Enforcer
+	// Confirmed is whether the user confirmed the exits, which are otherwise refused by a signing
+	// policy requiring the confirmation of exits.
+	Confirmed bool
+	// AuditLog, if set, records the signing and the broadcast of the exits.
+	AuditLog ExitAuditLog
+}
+
+// ExitAuditLog records voluntary exits in the signing audit log of the validator client.
+type ExitAuditLog interface {
+	AuditSign(ctx context.Context, req *validatorpb.SignRequest, signErr error)
+	AuditBroadcast(
+		ctx context.Context,
+		pubKey [fieldparams.BLSPubkeyLength]byte,
+		objectType string,
+		slot types.Slot,
+		signingRoot []byte,
+		broadcastErr error,
+	)
 }
 
 const exitPassphrase = "Exit my validator"
@@ -75,11 +99,14 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 	}
 
 	cfg := PerformExitCfg{
-		*validatorClient,
-		*nodeClient,
-		kManager,
-		rawPubKeys,
-		trimmedPubKeys,
+		ValidatorClient:  *validatorClient,
+		NodeClient:       *nodeClient,
+		Keymanager:       kManager,
+		RawPubKeys:       rawPubKeys,
+		FormattedPubKeys: trimmedPubKeys,
+		// The exits were confirmed with the passphrase. They are not recorded in the signing policy
+		// decisions nor the audit log, which are kept by the running validator client.
+		Confirmed: true,
 	}
 	rawExitedKeys, trimmedExitedKeys, err := PerformVoluntaryExit(cliCtx.Context, cfg)
 	if err != nil {
@@ -94,9 +121,46 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 func PerformVoluntaryExit(
 	ctx context.Context, cfg PerformExitCfg,
 ) (rawExitedKeys [][]byte, formattedExitedKeys []string, err error) {
+	signer := cfg.Keymanager.Sign
+	if cfg.SigningPolicy != nil {
+		if cfg.Confirmed {
+			ctx = signingpolicy.WithExitConfirmation(ctx)
+		}
+		signer = func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+			if err := cfg.SigningPolicy.Check(ctx, req); err != nil {
+				return nil, err
+			}
+			return cfg.Keymanager.Sign(ctx, req)
+		}
+	}
+	// signed is the last request signed, whose broadcast is recorded in the audit log.
+	var signed *validatorpb.SignRequest
+	if cfg.AuditLog != nil {
+		policySigner := signer
+		signer = func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+			sig, err := policySigner(ctx, req)
+			cfg.AuditLog.AuditSign(ctx, req, err)
+			if err == nil {
+				signed = req
+			}
+			return sig, err
+		}
+	}
 	var rawNotExitedKeys [][]byte
 	for i, key := range cfg.RawPubKeys {
-		if err := client.ProposeExit(ctx, cfg.ValidatorClient, cfg.NodeClient, cfg.Keymanager.Sign, key); err != nil {
+		signed = nil
+		err := client.ProposeExit(ctx, cfg.ValidatorClient, cfg.NodeClient, signer, key)
+		if signed != nil {
+			// The exit was signed, so an error is that of its broadcast.
+			_, slot, slotErr := signingpolicy.RequestObject(signed)
+			if slotErr != nil {
+				log.WithError(slotErr).Error("Could not determine the slot of the voluntary exit for the audit log")
+			}
+			cfg.AuditLog.AuditBroadcast(
+				ctx, bytesutil.ToBytes48(signed.PublicKey), signingpolicy.VoluntaryExitObject, slot, signed.SigningRoot, err,
+			)
+		}
+		if err != nil {
 			rawNotExitedKeys = append(rawNotExitedKeys, key)
 
 			msg := err.Error()
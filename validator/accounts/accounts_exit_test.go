@@ -2,6 +2,8 @@ package accounts
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
@@ -9,15 +11,21 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	mock2 "github.com/prysmaticlabs/prysm/testing/mock"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
+	constant "github.com/prysmaticlabs/prysm/validator/testing"
 	"github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -95,11 +103,12 @@ func TestExitAccountsCli_OK(t *testing.T) {
 	require.NotNil(t, formattedPubKeys)
 
 	cfg := PerformExitCfg{
-		mockValidatorClient,
-		mockNodeClient,
-		keymanager,
-		rawPubKeys,
-		formattedPubKeys,
+		ValidatorClient:  mockValidatorClient,
+		NodeClient:       mockNodeClient,
+		Keymanager:       keymanager,
+		RawPubKeys:       rawPubKeys,
+		FormattedPubKeys: formattedPubKeys,
+		Confirmed:        true,
 	}
 	rawExitedKeys, formattedExitedKeys, err := PerformVoluntaryExit(cliCtx.Context, cfg)
 	require.NoError(t, err)
@@ -190,11 +199,12 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 	require.NotNil(t, formattedPubKeys)
 
 	cfg := PerformExitCfg{
-		mockValidatorClient,
-		mockNodeClient,
-		keymanager,
-		rawPubKeys,
-		formattedPubKeys,
+		ValidatorClient:  mockValidatorClient,
+		NodeClient:       mockNodeClient,
+		Keymanager:       keymanager,
+		RawPubKeys:       rawPubKeys,
+		FormattedPubKeys: formattedPubKeys,
+		Confirmed:        true,
 	}
 	rawExitedKeys, formattedExitedKeys, err := PerformVoluntaryExit(cliCtx.Context, cfg)
 	require.NoError(t, err)
@@ -210,6 +220,98 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 	require.DeepEqual(t, wantedFormatted, formattedExitedKeys)
 }
 
+// exitAuditLog records the outcomes of the exits it audits.
+type exitAuditLog struct {
+	outcomes []string
+}
+
+func (l *exitAuditLog) AuditSign(_ context.Context, _ *validatorpb.SignRequest, signErr error) {
+	l.outcomes = append(l.outcomes, fmt.Sprintf("sign: %v", signErr))
+}
+
+func (l *exitAuditLog) AuditBroadcast(
+	_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, objectType string, _ types.Slot, _ []byte, broadcastErr error,
+) {
+	l.outcomes = append(l.outcomes, fmt.Sprintf("broadcast %s: %v", objectType, broadcastErr))
+}
+
+func TestPerformVoluntaryExit_SigningPolicyRefusesUnconfirmedExits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock2.NewMockBeaconNodeValidatorClient(ctrl)
+	mockNodeClient := mock2.NewMockNodeClient(ctrl)
+
+	mockValidatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(&ethpb.ValidatorIndexResponse{Index: 1}, nil)
+
+	// Any time in the past will suffice
+	genesisTime := &timestamppb.Timestamp{
+		Seconds: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+	}
+
+	mockNodeClient.EXPECT().
+		GetGenesis(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(&ethpb.Genesis{GenesisTime: genesisTime}, nil)
+
+	mockValidatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+	// Only the confirmed exit is proposed.
+	mockValidatorClient.EXPECT().
+		ProposeExit(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.SignedVoluntaryExit{})).
+		Return(&ethpb.ProposeExitResponse{}, nil)
+
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	ctx := context.Background()
+	w, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Derived,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	km, err := derived.NewKeymanager(ctx, &derived.SetupConfig{Wallet: w, ListenForChanges: false})
+	require.NoError(t, err)
+	require.NoError(t, km.RecoverAccountsFromMnemonic(ctx, constant.TestMnemonic, "", 1))
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	policy := signingpolicy.NewEnforcer(
+		&signingpolicy.Settings{DefaultConfig: signingpolicy.DefaultPolicy()}, dbtest.SetupDB(t, nil),
+	)
+	policy.SetGenesisTime(uint64(genesisTime.Seconds))
+	auditLog := &exitAuditLog{}
+	cfg := PerformExitCfg{
+		ValidatorClient:  mockValidatorClient,
+		NodeClient:       mockNodeClient,
+		Keymanager:       km,
+		RawPubKeys:       [][]byte{pubKeys[0][:]},
+		FormattedPubKeys: []string{fmt.Sprintf("%#x", bytesutil.Trunc(pubKeys[0][:]))},
+		SigningPolicy:    policy,
+		AuditLog:         auditLog,
+	}
+	rawExitedKeys, _, err := PerformVoluntaryExit(ctx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rawExitedKeys))
+
+	cfg.Confirmed = true
+	rawExitedKeys, _, err = PerformVoluntaryExit(ctx, cfg)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][]byte{pubKeys[0][:]}, rawExitedKeys)
+
+	// The refused exit is recorded in the audit log, and the confirmed one is signed and broadcast.
+	require.Equal(t, 3, len(auditLog.outcomes))
+	assert.Equal(t, "sign: voluntary exit was not confirmed: refused by signing policy", auditLog.outcomes[0])
+	assert.Equal(t, "sign: <nil>", auditLog.outcomes[1])
+	assert.Equal(t, "broadcast voluntary_exit: <nil>", auditLog.outcomes[2])
+}
+
 func TestPrepareWallet_EmptyWalletReturnsError(t *testing.T) {
 	imported.ResetCaches()
 	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
 	"github.com/urfave/cli/v2"
 )
 
@@ -73,6 +74,14 @@ func ListAccountsCli(cliCtx *cli.Context) error {
 		if err := listRemoteKeymanagerAccounts(cliCtx.Context, w, km, km.KeymanagerOpts()); err != nil {
 			return errors.Wrap(err, "could not list validator accounts with remote keymanager")
 		}
+	case keymanager.Threshold:
+		km, ok := km.(*threshold.Keymanager)
+		if !ok {
+			return errors.New("could not assert keymanager interface to concrete type")
+		}
+		if err := listThresholdKeymanagerAccounts(cliCtx.Context, w, km); err != nil {
+			return errors.Wrap(err, "could not list validator accounts with threshold keymanager")
+		}
 	default:
 		return fmt.Errorf(errKeymanagerNotSupported, w.KeymanagerKind().String())
 	}
@@ -224,6 +233,39 @@ func listRemoteKeymanagerAccounts(
 	return nil
 }
 
+func listThresholdKeymanagerAccounts(ctx context.Context, w *wallet.Wallet, km *threshold.Keymanager) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("threshold signer").Bold())
+	fmt.Printf(
+		"(configuration file path) %s\n",
+		au.BrightGreen(filepath.Join(w.AccountsDir(), wallet.KeymanagerConfigFileName)).Bold(),
+	)
+	fmt.Println(" ")
+	fmt.Printf("%s\n", au.BrightGreen("Configuration options").Bold())
+	fmt.Println(km.KeymanagerOpts())
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	for i := 0; i < len(validatingPubKeys); i++ {
+		fmt.Println("")
+		fmt.Printf(
+			"%s\n", au.BrightGreen(petnames.DeterministicName(validatingPubKeys[i][:], "-")).Bold(),
+		)
+		fmt.Printf("%s %#x\n", au.BrightCyan("[validating public key]").Bold(), validatingPubKeys[i])
+		fmt.Println(" ")
+	}
+	return nil
+}
+
 func listValidatorIndices(ctx context.Context, km keymanager.IKeymanager, client ethpb.BeaconNodeValidatorClient) error {
 	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
 	if err != nil {
@@ -0,0 +1,105 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/io/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/urfave/cli/v2"
+)
+
+// ThresholdKeygenCli splits an EIP-2335 keystore into shares for a threshold wallet. The share of
+// each signer is written to its own directory, signer-<index>, in the output directory, to be
+// imported on the creation of the threshold wallet of the signer.
+func ThresholdKeygenCli(cliCtx *cli.Context) error {
+	keystorePath := cliCtx.String(flags.KeystorePathFlag.Name)
+	if keystorePath == "" {
+		return fmt.Errorf("--%s must be specified", flags.KeystorePathFlag.Name)
+	}
+	keystorePath, err := file.ExpandPath(keystorePath)
+	if err != nil {
+		return errors.Wrapf(err, "could not determine absolute path for %s", keystorePath)
+	}
+	keystore, err := readKeystoreFile(cliCtx.Context, keystorePath)
+	if err != nil {
+		return err
+	}
+	outputDir, err := userprompt.InputDirectory(cliCtx, "Enter the directory to write the key shares to", flags.ThresholdSharesDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not get output directory")
+	}
+	var password string
+	if cliCtx.IsSet(flags.AccountPasswordFileFlag.Name) {
+		data, err := ioutil.ReadFile(cliCtx.String(flags.AccountPasswordFileFlag.Name)) // #nosec G304
+		if err != nil {
+			return err
+		}
+		password = string(data)
+	} else {
+		password, err = prompt.PasswordPrompt("Enter the password for the keystore", prompt.NotEmpty)
+		if err != nil {
+			return fmt.Errorf("could not read keystore password: %w", err)
+		}
+	}
+	shares, err := threshold.SplitKeystore(
+		keystore, password, cliCtx.Uint64(flags.ThresholdFlag.Name), cliCtx.Uint64(flags.NumSharesFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	if err := writeThresholdShares(outputDir, shares); err != nil {
+		return err
+	}
+	log.WithField("directory", outputDir).Infof(
+		"Split keystore of public key 0x%s into %d shares, encrypted with the password of the keystore",
+		keystore.Pubkey,
+		len(shares),
+	)
+	return nil
+}
+
+func writeThresholdShares(outputDir string, shares []*threshold.Share) error {
+	for _, s := range shares {
+		encoded, err := json.MarshalIndent(s, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "could not marshal share")
+		}
+		dir := filepath.Join(outputDir, fmt.Sprintf("signer-%d", s.Index))
+		if err := file.MkdirAll(dir); err != nil {
+			return errors.Wrapf(err, "could not create directory %s", dir)
+		}
+		fileName := fmt.Sprintf("share-%s.json", s.Keystore.Pubkey)
+		if err := file.WriteFile(filepath.Join(dir, fileName), encoded); err != nil {
+			return errors.Wrapf(err, "could not write share %d", s.Index)
+		}
+	}
+	return nil
+}
+
+// readThresholdShares reads the share files of a directory written by the threshold keygen command.
+func readThresholdShares(_ context.Context, dir string) ([]*threshold.Share, error) {
+	fileNames, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list share files")
+	}
+	shares := make([]*threshold.Share, len(fileNames))
+	for i, fileName := range fileNames {
+		encoded, err := ioutil.ReadFile(fileName) // #nosec G304
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read share file %s", fileName)
+		}
+		shares[i] = &threshold.Share{}
+		if err := json.Unmarshal(encoded, shares[i]); err != nil {
+			return nil, errors.Wrapf(err, "could not decode share file %s", fileName)
+		}
+	}
+	return shares, nil
+}
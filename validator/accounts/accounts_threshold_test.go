@@ -0,0 +1,104 @@
+package accounts
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	"github.com/urfave/cli/v2"
+)
+
+func TestThresholdKeygenCli_CreateWallets(t *testing.T) {
+	keysDir := t.TempDir()
+	keystore, keystorePath := createKeystore(t, keysDir)
+	_, _, passwordFile := setupWalletAndPasswordsDir(t)
+	sharesDir := filepath.Join(t.TempDir(), "shares")
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.KeystorePathFlag.Name, keystorePath, "")
+	set.String(flags.ThresholdSharesDirFlag.Name, sharesDir, "")
+	set.String(flags.AccountPasswordFileFlag.Name, passwordFile, "")
+	set.Uint64(flags.ThresholdFlag.Name, 2, "")
+	set.Uint64(flags.NumSharesFlag.Name, 3, "")
+	require.NoError(t, set.Set(flags.ThresholdSharesDirFlag.Name, sharesDir))
+	require.NoError(t, set.Set(flags.AccountPasswordFileFlag.Name, passwordFile))
+	require.NoError(t, ThresholdKeygenCli(cli.NewContext(&cli.App{}, set, nil)))
+
+	// The wallet of each signer is created with its share, which is encrypted with the password
+	// of the wallet instead of the password of the keystore.
+	walletPasswordFile := filepath.Join(t.TempDir(), "wallet-password.txt")
+	require.NoError(t, ioutil.WriteFile(walletPasswordFile, []byte("Passwordz0320$"), os.ModePerm))
+	authTokenFile := filepath.Join(t.TempDir(), "auth-token.txt")
+	require.NoError(t, ioutil.WriteFile(authTokenFile, []byte("token\n"), os.ModePerm))
+	for i := 1; i <= 3; i++ {
+		walletDir := filepath.Join(t.TempDir(), "wallet")
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.WalletDirFlag.Name, walletDir, "")
+		set.String(flags.KeymanagerKindFlag.Name, keymanager.Threshold.String(), "")
+		set.String(flags.WalletPasswordFileFlag.Name, walletPasswordFile, "")
+		set.String(flags.AccountPasswordFileFlag.Name, passwordFile, "")
+		set.String(flags.ThresholdSharesDirFlag.Name, filepath.Join(sharesDir, "signer-"+strconv.Itoa(i)), "")
+		set.Var(cli.NewStringSlice(), flags.ThresholdPeersFlag.Name, "")
+		set.String(flags.ThresholdListenAddressFlag.Name, "127.0.0.1:0", "")
+		set.String(flags.ThresholdAuthTokenFileFlag.Name, authTokenFile, "")
+		set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
+		for _, name := range []string{
+			flags.WalletDirFlag.Name,
+			flags.KeymanagerKindFlag.Name,
+			flags.WalletPasswordFileFlag.Name,
+			flags.AccountPasswordFileFlag.Name,
+			flags.ThresholdSharesDirFlag.Name,
+			flags.ThresholdListenAddressFlag.Name,
+			flags.ThresholdAuthTokenFileFlag.Name,
+		} {
+			require.NoError(t, set.Set(name, set.Lookup(name).Value.String()))
+		}
+		require.NoError(t, set.Set(flags.ThresholdPeersFlag.Name, "http://localhost:7600"))
+		cliCtx := cli.NewContext(&cli.App{}, set, nil)
+
+		w, err := CreateAndSaveWalletCli(cliCtx)
+		require.NoError(t, err)
+		km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+		require.NoError(t, err)
+		thresholdKm, ok := km.(*threshold.Keymanager)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, []string{"http://localhost:7600"}, thresholdKm.KeymanagerOpts().Peers)
+		assert.Equal(t, "token", thresholdKm.KeymanagerOpts().AuthToken)
+		pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(pubKeys))
+		assert.Equal(t, keystore.Pubkey, fmt.Sprintf("%x", pubKeys[0]))
+	}
+}
+
+func TestCreateWalletCli_ThresholdListenRequiresAuthToken(t *testing.T) {
+	walletPasswordFile := filepath.Join(t.TempDir(), "wallet-password.txt")
+	require.NoError(t, ioutil.WriteFile(walletPasswordFile, []byte("Passwordz0320$"), os.ModePerm))
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.WalletDirFlag.Name, filepath.Join(t.TempDir(), "wallet"), "")
+	set.String(flags.KeymanagerKindFlag.Name, keymanager.Threshold.String(), "")
+	set.String(flags.WalletPasswordFileFlag.Name, walletPasswordFile, "")
+	set.Var(cli.NewStringSlice(), flags.ThresholdPeersFlag.Name, "")
+	set.String(flags.ThresholdListenAddressFlag.Name, "127.0.0.1:0", "")
+	set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
+	for _, name := range []string{
+		flags.WalletDirFlag.Name,
+		flags.KeymanagerKindFlag.Name,
+		flags.WalletPasswordFileFlag.Name,
+		flags.ThresholdListenAddressFlag.Name,
+	} {
+		require.NoError(t, set.Set(name, set.Lookup(name).Value.String()))
+	}
+	_, err := CreateAndSaveWalletCli(cli.NewContext(&cli.App{}, set, nil))
+	require.ErrorContains(t, "an auth token is required to serve partial signatures", err)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -52,9 +53,10 @@ var (
 	)
 	// KeymanagerKindSelections as friendly text.
 	KeymanagerKindSelections = map[keymanager.Kind]string{
-		keymanager.Imported: "Imported Wallet (Recommended)",
-		keymanager.Derived:  "HD Wallet",
-		keymanager.Remote:   "Remote Signing Wallet (Advanced)",
+		keymanager.Imported:  "Imported Wallet (Recommended)",
+		keymanager.Derived:   "HD Wallet",
+		keymanager.Remote:    "Remote Signing Wallet (Advanced)",
+		keymanager.Threshold: "Threshold Signing Wallet (Advanced)",
 	}
 	// ValidateExistingPass checks that an input cannot be empty.
 	ValidateExistingPass = func(input string) error {
@@ -290,6 +292,24 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize remote keymanager")
 		}
+	case keymanager.Threshold:
+		configFile, err := w.ReadKeymanagerConfigFromDisk(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keymanager config")
+		}
+		opts, err := threshold.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		km, err = threshold.NewKeymanager(ctx, &threshold.SetupConfig{
+			Wallet: w,
+			Opts:   opts,
+			// Only the keymanager of a running validator client serves its peers.
+			ServePeers: cfg.ListenForChanges,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
@@ -411,7 +431,7 @@ func readKeymanagerKindFromWalletPath(walletPath string) (keymanager.Kind, error
 			return keymanagerKind, nil
 		}
 	}
-	return 0, errors.New("no keymanager folder (imported, remote, derived) found in wallet path")
+	return 0, errors.New("no keymanager folder (imported, remote, derived, threshold) found in wallet path")
 }
 
 // InputPassword prompts for a password and optionally for password confirmation.
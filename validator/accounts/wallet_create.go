@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/manifoldco/promptui"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/io/prompt"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/userprompt"
@@ -18,16 +20,20 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
 	"github.com/urfave/cli/v2"
 )
 
 // CreateWalletConfig defines the parameters needed to call the create wallet functions.
 type CreateWalletConfig struct {
-	SkipMnemonicConfirm  bool
-	NumAccounts          int
-	RemoteKeymanagerOpts *remote.KeymanagerOpts
-	WalletCfg            *wallet.Config
-	Mnemonic25thWord     string
+	SkipMnemonicConfirm     bool
+	NumAccounts             int
+	RemoteKeymanagerOpts    *remote.KeymanagerOpts
+	ThresholdKeymanagerOpts *threshold.KeymanagerOpts
+	ThresholdShares         []*threshold.Share
+	ThresholdSharesPassword string
+	WalletCfg               *wallet.Config
+	Mnemonic25thWord        string
 }
 
 // CreateAndSaveWalletCli from user input with a desired keymanager. If a
@@ -116,6 +122,14 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case keymanager.Threshold:
+		if err = createThresholdKeymanagerWallet(ctx, w, cfg); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet")
+		}
+		log.WithField("--wallet-dir", cfg.WalletCfg.WalletDir).Infof(
+			"Successfully created wallet with threshold keymanager configuration and %d key shares",
+			len(cfg.ThresholdShares),
+		)
 	default:
 		return nil, errors.Wrapf(err, errKeymanagerNotSupported, w.KeymanagerKind())
 	}
@@ -193,6 +207,43 @@ func extractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind keym
 		}
 		createWalletConfig.RemoteKeymanagerOpts = opts
 	}
+	if keymanagerKind == keymanager.Threshold {
+		createWalletConfig.ThresholdKeymanagerOpts = &threshold.KeymanagerOpts{
+			Peers:         cliCtx.StringSlice(flags.ThresholdPeersFlag.Name),
+			ListenAddress: cliCtx.String(flags.ThresholdListenAddressFlag.Name),
+		}
+		if cliCtx.IsSet(flags.ThresholdAuthTokenFileFlag.Name) {
+			tokenFile, err := file.ExpandPath(cliCtx.String(flags.ThresholdAuthTokenFileFlag.Name))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not determine absolute path of auth token file")
+			}
+			token, err := ioutil.ReadFile(tokenFile) // #nosec G304
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read auth token file")
+			}
+			createWalletConfig.ThresholdKeymanagerOpts.AuthToken = strings.TrimSpace(string(token))
+		}
+		if createWalletConfig.ThresholdKeymanagerOpts.ListenAddress != "" &&
+			createWalletConfig.ThresholdKeymanagerOpts.AuthToken == "" {
+			return nil, fmt.Errorf(
+				"an auth token is required to serve partial signatures, set --%s", flags.ThresholdAuthTokenFileFlag.Name,
+			)
+		}
+		if cliCtx.IsSet(flags.ThresholdSharesDirFlag.Name) {
+			sharesDir, err := file.ExpandPath(cliCtx.String(flags.ThresholdSharesDirFlag.Name))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not determine absolute path of shares directory")
+			}
+			createWalletConfig.ThresholdShares, err = readThresholdShares(cliCtx.Context, sharesDir)
+			if err != nil {
+				return nil, err
+			}
+			createWalletConfig.ThresholdSharesPassword, err = inputThresholdSharesPassword(cliCtx)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	return createWalletConfig, nil
 }
 
@@ -250,6 +301,53 @@ func createRemoteKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, op
 	return nil
 }
 
+func createThresholdKeymanagerWallet(ctx context.Context, wallet *wallet.Wallet, cfg *CreateWalletConfig) error {
+	opts := cfg.ThresholdKeymanagerOpts
+	if opts == nil {
+		opts = &threshold.KeymanagerOpts{}
+	}
+	keymanagerConfig, err := threshold.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	if len(cfg.ThresholdShares) == 0 {
+		return nil
+	}
+	km, err := threshold.NewKeymanager(ctx, &threshold.SetupConfig{
+		Wallet: wallet,
+		Opts:   opts,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize threshold keymanager")
+	}
+	passwords := make([]string, len(cfg.ThresholdShares))
+	for i := range passwords {
+		passwords[i] = cfg.ThresholdSharesPassword
+	}
+	return km.ImportShares(ctx, cfg.ThresholdShares, passwords)
+}
+
+func inputThresholdSharesPassword(cliCtx *cli.Context) (string, error) {
+	if cliCtx.IsSet(flags.AccountPasswordFileFlag.Name) {
+		data, err := ioutil.ReadFile(cliCtx.String(flags.AccountPasswordFileFlag.Name)) // #nosec G304
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	password, err := prompt.PasswordPrompt("Enter the password of the key shares", prompt.NotEmpty)
+	if err != nil {
+		return "", fmt.Errorf("could not read key shares password: %w", err)
+	}
+	return password, nil
+}
+
 func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 	if cliCtx.IsSet(flags.KeymanagerKindFlag.Name) {
 		return keymanager.ParseKind(cliCtx.String(flags.KeymanagerKindFlag.Name))
@@ -260,6 +358,7 @@ func inputKeymanagerKind(cliCtx *cli.Context) (keymanager.Kind, error) {
 			wallet.KeymanagerKindSelections[keymanager.Imported],
 			wallet.KeymanagerKindSelections[keymanager.Derived],
 			wallet.KeymanagerKindSelections[keymanager.Remote],
+			wallet.KeymanagerKindSelections[keymanager.Threshold],
 		},
 	}
 	selection, _, err := promptSelect.Run()
@@ -15,6 +15,7 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -84,7 +85,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 		return
 	}
 
-	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof, slot)
+	sig, root, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof, slot)
 	if err != nil {
 		log.Errorf("Could not sign aggregate and proof: %v", err)
 		return
@@ -95,6 +96,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 			Signature: sig,
 		},
 	})
+	v.auditBroadcast(ctx, pubKey, signingpolicy.AggregateAndProofObject, slot, root[:], err)
 	if err != nil {
 		log.Errorf("Could not submit signed aggregate and proof to beacon node: %v", err)
 		if v.emitAccountMetrics {
@@ -129,7 +131,7 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fiel
 	if err != nil {
 		return nil, err
 	}
-	sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -172,18 +174,18 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot) {
 }
 
 // This returns the signature of validator signing over aggregate and
-// proof object.
-func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, agg *ethpb.AggregateAttestationAndProof, slot types.Slot) ([]byte, error) {
+// proof object, and its signing root.
+func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, agg *ethpb.AggregateAttestationAndProof, slot types.Slot) ([]byte, [32]byte, error) {
 	d, err := v.domainData(ctx, slots.ToEpoch(agg.Aggregate.Data.Slot), params.BeaconConfig().DomainAggregateAndProof[:])
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
 	var sig bls.Signature
 	root, err := signing.ComputeSigningRoot(agg, d.SignatureDomain)
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
-	sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
@@ -191,10 +193,10 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [fieldparam
 		SigningSlot:     slot,
 	})
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
 
-	return sig.Marshal(), nil
+	return sig.Marshal(), root, nil
 }
 
 func (v *validator) addIndicesToLog(duty *ethpb.DutiesResponse_Duty) error {
@@ -24,6 +24,7 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -146,6 +147,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 		return
 	}
 	attResp, err := v.validatorClient.ProposeAttestation(ctx, attestation)
+	v.auditBroadcast(ctx, pubKey, signingpolicy.AttestationObject, slot, signingRoot[:], err)
 	if err != nil {
 		log.WithError(err).Error("Could not submit attestation to beacon node")
 		if v.emitAccountMetrics {
@@ -201,7 +203,7 @@ func (v *validator) signAtt(ctx context.Context, pubKey [fieldparams.BLSPubkeyLe
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
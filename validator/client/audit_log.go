@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
+)
+
+const (
+	// auditLogPruneInterval is how often the entries of the signing audit log past their retention
+	// period are deleted.
+	auditLogPruneInterval = time.Hour
+	// auditLogWriteInterval is how often the queued entries of the signing audit log are written.
+	auditLogWriteInterval = 100 * time.Millisecond
+	// auditLogQueueCapacity is how many entries can be queued before appending one waits for the
+	// queue to be written.
+	auditLogQueueCapacity = 2048
+)
+
+// auditLogQueue holds the entries of the signing audit log until they are written to the
+// database in batches, so that signing and broadcasting do not wait on a database write.
+type auditLogQueue struct {
+	db      db.Database
+	entries chan *kv.AuditLogEntry
+}
+
+func newAuditLogQueue(valDB db.Database) *auditLogQueue {
+	return &auditLogQueue{
+		db:      valDB,
+		entries: make(chan *kv.AuditLogEntry, auditLogQueueCapacity),
+	}
+}
+
+// run writes the queued entries periodically until the context is done, and then writes the
+// entries still queued.
+func (q *auditLogQueue) run(ctx context.Context) {
+	ticker := time.NewTicker(auditLogWriteInterval)
+	defer ticker.Stop()
+	var pending []*kv.AuditLogEntry
+	for {
+		select {
+		case <-ticker.C:
+			pending = q.write(ctx, pending)
+		case <-ctx.Done():
+			q.write(context.Background(), pending)
+			return
+		}
+	}
+}
+
+// write appends the pending entries and those queued to the audit log in a single transaction. It
+// returns the entries to retry if they could not be written.
+func (q *auditLogQueue) write(ctx context.Context, pending []*kv.AuditLogEntry) []*kv.AuditLogEntry {
+	for len(pending) < auditLogQueueCapacity {
+		select {
+		case entry := <-q.entries:
+			pending = append(pending, entry)
+			continue
+		default:
+		}
+		break
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	if err := q.db.AppendAuditLogEntries(ctx, pending); err != nil {
+		log.WithError(err).WithField("entries", len(pending)).Error("Could not append signing audit log entries")
+		return pending
+	}
+	return nil
+}
+
+// auditSign records the outcome of a sign request in the signing audit log, if it is enabled.
+func (v *validator) auditSign(ctx context.Context, req *validatorpb.SignRequest, signErr error) {
+	if v.auditLog == nil {
+		return
+	}
+	objectType, slot, err := signingpolicy.RequestObject(req)
+	if err != nil {
+		log.WithError(err).Error("Could not determine the object of the sign request for the audit log")
+		return
+	}
+	entry := &kv.AuditLogEntry{
+		Timestamp:       prysmTime.Now().Unix(),
+		PublicKey:       req.PublicKey,
+		ObjectType:      objectType,
+		Slot:            slot,
+		SignatureDomain: req.SignatureDomain,
+		SigningRoot:     req.SigningRoot,
+		Keymanager:      keymanagerKind(v.keyManager),
+		Outcome:         kv.AuditOutcomeSigned,
+	}
+	if signErr != nil {
+		entry.Outcome = kv.AuditOutcomeFailed
+		if errors.Is(signErr, signingpolicy.ErrRefused) {
+			entry.Outcome = kv.AuditOutcomeRefused
+		}
+		entry.Error = signErr.Error()
+	}
+	v.appendAuditLogEntry(ctx, entry)
+}
+
+// auditBroadcast records the outcome of broadcasting a signed object through the beacon node in
+// the signing audit log, if it is enabled.
+func (v *validator) auditBroadcast(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	objectType string,
+	slot types.Slot,
+	signingRoot []byte,
+	broadcastErr error,
+) {
+	if v.auditLog == nil {
+		return
+	}
+	entry := &kv.AuditLogEntry{
+		Timestamp:   prysmTime.Now().Unix(),
+		PublicKey:   pubKey[:],
+		ObjectType:  objectType,
+		Slot:        slot,
+		SigningRoot: signingRoot,
+		Keymanager:  keymanagerKind(v.keyManager),
+		Outcome:     kv.AuditOutcomeBroadcast,
+	}
+	if broadcastErr != nil {
+		entry.Outcome = kv.AuditOutcomeBroadcastFailed
+		entry.Error = broadcastErr.Error()
+	}
+	v.appendAuditLogEntry(ctx, entry)
+}
+
+// AuditSign records the outcome of a sign request made on behalf of the validators outside of their
+// duties, such as a voluntary exit, in the signing audit log if it is enabled.
+func (v *ValidatorService) AuditSign(ctx context.Context, req *validatorpb.SignRequest, signErr error) {
+	// The validator is set once the service is started.
+	val, ok := v.validator.(*validator)
+	if !ok {
+		return
+	}
+	val.auditSign(ctx, req, signErr)
+}
+
+// AuditBroadcast records the outcome of broadcasting an object signed on behalf of the validators
+// outside of their duties, such as a voluntary exit, in the signing audit log if it is enabled.
+func (v *ValidatorService) AuditBroadcast(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	objectType string,
+	slot types.Slot,
+	signingRoot []byte,
+	broadcastErr error,
+) {
+	// The validator is set once the service is started.
+	val, ok := v.validator.(*validator)
+	if !ok {
+		return
+	}
+	val.auditBroadcast(ctx, pubKey, objectType, slot, signingRoot, broadcastErr)
+}
+
+// appendAuditLogEntry queues the entry to be written to the audit log. Failing to write it does not
+// fail the duty.
+func (v *validator) appendAuditLogEntry(ctx context.Context, entry *kv.AuditLogEntry) {
+	select {
+	case v.auditLog.entries <- entry:
+	case <-ctx.Done():
+		log.WithError(ctx.Err()).Error("Could not queue signing audit log entry")
+	}
+}
+
+// keymanagerKind returns the name of the kind of the keymanager, as recorded in the audit log.
+func keymanagerKind(km keymanager.IKeymanager) string {
+	switch km.(type) {
+	case *imported.Keymanager:
+		return keymanager.Imported.String()
+	case *derived.Keymanager:
+		return keymanager.Derived.String()
+	case *threshold.Keymanager:
+		return keymanager.Threshold.String()
+	case *remoteweb3signer.Keymanager:
+		return "web3signer"
+	case *remote.Keymanager:
+		return keymanager.Remote.String()
+	default:
+		return "unknown"
+	}
+}
+
+// pruneSigningAuditLog periodically deletes the entries of the signing audit log older than the
+// retention period, until the context is done.
+func pruneSigningAuditLog(ctx context.Context, valDB db.Database, retention time.Duration) {
+	ticker := time.NewTicker(auditLogPruneInterval)
+	defer ticker.Stop()
+	for {
+		pruned, err := valDB.PruneAuditLog(ctx, prysmTime.Now().Add(-retention).Unix())
+		if err != nil {
+			log.WithError(err).Error("Could not prune signing audit log")
+		} else if pruned > 0 {
+			log.WithField("entries", pruned).Debug("Pruned signing audit log")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
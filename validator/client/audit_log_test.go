@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
+)
+
+func TestProposeBlock_RecordsSigningAuditLog(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	validator.auditLog = newAuditLogQueue(validator.db)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).Times(2)
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(util.NewBeaconBlock().Block, nil /*err*/)
+	m.validatorClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
+	).Return(nil /*response*/, errors.New("block rejected"))
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+
+	require.Equal(t, 0, len(validator.auditLog.write(context.Background(), nil)))
+	entries, err := validator.db.AuditLog(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+	// The entries are the most recent first.
+	assert.Equal(t, signingpolicy.BlockObject, entries[0].ObjectType)
+	assert.Equal(t, kv.AuditOutcomeBroadcastFailed, entries[0].Outcome)
+	assert.Equal(t, "block rejected", entries[0].Error)
+	assert.DeepEqual(t, entries[1].SigningRoot, entries[0].SigningRoot)
+	assert.Equal(t, signingpolicy.BlockObject, entries[1].ObjectType)
+	assert.Equal(t, kv.AuditOutcomeSigned, entries[1].Outcome)
+	assert.DeepEqual(t, make([]byte, 32), entries[1].SignatureDomain)
+	assert.Equal(t, signingpolicy.RandaoRevealObject, entries[2].ObjectType)
+	assert.Equal(t, kv.AuditOutcomeSigned, entries[2].Outcome)
+	for _, e := range entries {
+		assert.DeepEqual(t, pubKey[:], e.PublicKey)
+	}
+}
+
+func TestProposeBlock_RecordsRefusalInSigningAuditLog(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	validator.auditLog = newAuditLogQueue(validator.db)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.signingPolicy = signingpolicy.NewEnforcer(
+		&signingpolicy.Settings{DefaultConfig: &signingpolicy.Policy{MaxFutureSlots: 1}}, validator.db,
+	)
+	validator.signingPolicy.SetGenesisTime(uint64(time.Now().Unix()))
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().SlotsPerEpoch, pubKey)
+
+	require.Equal(t, 0, len(validator.auditLog.write(context.Background(), nil)))
+	entries, err := validator.db.AuditLog(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, signingpolicy.RandaoRevealObject, entries[0].ObjectType)
+	assert.Equal(t, kv.AuditOutcomeRefused, entries[0].Outcome)
+	assert.Equal(t, true, strings.Contains(entries[0].Error, "refused by signing policy"))
+}
+
+func TestProposeBlock_SigningAuditLogDisabled(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(nil /*response*/, errors.New("no block"))
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+
+	entries, err := validator.db.AuditLog(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+}
+
+func TestPruneSigningAuditLog(t *testing.T) {
+	validator, _, _, finish := setup(t)
+	defer finish()
+	ctx := context.Background()
+	now := prysmTime.Now()
+	pubKey := []byte{1}
+	require.NoError(t, validator.db.AppendAuditLogEntry(ctx, &kv.AuditLogEntry{Timestamp: now.Add(-2 * time.Hour).Unix(), PublicKey: pubKey}))
+	require.NoError(t, validator.db.AppendAuditLogEntry(ctx, &kv.AuditLogEntry{Timestamp: now.Unix(), PublicKey: pubKey, Slot: 1}))
+
+	// The log is pruned once before the done context stops the routine.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	pruneSigningAuditLog(ctx, validator.db, time.Hour)
+
+	entries, err := validator.db.AuditLog(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, now.Unix(), entries[0].Timestamp)
+}
+
+func TestAuditLogQueue_WritesQueuedEntriesWhenDone(t *testing.T) {
+	validator, _, _, finish := setup(t)
+	defer finish()
+	q := newAuditLogQueue(validator.db)
+	for slot := types.Slot(0); slot < 3; slot++ {
+		q.entries <- &kv.AuditLogEntry{PublicKey: []byte{1}, Slot: slot}
+	}
+
+	// The entries still queued are written once the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	q.run(ctx)
+
+	entries, err := validator.db.AuditLog(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(entries))
+}
@@ -0,0 +1,100 @@
+package beacon_api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// GetAttestationData asks the beacon node to produce the attestation data of a committee.
+func (c *beaconApiValidatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	query := url.Values{
+		"slot":            []string{strconv.FormatUint(uint64(in.Slot), 10)},
+		"committee_index": []string{strconv.FormatUint(uint64(in.CommitteeIndex), 10)},
+	}
+	resp := &ethpbv1.ProduceAttestationDataResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/validator/attestation_data", query, resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce attestation data")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty attestation data response")
+	}
+	return migration.V1AttDataToV1Alpha1(resp.Data), nil
+}
+
+// ProposeAttestation submits an attestation to the pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	if in.Data == nil {
+		return nil, errors.New("nil attestation data")
+	}
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	if err := c.rest.post(ctx, "/eth/v1/beacon/pool/attestations", encodeMessages(migration.V1Alpha1AttestationToV1(in)), nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit attestation")
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof returns the aggregate of the attestations of the committee of an
+// aggregator, ready to be signed.
+func (c *beaconApiValidatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	index, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: in.Slot, CommitteeIndex: in.CommitteeIndex})
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	query := url.Values{
+		"attestation_data_root": []string{hexutil.Encode(root[:])},
+		"slot":                  []string{strconv.FormatUint(uint64(in.Slot), 10)},
+	}
+	resp := &ethpbv1.AggregateAttestationResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/validator/aggregate_attestation", query, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get aggregate attestation")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty aggregate attestation response")
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: index.Index,
+			Aggregate:       migration.V1AttToV1Alpha1(resp.Data),
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes a signed aggregate.
+func (c *beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	signed := in.SignedAggregateAndProof
+	if signed == nil || signed.Message == nil || signed.Message.Aggregate == nil || signed.Message.Aggregate.Data == nil {
+		return nil, errors.New("nil signed aggregate")
+	}
+	root, err := signed.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	body := &ethpbv1.SignedAggregateAttestationAndProof{
+		Message:   migration.V1Alpha1AggregateAttAndProofToV1(signed.Message),
+		Signature: signed.Signature,
+	}
+	if err := c.rest.post(ctx, "/eth/v1/validator/aggregate_and_proofs", encodeMessages(body), nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish aggregate")
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
@@ -0,0 +1,137 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func testAttestationData() *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            9,
+		CommitteeIndex:  2,
+		BeaconBlockRoot: bytesutil.PadTo([]byte("head"), 32),
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("source"), 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("target"), 32)},
+	}
+}
+
+func serveAttestationData(t *testing.T, mux *http.ServeMux, data *ethpb.AttestationData) {
+	resp := &ethpbv1.ProduceAttestationDataResponse{Data: migration.V1Alpha1AttDataToV1(data)}
+	serve(t, mux, http.MethodGet, "/eth/v1/validator/attestation_data", resp, func(r *http.Request, _ []byte) {
+		assert.Equal(t, "9", r.URL.Query().Get("slot"))
+		assert.Equal(t, "2", r.URL.Query().Get("committee_index"))
+	})
+}
+
+func TestGetAttestationData(t *testing.T) {
+	data := testAttestationData()
+	mux := http.NewServeMux()
+	serveAttestationData(t, mux, data)
+	c := setupClient(t, mux)
+
+	resp, err := c.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{Slot: 9, CommitteeIndex: 2})
+	require.NoError(t, err)
+	assert.DeepEqual(t, data, resp)
+}
+
+func TestProposeAttestation(t *testing.T) {
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0b101},
+		Data:            testAttestationData(),
+		Signature:       bytesutil.PadTo([]byte("signature"), 96),
+	}
+	wantRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/beacon/pool/attestations", nil, func(_ *http.Request, body []byte) {
+		published := &ethpbv1.SubmitAttestationsRequest{}
+		require.NoError(t, unmarshalJSON([]byte(`{"data":`+string(body)+`}`), published))
+		require.Equal(t, 1, len(published.Data))
+		assert.DeepEqual(t, att, migration.V1AttToV1Alpha1(published.Data[0]))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ProposeAttestation(context.Background(), att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantRoot[:], resp.AttestationDataRoot)
+}
+
+func TestSubmitAggregateSelectionProof(t *testing.T) {
+	data := testAttestationData()
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	aggregate := &ethpb.Attestation{
+		AggregationBits: []byte{0b111},
+		Data:            data,
+		Signature:       bytesutil.PadTo([]byte("aggregate"), 96),
+	}
+	proof := bytesutil.PadTo([]byte("proof"), 96)
+
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/validators", &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{{Index: 11, Validator: &ethpbv1.Validator{Pubkey: pubKey(1)}}},
+	}, nil)
+	serveAttestationData(t, mux, data)
+	serve(t, mux, http.MethodGet, "/eth/v1/validator/aggregate_attestation", &ethpbv1.AggregateAttestationResponse{
+		Data: migration.V1Alpha1AttestationToV1(aggregate),
+	}, func(r *http.Request, _ []byte) {
+		assert.Equal(t, hexutil.Encode(dataRoot[:]), r.URL.Query().Get("attestation_data_root"))
+		assert.Equal(t, "9", r.URL.Query().Get("slot"))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.SubmitAggregateSelectionProof(context.Background(), &ethpb.AggregateSelectionRequest{
+		Slot:           9,
+		CommitteeIndex: 2,
+		PublicKey:      pubKey(1),
+		SlotSignature:  proof,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: 11,
+		Aggregate:       aggregate,
+		SelectionProof:  proof,
+	}, resp.AggregateAndProof)
+}
+
+func TestSubmitSignedAggregateSelectionProof(t *testing.T) {
+	signed := &ethpb.SignedAggregateAttestationAndProof{
+		Message: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: 11,
+			Aggregate: &ethpb.Attestation{
+				AggregationBits: []byte{0b111},
+				Data:            testAttestationData(),
+				Signature:       bytesutil.PadTo([]byte("aggregate"), 96),
+			},
+			SelectionProof: bytesutil.PadTo([]byte("proof"), 96),
+		},
+		Signature: bytesutil.PadTo([]byte("signature"), 96),
+	}
+	wantRoot, err := signed.Message.Aggregate.Data.HashTreeRoot()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/aggregate_and_proofs", nil, func(_ *http.Request, body []byte) {
+		published := &ethpbv1.SubmitAggregateAndProofsRequest{}
+		require.NoError(t, unmarshalJSON([]byte(`{"data":`+string(body)+`}`), published))
+		require.Equal(t, 1, len(published.Data))
+		assert.Equal(t, signed.Message.AggregatorIndex, published.Data[0].Message.AggregatorIndex)
+		assert.DeepEqual(t, signed.Message.SelectionProof, published.Data[0].Message.SelectionProof)
+		assert.DeepEqual(t, signed.Signature, published.Data[0].Signature)
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.SubmitSignedAggregateSelectionProof(context.Background(), &ethpb.SignedAggregateSubmitRequest{SignedAggregateAndProof: signed})
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantRoot[:], resp.AttestationDataRoot)
+}
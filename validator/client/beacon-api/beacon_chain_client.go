@@ -0,0 +1,78 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = iface.BeaconChainClient(&beaconApiBeaconChainClient{})
+
+// beaconApiBeaconChainClient implements the beacon chain calls used by the validator client on top
+// of the /eth/v1/beacon endpoints of the beacon API.
+type beaconApiBeaconChainClient struct {
+	rest *restClient
+}
+
+// NewBeaconApiBeaconChainClient returns a beacon chain client backed by the beacon API served at
+// the given URL.
+func NewBeaconApiBeaconChainClient(endpoint string, timeout time.Duration) iface.BeaconChainClient {
+	return &beaconApiBeaconChainClient{rest: newRestClient(endpoint, &http.Client{Timeout: timeout})}
+}
+
+// GetChainHead returns the head block and the checkpoints of the head state.
+func (c *beaconApiBeaconChainClient) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	header := &ethpbv1.BlockHeaderResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/beacon/headers/head", nil, header); err != nil {
+		return nil, errors.Wrap(err, "could not get head block header")
+	}
+	if header.Data == nil || header.Data.Header == nil || header.Data.Header.Message == nil {
+		return nil, errors.New("empty block header response")
+	}
+	checkpoints := &ethpbv1.StateFinalityCheckpointResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", nil, checkpoints); err != nil {
+		return nil, errors.Wrap(err, "could not get finality checkpoints")
+	}
+	cp := checkpoints.Data
+	if cp == nil || cp.Finalized == nil || cp.CurrentJustified == nil || cp.PreviousJustified == nil {
+		return nil, errors.New("empty finality checkpoints response")
+	}
+
+	headSlot := header.Data.Header.Message.Slot
+	res := &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  slots.ToEpoch(headSlot),
+		HeadBlockRoot:              header.Data.Root,
+		FinalizedEpoch:             cp.Finalized.Epoch,
+		FinalizedBlockRoot:         cp.Finalized.Root,
+		JustifiedEpoch:             cp.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         cp.CurrentJustified.Root,
+		PreviousJustifiedEpoch:     cp.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: cp.PreviousJustified.Root,
+	}
+	var err error
+	if res.FinalizedSlot, err = slots.EpochStart(cp.Finalized.Epoch); err != nil {
+		return nil, err
+	}
+	if res.JustifiedSlot, err = slots.EpochStart(cp.CurrentJustified.Epoch); err != nil {
+		return nil, err
+	}
+	if res.PreviousJustifiedSlot, err = slots.EpochStart(cp.PreviousJustified.Epoch); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetValidatorPerformance is not supported, the beacon API does not expose the participation of
+// individual validators.
+func (c *beaconApiBeaconChainClient) GetValidatorPerformance(_ context.Context, _ *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, errors.Wrap(errUnsupported, "GetValidatorPerformance")
+}
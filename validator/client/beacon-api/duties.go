@@ -0,0 +1,205 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// subscriptionKey identifies a beacon committee.
+type subscriptionKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// GetDuties returns the duties of the validators in the requested epoch and in the next epoch,
+// put together from the attester, proposer and sync committee duties endpoints.
+func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	validators, err := c.stateValidators(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	currentDuties, currentAttesterDuties, err := c.epochDuties(ctx, in.Epoch, false, in.PublicKeys, validators)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties for epoch %d", in.Epoch)
+	}
+	nextDuties, nextAttesterDuties, err := c.epochDuties(ctx, in.Epoch+1, true, in.PublicKeys, validators)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get duties for epoch %d", in.Epoch+1)
+	}
+
+	subscriptions := make(map[subscriptionKey][]*ethpbv1.AttesterDuty)
+	for _, duty := range append(currentAttesterDuties, nextAttesterDuties...) {
+		key := subscriptionKey{slot: duty.Slot, committeeIndex: duty.CommitteeIndex}
+		subscriptions[key] = append(subscriptions[key], duty)
+	}
+	c.subscriptionsLock.Lock()
+	c.subscriptions = subscriptions
+	c.subscriptionsLock.Unlock()
+
+	return &ethpb.DutiesResponse{
+		Duties:             currentDuties,
+		CurrentEpochDuties: currentDuties,
+		NextEpochDuties:    nextDuties,
+	}, nil
+}
+
+// epochDuties returns the duties of the validators with the given public keys in an epoch, along
+// with their attester duties. The proposers of the next epoch may not be known yet.
+func (c *beaconApiValidatorClient) epochDuties(
+	ctx context.Context,
+	epoch types.Epoch,
+	nextEpoch bool,
+	pubKeys [][]byte,
+	validators map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer,
+) ([]*ethpb.DutiesResponse_Duty, []*ethpbv1.AttesterDuty, error) {
+	duties := make([]*ethpb.DutiesResponse_Duty, len(pubKeys))
+	dutiesByIndex := make(map[types.ValidatorIndex]*ethpb.DutiesResponse_Duty, len(validators))
+	indices := make([]interface{}, 0, len(validators))
+	for i, pubKey := range pubKeys {
+		v, ok := validators[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			duties[i] = &ethpb.DutiesResponse_Duty{
+				PublicKey:      pubKey,
+				Status:         ethpb.ValidatorStatus_UNKNOWN_STATUS,
+				ValidatorIndex: nonExistentIndex,
+			}
+			continue
+		}
+		duties[i] = &ethpb.DutiesResponse_Duty{
+			PublicKey:      pubKey,
+			Status:         validatorStatus(v.Status),
+			ValidatorIndex: v.Index,
+		}
+		if _, ok := dutiesByIndex[v.Index]; !ok {
+			indices = append(indices, strconv.FormatUint(uint64(v.Index), 10))
+		}
+		dutiesByIndex[v.Index] = duties[i]
+	}
+	if len(indices) == 0 {
+		return duties, nil, nil
+	}
+
+	attesterResp := &ethpbv1.AttesterDutiesResponse{}
+	if err := c.rest.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch), indices, attesterResp); err != nil {
+		return nil, nil, errors.Wrap(err, "could not get attester duties")
+	}
+	if len(attesterResp.Data) > 0 {
+		committees, err := c.committees(ctx, epoch)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, attesterDuty := range attesterResp.Data {
+			duty, ok := dutiesByIndex[attesterDuty.ValidatorIndex]
+			if !ok {
+				continue
+			}
+			duty.AttesterSlot = attesterDuty.Slot
+			duty.CommitteeIndex = attesterDuty.CommitteeIndex
+			duty.Committee = committees[subscriptionKey{slot: attesterDuty.Slot, committeeIndex: attesterDuty.CommitteeIndex}]
+		}
+	}
+
+	proposerResp := &ethpbv1.ProposerDutiesResponse{}
+	if err := c.rest.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), nil, proposerResp); err != nil {
+		if !nextEpoch {
+			return nil, nil, errors.Wrap(err, "could not get proposer duties")
+		}
+		// Beacon nodes only know the proposers of the epochs they have processed, so a failure to
+		// get the proposers of the next epoch is expected.
+		log.WithError(err).WithField("epoch", epoch).Debug("Could not get proposer duties")
+	}
+	for _, proposerDuty := range proposerResp.Data {
+		if duty, ok := dutiesByIndex[proposerDuty.ValidatorIndex]; ok {
+			duty.ProposerSlots = append(duty.ProposerSlots, proposerDuty.Slot)
+		}
+	}
+
+	if epoch >= params.BeaconConfig().AltairForkEpoch {
+		syncResp := &ethpbv2.SyncCommitteeDutiesResponse{}
+		if err := c.rest.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/sync/%d", epoch), indices, syncResp); err != nil {
+			return nil, nil, errors.Wrap(err, "could not get sync committee duties")
+		}
+		for _, syncDuty := range syncResp.Data {
+			if duty, ok := dutiesByIndex[syncDuty.ValidatorIndex]; ok {
+				duty.IsSyncCommittee = true
+			}
+		}
+	}
+	return duties, attesterResp.Data, nil
+}
+
+// committees returns the beacon committees of an epoch.
+func (c *beaconApiValidatorClient) committees(ctx context.Context, epoch types.Epoch) (map[subscriptionKey][]types.ValidatorIndex, error) {
+	resp := &ethpbv1.StateCommitteesResponse{}
+	query := url.Values{"epoch": []string{strconv.FormatUint(uint64(epoch), 10)}}
+	if err := c.rest.get(ctx, "/eth/v1/beacon/states/head/committees", query, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get committees")
+	}
+	committees := make(map[subscriptionKey][]types.ValidatorIndex, len(resp.Data))
+	for _, committee := range resp.Data {
+		committees[subscriptionKey{slot: committee.Slot, committeeIndex: committee.Index}] = committee.Validators
+	}
+	return committees, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the validators to the subnets of their committees. The
+// validators of each committee and the number of committees at its slot are taken from the
+// attester duties of the last call to GetDuties. The request does not say which validator each
+// entry is for, so a validator is subscribed as an aggregator if any entry of its committee is.
+func (c *beaconApiValidatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.Slots) != len(in.IsAggregator) {
+		return nil, errors.New("slots, committee ids and aggregator flags must have the same length")
+	}
+	type validatorKey struct {
+		subscriptionKey
+		index types.ValidatorIndex
+	}
+	subscriptions := make(map[validatorKey]*ethpbv1.BeaconCommitteeSubscribe)
+	order := make([]validatorKey, 0, len(in.Slots))
+	c.subscriptionsLock.RLock()
+	for i, slot := range in.Slots {
+		key := subscriptionKey{slot: slot, committeeIndex: in.CommitteeIds[i]}
+		for _, duty := range c.subscriptions[key] {
+			vKey := validatorKey{subscriptionKey: key, index: duty.ValidatorIndex}
+			if sub, ok := subscriptions[vKey]; ok {
+				sub.IsAggregator = sub.IsAggregator || in.IsAggregator[i]
+				continue
+			}
+			subscriptions[vKey] = &ethpbv1.BeaconCommitteeSubscribe{
+				ValidatorIndex:   duty.ValidatorIndex,
+				CommitteeIndex:   duty.CommitteeIndex,
+				CommitteesAtSlot: duty.CommitteesAtSlot,
+				Slot:             duty.Slot,
+				IsAggregator:     in.IsAggregator[i],
+			}
+			order = append(order, vKey)
+		}
+	}
+	c.subscriptionsLock.RUnlock()
+	if len(order) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+
+	body := make([]proto.Message, len(order))
+	for i, key := range order {
+		body[i] = subscriptions[key]
+	}
+	if err := c.rest.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", encodeMessages(body...), nil); err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to committee subnets")
+	}
+	return &emptypb.Empty{}, nil
+}
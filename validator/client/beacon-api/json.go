@@ -0,0 +1,228 @@
+package beacon_api
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The beacon API encodes byte fields as 0x-prefixed hex strings, integers as decimal strings and
+// enums as lower case names, which differs from the protobuf JSON mapping. The eth/v1 and eth/v2
+// messages use the field names of the API, so they are encoded and decoded by walking the
+// message descriptors.
+
+const timestampName = "google.protobuf.Timestamp"
+
+// encodeMessage returns the beacon API JSON representation of a message, ready to be passed to
+// json.Marshal.
+func encodeMessage(m proto.Message) map[string]interface{} {
+	return encodeFields(m.ProtoReflect())
+}
+
+// encodeMessages returns the beacon API JSON representation of a list of messages.
+func encodeMessages(ms ...proto.Message) []interface{} {
+	res := make([]interface{}, len(ms))
+	for i, m := range ms {
+		res[i] = encodeMessage(m)
+	}
+	return res
+}
+
+func encodeFields(m protoreflect.Message) map[string]interface{} {
+	res := make(map[string]interface{})
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.ContainingOneof() != nil && !m.Has(fd) {
+			continue
+		}
+		v := m.Get(fd)
+		if fd.IsList() {
+			list := v.List()
+			values := make([]interface{}, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				values[j] = encodeValue(fd, list.Get(j))
+			}
+			res[string(fd.Name())] = values
+			continue
+		}
+		res[string(fd.Name())] = encodeValue(fd, v)
+	}
+	return res
+}
+
+func encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BytesKind:
+		return hexutil.Encode(v.Bytes())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return strings.ToLower(string(ev.Name()))
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := v.Message()
+		if msg.Descriptor().FullName() == timestampName {
+			return strconv.FormatInt(msg.Get(msg.Descriptor().Fields().ByName("seconds")).Int(), 10)
+		}
+		return encodeFields(msg)
+	default:
+		return nil
+	}
+}
+
+// unmarshalJSON decodes the beacon API JSON representation of a message. Unknown fields are
+// ignored.
+func unmarshalJSON(data []byte, m proto.Message) error {
+	return decodeFields(data, m.ProtoReflect())
+}
+
+func decodeFields(data []byte, m protoreflect.Message) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		enc, ok := raw[string(fd.Name())]
+		if !ok || string(enc) == "null" {
+			continue
+		}
+		if err := decodeField(enc, m, fd); err != nil {
+			return errors.Wrapf(err, "field %s", fd.Name())
+		}
+	}
+	return nil
+}
+
+func decodeField(enc json.RawMessage, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	isMessage := fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+	if fd.IsList() {
+		var elems []json.RawMessage
+		if err := json.Unmarshal(enc, &elems); err != nil {
+			return err
+		}
+		list := m.Mutable(fd).List()
+		for _, elem := range elems {
+			if isMessage {
+				v := list.NewElement()
+				if err := decodeMessage(elem, v.Message()); err != nil {
+					return err
+				}
+				list.Append(v)
+				continue
+			}
+			v, err := decodeScalar(elem, fd)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+	if isMessage {
+		return decodeMessage(enc, m.Mutable(fd).Message())
+	}
+	v, err := decodeScalar(enc, fd)
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+func decodeMessage(enc json.RawMessage, m protoreflect.Message) error {
+	if m.Descriptor().FullName() != timestampName {
+		return decodeFields(enc, m)
+	}
+	seconds, err := decodeInt(enc)
+	if err != nil {
+		return err
+	}
+	m.Set(m.Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+	return nil
+}
+
+func decodeScalar(enc json.RawMessage, fd protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		var b bool
+		if err := json.Unmarshal(enc, &b); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.StringKind:
+		var s string
+		if err := json.Unmarshal(enc, &s); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		var s string
+		if err := json.Unmarshal(enc, &s); err != nil {
+			return protoreflect.Value{}, err
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		u, err := decodeUint(enc, 32)
+		return protoreflect.ValueOfUint32(uint32(u)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		u, err := decodeUint(enc, 64)
+		return protoreflect.ValueOfUint64(u), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		i, err := decodeInt(enc)
+		return protoreflect.ValueOfInt32(int32(i)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		i, err := decodeInt(enc)
+		return protoreflect.ValueOfInt64(i), err
+	case protoreflect.EnumKind:
+		var s string
+		if err := json.Unmarshal(enc, &s); err != nil {
+			return protoreflect.Value{}, err
+		}
+		ev := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(s)))
+		if ev == nil {
+			return protoreflect.Value{}, errors.Errorf("unknown value %q of enum %s", s, fd.Enum().FullName())
+		}
+		return protoreflect.ValueOfEnum(ev.Number()), nil
+	default:
+		return protoreflect.Value{}, errors.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// decodeUint decodes an unsigned integer, which the beacon API encodes as a decimal string.
+// Plain JSON numbers are accepted as well.
+func decodeUint(enc json.RawMessage, bitSize int) (uint64, error) {
+	var s string
+	if err := json.Unmarshal(enc, &s); err != nil {
+		s = string(enc)
+	}
+	return strconv.ParseUint(s, 10, bitSize)
+}
+
+func decodeInt(enc json.RawMessage) (int64, error) {
+	var s string
+	if err := json.Unmarshal(enc, &s); err != nil {
+		s = string(enc)
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,97 @@
+package beacon_api
+
+import (
+	"encoding/json"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUnmarshalJSON(t *testing.T) {
+	data := `{
+		"data": [{
+			"index": "12",
+			"balance": 32000000000,
+			"status": "active_ongoing",
+			"validator": {
+				"pubkey": "0x0102",
+				"slashed": true,
+				"activation_epoch": "3",
+				"unknown_field": "ignored"
+			}
+		}]
+	}`
+	resp := &ethpbv1.StateValidatorsResponse{}
+	require.NoError(t, unmarshalJSON([]byte(data), resp))
+	require.Equal(t, 1, len(resp.Data))
+	v := resp.Data[0]
+	assert.Equal(t, types.ValidatorIndex(12), v.Index)
+	assert.Equal(t, uint64(32000000000), v.Balance)
+	assert.Equal(t, ethpbv1.ValidatorStatus_ACTIVE_ONGOING, v.Status)
+	assert.DeepEqual(t, []byte{1, 2}, v.Validator.Pubkey)
+	assert.Equal(t, true, v.Validator.Slashed)
+	assert.Equal(t, types.Epoch(3), v.Validator.ActivationEpoch)
+}
+
+func TestUnmarshalJSON_Timestamp(t *testing.T) {
+	resp := &ethpbv1.GenesisResponse{}
+	require.NoError(t, unmarshalJSON([]byte(`{"data":{"genesis_time":"1606824023","genesis_fork_version":"0x00000000"}}`), resp))
+	assert.Equal(t, int64(1606824023), resp.Data.GenesisTime.Seconds)
+	assert.DeepEqual(t, []byte{0, 0, 0, 0}, resp.Data.GenesisForkVersion)
+}
+
+func TestUnmarshalJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{name: "bad integer", data: `{"data":[{"index":"abc"}]}`, err: "field index"},
+		{name: "bad bytes", data: `{"data":[{"validator":{"pubkey":"0102"}}]}`, err: "field pubkey"},
+		{name: "bad enum", data: `{"data":[{"status":"sleeping"}]}`, err: "unknown value"},
+		{name: "bad list", data: `{"data":{}}`, err: "field data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorContains(t, tt.err, unmarshalJSON([]byte(tt.data), &ethpbv1.StateValidatorsResponse{}))
+		})
+	}
+}
+
+func TestEncodeMessage_RoundTrip(t *testing.T) {
+	att := &ethpbv1.Attestation{
+		AggregationBits: []byte{0b1101},
+		Data: &ethpbv1.AttestationData{
+			Slot:            5,
+			Index:           2,
+			BeaconBlockRoot: bytesutil.PadTo([]byte("root"), 32),
+			Source:          &ethpbv1.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			Target:          &ethpbv1.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("target"), 32)},
+		},
+		Signature: bytesutil.PadTo([]byte("sig"), 96),
+	}
+	enc, err := json.Marshal(encodeMessage(att))
+	require.NoError(t, err)
+
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(enc, &raw))
+	assert.Equal(t, "0x0d", raw["aggregation_bits"])
+	assert.Equal(t, "5", raw["data"].(map[string]interface{})["slot"])
+
+	res := &ethpbv1.Attestation{}
+	require.NoError(t, unmarshalJSON(enc, res))
+	assert.DeepEqual(t, att, res)
+}
+
+func TestEncodeMessage_EnumAndTimestamp(t *testing.T) {
+	enc := encodeMessage(&ethpbv1.ValidatorContainer{Status: ethpbv1.ValidatorStatus_PENDING_QUEUED})
+	assert.Equal(t, "pending_queued", enc["status"])
+
+	enc = encodeMessage(&ethpbv1.GenesisResponse_Genesis{GenesisTime: &timestamppb.Timestamp{Seconds: 100}})
+	assert.Equal(t, "100", enc["genesis_time"])
+}
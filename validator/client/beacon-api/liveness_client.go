@@ -0,0 +1,58 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+)
+
+var _ = iface.LivenessClient(&beaconApiLivenessClient{})
+
+// beaconApiLivenessClient implements the liveness calls used by the validator client on top of
+// the /eth/v1/validator/liveness endpoint of the beacon API.
+type beaconApiLivenessClient struct {
+	rest *restClient
+}
+
+// NewBeaconApiLivenessClient returns a liveness client backed by the beacon API served at the
+// given URL.
+func NewBeaconApiLivenessClient(endpoint string, timeout time.Duration) iface.LivenessClient {
+	return &beaconApiLivenessClient{rest: newRestClient(endpoint, &http.Client{Timeout: timeout})}
+}
+
+// GetLiveness returns whether the requested validators were seen active in the requested epoch.
+func (c *beaconApiLivenessClient) GetLiveness(ctx context.Context, in *ethpbv1.GetLivenessRequest, _ ...grpc.CallOption) (*ethpbv1.GetLivenessResponse, error) {
+	indices := make([]string, len(in.Index))
+	for i, idx := range in.Index {
+		indices[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	resp := &ethpbv1.GetLivenessResponse{}
+	if err := c.rest.post(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", in.Epoch), indices, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get liveness")
+	}
+	return resp, nil
+}
+
+// GetLiveness returns whether the requested validators were seen active in the requested epoch.
+func (c *beaconApiValidatorClient) GetLiveness(ctx context.Context, in *ethpb.LivenessRequest, _ ...grpc.CallOption) (*ethpb.LivenessResponse, error) {
+	lc := &beaconApiLivenessClient{rest: c.rest}
+	v1Resp, err := lc.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{Epoch: in.Epoch, Index: in.Indices})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpb.LivenessResponse{
+		Statuses: make([]*ethpb.LivenessResponse_Liveness, len(v1Resp.Data)),
+	}
+	for i, l := range v1Resp.Data {
+		resp.Statuses[i] = &ethpb.LivenessResponse_Liveness{Index: l.Index, IsLive: l.IsLive}
+	}
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestLivenessClient_GetLiveness(t *testing.T) {
+	want := &ethpbv1.GetLivenessResponse{
+		Data: []*ethpbv1.GetLivenessResponse_Liveness{
+			{Index: 1, IsLive: true},
+			{Index: 2, IsLive: false},
+		},
+	}
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/liveness/3", want, func(_ *http.Request, body []byte) {
+		assert.Equal(t, `["1","2"]`, string(body))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewBeaconApiLivenessClient(srv.URL, time.Second)
+
+	resp, err := c.GetLiveness(context.Background(), &ethpbv1.GetLivenessRequest{
+		Epoch: 3,
+		Index: []types.ValidatorIndex{1, 2},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, resp)
+}
+
+func TestValidatorClient_GetLiveness(t *testing.T) {
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/liveness/3", &ethpbv1.GetLivenessResponse{
+		Data: []*ethpbv1.GetLivenessResponse_Liveness{{Index: 1, IsLive: true}},
+	}, func(_ *http.Request, body []byte) {
+		assert.Equal(t, `["1"]`, string(body))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.GetLiveness(context.Background(), &ethpb.LivenessRequest{
+		Epoch:   3,
+		Indices: []types.ValidatorIndex{1},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.LivenessResponse{
+		Statuses: []*ethpb.LivenessResponse_Liveness{{Index: 1, IsLive: true}},
+	}, resp)
+}
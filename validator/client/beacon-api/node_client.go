@@ -0,0 +1,106 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = ethpb.NodeClient(&beaconApiNodeClient{})
+
+// beaconApiNodeClient implements the node calls used by the validator client on top of the
+// /eth/v1/node endpoints of the beacon API.
+type beaconApiNodeClient struct {
+	rest *restClient
+}
+
+// NewBeaconApiNodeClient returns a node client backed by the beacon API served at the given URL.
+func NewBeaconApiNodeClient(endpoint string, timeout time.Duration) ethpb.NodeClient {
+	return &beaconApiNodeClient{rest: newRestClient(endpoint, &http.Client{Timeout: timeout})}
+}
+
+// GetSyncStatus returns whether the beacon node is syncing.
+func (c *beaconApiNodeClient) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	resp := &ethpbv1.SyncingResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/node/syncing", nil, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync status")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty sync status response")
+	}
+	return &ethpb.SyncStatus{Syncing: resp.Data.IsSyncing}, nil
+}
+
+// GetGenesis returns the genesis of the chain and the address of the deposit contract.
+func (c *beaconApiNodeClient) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesis, err := getGenesis(ctx, c.rest)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	resp := &ethpbv1.DepositContractResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/config/deposit_contract", nil, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get deposit contract")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty deposit contract response")
+	}
+	address, err := hexutil.Decode(resp.Data.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit contract address")
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            timestamppb.New(genesis.GenesisTime.AsTime()),
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  genesis.GenesisValidatorsRoot,
+	}, nil
+}
+
+// GetVersion returns the version of the beacon node.
+func (c *beaconApiNodeClient) GetVersion(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Version, error) {
+	resp := &ethpbv1.VersionResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/node/version", nil, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get version")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty version response")
+	}
+	return &ethpb.Version{Version: resp.Data.Version}, nil
+}
+
+// ListImplementedServices is not supported.
+func (c *beaconApiNodeClient) ListImplementedServices(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ImplementedServices, error) {
+	return nil, errors.Wrap(errUnsupported, "ListImplementedServices")
+}
+
+// GetHost is not supported.
+func (c *beaconApiNodeClient) GetHost(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.HostData, error) {
+	return nil, errors.Wrap(errUnsupported, "GetHost")
+}
+
+// GetPeer is not supported.
+func (c *beaconApiNodeClient) GetPeer(_ context.Context, _ *ethpb.PeerRequest, _ ...grpc.CallOption) (*ethpb.Peer, error) {
+	return nil, errors.Wrap(errUnsupported, "GetPeer")
+}
+
+// ListPeers is not supported.
+func (c *beaconApiNodeClient) ListPeers(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Peers, error) {
+	return nil, errors.Wrap(errUnsupported, "ListPeers")
+}
+
+// GetBandwidth is not supported.
+func (c *beaconApiNodeClient) GetBandwidth(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Bandwidth, error) {
+	return nil, errors.Wrap(errUnsupported, "GetBandwidth")
+}
+
+// GetETH1ConnectionStatus is not supported.
+func (c *beaconApiNodeClient) GetETH1ConnectionStatus(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ETH1ConnectionStatus, error) {
+	return nil, errors.Wrap(errUnsupported, "GetETH1ConnectionStatus")
+}
@@ -0,0 +1,95 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNodeClient(t *testing.T) {
+	root := bytesutil.PadTo([]byte("root"), 32)
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/node/syncing", &ethpbv1.SyncingResponse{
+		Data: &ethpbv1.SyncInfo{HeadSlot: 10, SyncDistance: 5, IsSyncing: true},
+	}, nil)
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/genesis", &ethpbv1.GenesisResponse{
+		Data: &ethpbv1.GenesisResponse_Genesis{
+			GenesisTime:           &timestamppb.Timestamp{Seconds: 1000},
+			GenesisValidatorsRoot: root,
+			GenesisForkVersion:    []byte{0, 0, 0, 0},
+		},
+	}, nil)
+	serve(t, mux, http.MethodGet, "/eth/v1/config/deposit_contract", &ethpbv1.DepositContractResponse{
+		Data: &ethpbv1.DepositContract{ChainId: 1, Address: "0x00000000219ab540356cbb839cbe05303d7705fa"},
+	}, nil)
+	serve(t, mux, http.MethodGet, "/eth/v1/node/version", &ethpbv1.VersionResponse{
+		Data: &ethpbv1.Version{Version: "Lighthouse/v2.1.0"},
+	}, nil)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewBeaconApiNodeClient(srv.URL, time.Second)
+
+	syncStatus, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, syncStatus.Syncing)
+
+	genesis, err := c.GetGenesis(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), genesis.GenesisTime.Seconds)
+	assert.DeepEqual(t, root, genesis.GenesisValidatorsRoot)
+	assert.Equal(t, "0x00000000219ab540356cbb839cbe05303d7705fa", hexutil.Encode(genesis.DepositContractAddress))
+
+	version, err := c.GetVersion(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "Lighthouse/v2.1.0", version.Version)
+
+	_, err = c.ListPeers(context.Background(), &emptypb.Empty{})
+	require.ErrorContains(t, "not supported", err)
+}
+
+func TestBeaconChainClient_GetChainHead(t *testing.T) {
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	finalizedRoot := bytesutil.PadTo([]byte("finalized"), 32)
+	justifiedRoot := bytesutil.PadTo([]byte("justified"), 32)
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/headers/head", &ethpbv1.BlockHeaderResponse{
+		Data: &ethpbv1.BlockHeaderContainer{
+			Root:      headRoot,
+			Canonical: true,
+			Header:    &ethpbv1.BeaconBlockHeaderContainer{Message: &ethpbv1.BeaconBlockHeader{Slot: 100}},
+		},
+	}, nil)
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/finality_checkpoints", &ethpbv1.StateFinalityCheckpointResponse{
+		Data: &ethpbv1.StateFinalityCheckpointResponse_StateFinalityCheckpoint{
+			PreviousJustified: &ethpbv1.Checkpoint{Epoch: 1, Root: finalizedRoot},
+			CurrentJustified:  &ethpbv1.Checkpoint{Epoch: 2, Root: justifiedRoot},
+			Finalized:         &ethpbv1.Checkpoint{Epoch: 1, Root: finalizedRoot},
+		},
+	}, nil)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewBeaconApiBeaconChainClient(srv.URL, time.Second)
+
+	head, err := c.GetChainHead(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), uint64(head.HeadSlot))
+	assert.Equal(t, uint64(3), uint64(head.HeadEpoch))
+	assert.DeepEqual(t, headRoot, head.HeadBlockRoot)
+	assert.Equal(t, uint64(1), uint64(head.FinalizedEpoch))
+	assert.Equal(t, uint64(32), uint64(head.FinalizedSlot))
+	assert.DeepEqual(t, finalizedRoot, head.FinalizedBlockRoot)
+	assert.Equal(t, uint64(2), uint64(head.JustifiedEpoch))
+	assert.Equal(t, uint64(64), uint64(head.JustifiedSlot))
+	assert.DeepEqual(t, justifiedRoot, head.JustifiedBlockRoot)
+	assert.Equal(t, uint64(1), uint64(head.PreviousJustifiedEpoch))
+}
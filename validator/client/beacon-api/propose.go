@@ -0,0 +1,194 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Versions of the blocks returned by the beacon API. Beacon nodes which predate the naming of the
+// bellatrix fork call it merge.
+const (
+	versionPhase0    = "phase0"
+	versionAltair    = "altair"
+	versionBellatrix = "bellatrix"
+	versionMerge     = "merge"
+)
+
+// versionedResponse is a beacon API response holding a container whose type depends on the fork.
+type versionedResponse struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// sszObject is a container with an SSZ encoding.
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ([]byte) error
+}
+
+// sszMessage is a beacon API message with an SSZ encoding.
+type sszMessage interface {
+	proto.Message
+	sszObject
+}
+
+// convertSSZ copies a container between its beacon API and its Prysm representations, which have
+// the same SSZ encoding.
+func convertSSZ(from, to sszObject) error {
+	enc, err := from.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return to.UnmarshalSSZ(enc)
+}
+
+// GetBeaconBlock asks the beacon node to produce a block for the slot.
+func (c *beaconApiValidatorClient) GetBeaconBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.GenericBeaconBlock, error) {
+	query := url.Values{
+		"randao_reveal": []string{hexutil.Encode(in.RandaoReveal)},
+		"graffiti":      []string{hexutil.Encode(in.Graffiti)},
+	}
+	body, err := c.rest.getRaw(ctx, fmt.Sprintf("/eth/v2/validator/blocks/%d", in.Slot), query)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not produce block")
+	}
+	resp := &versionedResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode produced block")
+	}
+
+	switch strings.ToLower(resp.Version) {
+	case versionPhase0:
+		blk := &ethpbv1.BeaconBlock{}
+		res := &ethpb.BeaconBlock{}
+		if err := decodeBlock(resp.Data, blk, res); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: res}}, nil
+	case versionAltair:
+		blk := &ethpbv2.BeaconBlockAltair{}
+		res := &ethpb.BeaconBlockAltair{}
+		if err := decodeBlock(resp.Data, blk, res); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Altair{Altair: res}}, nil
+	case versionBellatrix, versionMerge:
+		blk := &ethpbv2.BeaconBlockMerge{}
+		res := &ethpb.BeaconBlockMerge{}
+		if err := decodeBlock(resp.Data, blk, res); err != nil {
+			return nil, err
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Merge{Merge: res}}, nil
+	default:
+		return nil, errors.Errorf("unsupported block version %q", resp.Version)
+	}
+}
+
+// GetBlock asks the beacon node to produce a phase 0 block for the slot.
+func (c *beaconApiValidatorClient) GetBlock(ctx context.Context, in *ethpb.BlockRequest, opts ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	blk, err := c.GetBeaconBlock(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if blk.GetPhase0() == nil {
+		return nil, errors.Errorf("beacon node produced a post phase 0 block for slot %d", in.Slot)
+	}
+	return blk.GetPhase0(), nil
+}
+
+// ProposeBeaconBlock publishes a signed block.
+func (c *beaconApiValidatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	var body map[string]interface{}
+	var root [32]byte
+	var err error
+	switch b := in.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		blk := &ethpbv1.SignedBeaconBlock{}
+		if err := convertSSZ(b.Phase0, blk); err != nil {
+			return nil, errors.Wrap(err, "could not convert block")
+		}
+		body = encodeMessage(blk)
+		root, err = b.Phase0.Block.HashTreeRoot()
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		blk := &ethpbv2.SignedBeaconBlockAltair{}
+		if err := convertSSZ(b.Altair, blk); err != nil {
+			return nil, errors.Wrap(err, "could not convert block")
+		}
+		body = encodeMessage(blk)
+		root, err = b.Altair.Block.HashTreeRoot()
+	case *ethpb.GenericSignedBeaconBlock_Merge:
+		blk := &ethpbv2.SignedBeaconBlockMerge{}
+		if err := convertSSZ(b.Merge, blk); err != nil {
+			return nil, errors.Wrap(err, "could not convert block")
+		}
+		body = encodeMessage(blk)
+		root, err = b.Merge.Block.HashTreeRoot()
+	default:
+		return nil, errors.Errorf("unsupported block type %T", in.Block)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	if err := c.rest.post(ctx, "/eth/v1/beacon/blocks", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish block")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// ProposeBlock publishes a signed phase 0 block.
+func (c *beaconApiValidatorClient) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	return c.ProposeBeaconBlock(ctx, &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: in},
+	}, opts...)
+}
+
+// ProposeExit submits a signed voluntary exit to the pool of the beacon node.
+func (c *beaconApiValidatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	if in.Exit == nil {
+		return nil, errors.New("nil voluntary exit")
+	}
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute exit root")
+	}
+	if err := c.rest.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", encodeMessage(migration.V1Alpha1ExitToV1(in)), nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit voluntary exit")
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
+
+// PrepareBeaconProposer registers the fee recipients of the validators with the beacon node.
+func (c *beaconApiValidatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	body := make([]proto.Message, len(in.Recipients))
+	for i, recipient := range in.Recipients {
+		body[i] = recipient
+	}
+	if err := c.rest.post(ctx, "/eth/v1/validator/prepare_beacon_proposer", encodeMessages(body...), nil); err != nil {
+		return nil, errors.Wrap(err, "could not prepare beacon proposer")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// decodeBlock decodes a container of the beacon API into its Prysm representation.
+func decodeBlock(data []byte, blk sszMessage, res sszObject) error {
+	if err := unmarshalJSON(data, blk); err != nil {
+		return errors.Wrap(err, "could not decode block")
+	}
+	if err := convertSSZ(blk, res); err != nil {
+		return errors.Wrap(err, "could not convert block")
+	}
+	return nil
+}
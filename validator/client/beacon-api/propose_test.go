@@ -0,0 +1,159 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// serveVersioned responds to the requests to path with a container of the given fork version.
+func serveVersioned(t *testing.T, mux *http.ServeMux, path, version string, data proto.Message, check func(r *http.Request)) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if check != nil {
+			check(r)
+		}
+		enc, err := json.Marshal(map[string]interface{}{"version": version, "data": encodeMessage(data)})
+		require.NoError(t, err)
+		_, err = w.Write(enc)
+		require.NoError(t, err)
+	})
+}
+
+func TestGetBeaconBlock_Phase0(t *testing.T) {
+	blk := util.NewBeaconBlock().Block
+	blk.Slot = 3
+	blk.Body.Graffiti = bytesutil.PadTo([]byte("graffiti"), 32)
+	v1Blk := &ethpbv1.BeaconBlock{}
+	require.NoError(t, convertSSZ(blk, v1Blk))
+	randao := bytesutil.PadTo([]byte("randao"), 96)
+
+	mux := http.NewServeMux()
+	serveVersioned(t, mux, "/eth/v2/validator/blocks/3", versionPhase0, v1Blk, func(r *http.Request) {
+		assert.Equal(t, hexutil.Encode(randao), r.URL.Query().Get("randao_reveal"))
+		assert.Equal(t, hexutil.Encode(blk.Body.Graffiti), r.URL.Query().Get("graffiti"))
+	})
+	c := setupClient(t, mux)
+
+	req := &ethpb.BlockRequest{Slot: 3, RandaoReveal: randao, Graffiti: blk.Body.Graffiti}
+	resp, err := c.GetBeaconBlock(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, resp.GetPhase0())
+
+	res, err := c.GetBlock(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, res)
+}
+
+func TestGetBeaconBlock_Altair(t *testing.T) {
+	blk := util.NewBeaconBlockAltair().Block
+	blk.Slot = 4
+	v2Blk := &ethpbv2.BeaconBlockAltair{}
+	require.NoError(t, convertSSZ(blk, v2Blk))
+
+	mux := http.NewServeMux()
+	serveVersioned(t, mux, "/eth/v2/validator/blocks/4", "ALTAIR", v2Blk, nil)
+	c := setupClient(t, mux)
+
+	req := &ethpb.BlockRequest{Slot: 4, RandaoReveal: make([]byte, 96), Graffiti: make([]byte, 32)}
+	resp, err := c.GetBeaconBlock(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, resp.GetAltair())
+
+	_, err = c.GetBlock(context.Background(), req)
+	require.ErrorContains(t, "post phase 0 block", err)
+}
+
+func TestGetBeaconBlock_UnknownVersion(t *testing.T) {
+	mux := http.NewServeMux()
+	serveVersioned(t, mux, "/eth/v2/validator/blocks/4", "capella", &ethpbv1.BeaconBlock{}, nil)
+	c := setupClient(t, mux)
+
+	_, err := c.GetBeaconBlock(context.Background(), &ethpb.BlockRequest{Slot: 4})
+	require.ErrorContains(t, `unsupported block version "capella"`, err)
+}
+
+func TestProposeBeaconBlock(t *testing.T) {
+	blk := util.NewBeaconBlockAltair()
+	blk.Block.Slot = 5
+	blk.Signature = bytesutil.PadTo([]byte("signature"), 96)
+	wantRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/beacon/blocks", nil, func(_ *http.Request, body []byte) {
+		published := &ethpbv2.SignedBeaconBlockAltair{}
+		require.NoError(t, unmarshalJSON(body, published))
+		res := &ethpb.SignedBeaconBlockAltair{}
+		require.NoError(t, convertSSZ(published, res))
+		assert.DeepEqual(t, blk, res)
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ProposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: &ethpb.GenericSignedBeaconBlock_Altair{Altair: blk},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantRoot[:], resp.BlockRoot)
+}
+
+func TestProposeBeaconBlock_Rejected(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/blocks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400,"message":"invalid block"}`))
+	})
+	c := setupClient(t, mux)
+
+	_, err := c.ProposeBlock(context.Background(), util.NewBeaconBlock())
+	require.ErrorContains(t, "invalid block", err)
+}
+
+func TestProposeExit(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 7},
+		Signature: bytesutil.PadTo([]byte("signature"), 96),
+	}
+	wantRoot, err := exit.Exit.HashTreeRoot()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/beacon/pool/voluntary_exits", nil, func(_ *http.Request, body []byte) {
+		published := &ethpbv1.SignedVoluntaryExit{}
+		require.NoError(t, unmarshalJSON(body, published))
+		assert.Equal(t, exit.Exit.ValidatorIndex, published.Message.ValidatorIndex)
+		assert.Equal(t, exit.Exit.Epoch, published.Message.Epoch)
+		assert.DeepEqual(t, exit.Signature, published.Signature)
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.ProposeExit(context.Background(), exit)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantRoot[:], resp.ExitRoot)
+}
+
+func TestPrepareBeaconProposer(t *testing.T) {
+	feeRecipient := bytesutil.PadTo([]byte("fee"), 20)
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/prepare_beacon_proposer", nil, func(_ *http.Request, body []byte) {
+		assert.Equal(t, `[{"fee_recipient":"`+hexutil.Encode(feeRecipient)+`","validator_index":"4"}]`, string(body))
+	})
+	c := setupClient(t, mux)
+
+	_, err := c.PrepareBeaconProposer(context.Background(), &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: feeRecipient, ValidatorIndex: 4},
+		},
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,159 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound is returned when the beacon node responds with 404 to a request.
+var ErrNotFound = errors.New("not found")
+
+// apiError is the error body returned by the beacon API.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// restClient sends JSON requests to the beacon API of a beacon node.
+type restClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func newRestClient(baseURL string, client *http.Client) *restClient {
+	return &restClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  client,
+	}
+}
+
+// get requests the endpoint at the given path with the query parameters and decodes the
+// JSON response into resp.
+func (c *restClient) get(ctx context.Context, path string, query url.Values, resp proto.Message) error {
+	body, err := c.getRaw(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(body, path, resp)
+}
+
+// getRaw requests the endpoint at the given path with the query parameters and returns the body
+// of the response.
+func (c *restClient) getRaw(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	if len(query) > 0 {
+		path = path + "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req, path)
+}
+
+// stream opens the event stream served at the given path. Unlike other requests, event streams
+// are not subject to the timeout of the client.
+func (c *restClient) stream(ctx context.Context, path string, query url.Values) (io.ReadCloser, error) {
+	if len(query) > 0 {
+		path = path + "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	streamClient := &http.Client{Transport: c.client.Transport}
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer closeBody(resp.Body)
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read response from %s", path)
+		}
+		if err := checkStatus(resp.StatusCode, body, path); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, path)
+	}
+	return resp.Body, nil
+}
+
+// post sends the JSON encoding of body to the endpoint at the given path and decodes the JSON
+// response into resp, unless resp is nil.
+func (c *restClient) post(ctx context.Context, path string, body interface{}, resp proto.Message) error {
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "could not encode request body")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(enc))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	respBody, err := c.do(req, path)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+	return decodeResponse(respBody, path, resp)
+}
+
+func (c *restClient) do(req *http.Request, path string) ([]byte, error) {
+	req.Header.Set("Accept", "application/json")
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(httpResp.Body)
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read response from %s", path)
+	}
+	if err := checkStatus(httpResp.StatusCode, body, path); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func decodeResponse(body []byte, path string, resp proto.Message) error {
+	if err := unmarshalJSON(body, resp); err != nil {
+		return errors.Wrapf(err, "could not decode response from %s", path)
+	}
+	return nil
+}
+
+// checkStatus returns an error carrying the message of the error body of a failed request.
+func checkStatus(code int, body []byte, path string) error {
+	if code == http.StatusOK || code == http.StatusAccepted {
+		return nil
+	}
+	msg := http.StatusText(code)
+	apiErr := &apiError{}
+	if err := json.Unmarshal(body, apiErr); err == nil && apiErr.Message != "" {
+		msg = apiErr.Message
+	}
+	if code == http.StatusNotFound {
+		return errors.Wrapf(ErrNotFound, "%s: %s", path, msg)
+	}
+	return fmt.Errorf("unexpected status code %d from %s: %s", code, path, msg)
+}
+
+func closeBody(body io.Closer) {
+	if err := body.Close(); err != nil {
+		log.WithError(err).Error("Could not close response body")
+	}
+}
@@ -0,0 +1,198 @@
+package beacon_api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// validatorsBatchSize is the number of validators requested at once from the validators endpoint,
+// which takes the validator ids as query parameters.
+const validatorsBatchSize = 64
+
+// nonExistentIndex is the index of a validator which is not in the beacon state, as returned by
+// the Prysm API.
+const nonExistentIndex = types.ValidatorIndex(^uint64(0))
+
+// keyStatus is the status and index of a validator key.
+type keyStatus struct {
+	status *ethpb.ValidatorStatusResponse
+	index  types.ValidatorIndex
+}
+
+// ValidatorIndex returns the index of the validator with the given public key.
+func (c *beaconApiValidatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	validators, err := c.stateValidators(ctx, [][]byte{in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	v, ok := validators[bytesutil.ToBytes48(in.PublicKey)]
+	if !ok {
+		return nil, errors.Errorf("could not find validator index for public key %#x", in.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{Index: v.Index}, nil
+}
+
+// ValidatorStatus returns the status of the validator with the given public key.
+func (c *beaconApiValidatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	statuses, err := c.validatorStatuses(ctx, [][]byte{in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	return statuses[0].status, nil
+}
+
+// MultipleValidatorStatus returns the statuses of the validators with the given public keys and
+// indices.
+func (c *beaconApiValidatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	pubKeys := make([][]byte, 0, len(in.PublicKeys)+len(in.Indices))
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, pubKey := range in.PublicKeys {
+		if !seen[bytesutil.ToBytes48(pubKey)] {
+			seen[bytesutil.ToBytes48(pubKey)] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	if len(in.Indices) > 0 {
+		ids := make([]string, len(in.Indices))
+		for i, idx := range in.Indices {
+			ids[i] = strconv.FormatInt(idx, 10)
+		}
+		validators, err := c.stateValidatorsByID(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range validators {
+			if v.Validator != nil && !seen[bytesutil.ToBytes48(v.Validator.Pubkey)] {
+				seen[bytesutil.ToBytes48(v.Validator.Pubkey)] = true
+				pubKeys = append(pubKeys, v.Validator.Pubkey)
+			}
+		}
+	}
+
+	statuses, err := c.validatorStatuses(ctx, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	res := &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: pubKeys,
+		Statuses:   make([]*ethpb.ValidatorStatusResponse, len(pubKeys)),
+		Indices:    make([]types.ValidatorIndex, len(pubKeys)),
+	}
+	for i, s := range statuses {
+		res.Statuses[i] = s.status
+		res.Indices[i] = s.index
+	}
+	return res, nil
+}
+
+// WaitForActivation returns a stream which polls the statuses of the validators.
+func (c *beaconApiValidatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &waitForActivationStream{
+		stream:     stream{ctx: ctx},
+		client:     c,
+		publicKeys: in.PublicKeys,
+	}, nil
+}
+
+// validatorStatuses returns the statuses of the validators with the given public keys, in the
+// same order. Validators which are not in the head state have an unknown status.
+func (c *beaconApiValidatorClient) validatorStatuses(ctx context.Context, pubKeys [][]byte) ([]*keyStatus, error) {
+	validators, err := c.stateValidators(ctx, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*keyStatus, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		v, ok := validators[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			statuses[i] = &keyStatus{
+				status: &ethpb.ValidatorStatusResponse{
+					Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+					ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+				},
+				index: nonExistentIndex,
+			}
+			continue
+		}
+		statuses[i] = &keyStatus{
+			status: &ethpb.ValidatorStatusResponse{
+				Status:          validatorStatus(v.Status),
+				ActivationEpoch: v.Validator.ActivationEpoch,
+			},
+			index: v.Index,
+		}
+	}
+	return statuses, nil
+}
+
+// stateValidators returns the validators with the given public keys in the head state, by public
+// key. Validators which are not in the head state are left out.
+func (c *beaconApiValidatorClient) stateValidators(ctx context.Context, pubKeys [][]byte) (map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer, error) {
+	ids := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		ids[i] = hexutil.Encode(pubKey)
+	}
+	validators, err := c.stateValidatorsByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[[fieldparams.BLSPubkeyLength]byte]*ethpbv1.ValidatorContainer, len(validators))
+	for _, v := range validators {
+		if v.Validator == nil {
+			continue
+		}
+		res[bytesutil.ToBytes48(v.Validator.Pubkey)] = v
+	}
+	return res, nil
+}
+
+// stateValidatorsByID requests the validators with the given ids, which are either public keys or
+// indices, from the head state in batches.
+func (c *beaconApiValidatorClient) stateValidatorsByID(ctx context.Context, ids []string) ([]*ethpbv1.ValidatorContainer, error) {
+	validators := make([]*ethpbv1.ValidatorContainer, 0, len(ids))
+	for start := 0; start < len(ids); start += validatorsBatchSize {
+		end := start + validatorsBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		resp := &ethpbv1.StateValidatorsResponse{}
+		if err := c.rest.get(ctx, "/eth/v1/beacon/states/head/validators", url.Values{"id": ids[start:end]}, resp); err != nil {
+			return nil, errors.Wrap(err, "could not get validators")
+		}
+		validators = append(validators, resp.Data...)
+	}
+	return validators, nil
+}
+
+// validatorStatus converts the status of a validator in the beacon API to its status in the
+// Prysm API.
+func validatorStatus(status ethpbv1.ValidatorStatus) ethpb.ValidatorStatus {
+	switch status {
+	case ethpbv1.ValidatorStatus_PENDING_INITIALIZED:
+		return ethpb.ValidatorStatus_DEPOSITED
+	case ethpbv1.ValidatorStatus_PENDING_QUEUED, ethpbv1.ValidatorStatus_PENDING:
+		return ethpb.ValidatorStatus_PENDING
+	case ethpbv1.ValidatorStatus_ACTIVE_ONGOING, ethpbv1.ValidatorStatus_ACTIVE:
+		return ethpb.ValidatorStatus_ACTIVE
+	case ethpbv1.ValidatorStatus_ACTIVE_EXITING:
+		return ethpb.ValidatorStatus_EXITING
+	case ethpbv1.ValidatorStatus_ACTIVE_SLASHED:
+		return ethpb.ValidatorStatus_SLASHING
+	case ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus_EXITED_SLASHED, ethpbv1.ValidatorStatus_EXITED,
+		ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE, ethpbv1.ValidatorStatus_WITHDRAWAL_DONE, ethpbv1.ValidatorStatus_WITHDRAWAL:
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
@@ -0,0 +1,135 @@
+package beacon_api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+const (
+	headTopic = "head"
+	// maxEventSize is the maximum size of a line of the event stream.
+	maxEventSize = 1 << 20
+)
+
+// StreamBlocksAltair streams the blocks which become the head of the beacon node, from the head
+// events of the event stream. Only phase 0 and altair blocks fit in the response, so blocks of
+// later forks are skipped.
+func (c *beaconApiValidatorClient) StreamBlocksAltair(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	body, err := c.rest.stream(ctx, "/eth/v1/events", url.Values{"topics": []string{headTopic}})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to head events")
+	}
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 4096), maxEventSize)
+	return &streamBlocksAltairStream{
+		stream:  stream{ctx: ctx},
+		client:  c,
+		body:    body,
+		scanner: scanner,
+	}, nil
+}
+
+// streamBlocksAltairStream reads head events from the event stream and fetches their blocks.
+type streamBlocksAltairStream struct {
+	stream
+	client  *beaconApiValidatorClient
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+}
+
+// Recv blocks until a new phase 0 or altair head block is received.
+func (s *streamBlocksAltairStream) Recv() (*ethpb.StreamBlocksResponse, error) {
+	for {
+		event, data, err := s.nextEvent()
+		if err != nil {
+			closeBody(s.body)
+			return nil, err
+		}
+		if event != headTopic {
+			continue
+		}
+		head := &ethpbv1.EventHead{}
+		if err := unmarshalJSON(data, head); err != nil {
+			return nil, errors.Wrap(err, "could not decode head event")
+		}
+		res, err := s.client.signedBlock(s.ctx, head.Block)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return res, nil
+		}
+	}
+}
+
+// nextEvent returns the type and data of the next event of the stream.
+func (s *streamBlocksAltairStream) nextEvent() (string, []byte, error) {
+	var event string
+	var data bytes.Buffer
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		switch {
+		case line == "":
+			// A blank line ends an event.
+			if data.Len() > 0 {
+				return event, data.Bytes(), nil
+			}
+			event = ""
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	if err := s.scanner.Err(); err != nil {
+		return "", nil, errors.Wrap(err, "could not read event stream")
+	}
+	return "", nil, io.EOF
+}
+
+// signedBlock fetches the block with the given root. It returns nil if the block is neither a
+// phase 0 nor an altair block.
+func (c *beaconApiValidatorClient) signedBlock(ctx context.Context, root []byte) (*ethpb.StreamBlocksResponse, error) {
+	body, err := c.rest.getRaw(ctx, fmt.Sprintf("/eth/v2/beacon/blocks/%s", hexutil.Encode(root)), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get block %#x", root)
+	}
+	resp := &versionedResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrapf(err, "could not decode block %#x", root)
+	}
+	switch strings.ToLower(resp.Version) {
+	case versionPhase0:
+		blk := &ethpbv1.SignedBeaconBlock{}
+		res := &ethpb.SignedBeaconBlock{}
+		if err := decodeBlock(resp.Data, blk, res); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_Phase0Block{Phase0Block: res}}, nil
+	case versionAltair:
+		blk := &ethpbv2.SignedBeaconBlockAltair{}
+		res := &ethpb.SignedBeaconBlockAltair{}
+		if err := decodeBlock(resp.Data, blk, res); err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_AltairBlock{AltairBlock: res}}, nil
+	default:
+		return nil, nil
+	}
+}
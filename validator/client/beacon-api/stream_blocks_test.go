@@ -0,0 +1,74 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func TestStreamBlocksAltair(t *testing.T) {
+	phase0Root := bytesutil.PadTo([]byte("phase0"), 32)
+	mergeRoot := bytesutil.PadTo([]byte("merge"), 32)
+	altairRoot := bytesutil.PadTo([]byte("altair"), 32)
+	phase0Blk := util.NewBeaconBlock()
+	phase0Blk.Block.Slot = 1
+	altairBlk := util.NewBeaconBlockAltair()
+	altairBlk.Block.Slot = 3
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "head", r.URL.Query().Get("topics"))
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, root := range [][]byte{phase0Root, mergeRoot, altairRoot} {
+			_, err := fmt.Fprintf(w, "event: head\ndata: {\"slot\":\"1\",\"block\":\"%s\"}\n\n", hexutil.Encode(root))
+			require.NoError(t, err)
+		}
+		// Events of other topics are skipped.
+		_, err := fmt.Fprint(w, "event: block\ndata: {\"slot\":\"4\"}\n\n")
+		require.NoError(t, err)
+	})
+	v1Blk := &ethpbv1.SignedBeaconBlock{}
+	require.NoError(t, convertSSZ(phase0Blk, v1Blk))
+	serveVersioned(t, mux, "/eth/v2/beacon/blocks/"+hexutil.Encode(phase0Root), versionPhase0, v1Blk, nil)
+	serveVersioned(t, mux, "/eth/v2/beacon/blocks/"+hexutil.Encode(mergeRoot), versionMerge, &ethpbv2.SignedBeaconBlockMerge{}, nil)
+	v2Blk := &ethpbv2.SignedBeaconBlockAltair{}
+	require.NoError(t, convertSSZ(altairBlk, v2Blk))
+	serveVersioned(t, mux, "/eth/v2/beacon/blocks/"+hexutil.Encode(altairRoot), versionAltair, v2Blk, nil)
+	c := setupClient(t, mux)
+
+	stream, err := c.StreamBlocksAltair(context.Background(), &ethpb.StreamBlocksRequest{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, phase0Blk, resp.GetPhase0Block())
+	// Blocks of later forks do not fit in the response and are skipped.
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.DeepEqual(t, altairBlk, resp.GetAltairBlock())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestStreamBlocksAltair_Unavailable(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"code":500,"message":"events are disabled"}`))
+	})
+	c := setupClient(t, mux)
+
+	_, err := c.StreamBlocksAltair(context.Background(), &ethpb.StreamBlocksRequest{})
+	require.ErrorContains(t, "events are disabled", err)
+}
@@ -0,0 +1,117 @@
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/metadata"
+)
+
+// stream implements the parts of grpc.ClientStream which are not used by the validator client,
+// for the server side streams of the Prysm API which are emulated on top of the beacon API.
+type stream struct {
+	ctx context.Context
+}
+
+// Header is not supported.
+func (s *stream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+// Trailer is not supported.
+func (s *stream) Trailer() metadata.MD {
+	return nil
+}
+
+// CloseSend is a no-op, as nothing is ever sent on the stream.
+func (s *stream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the stream.
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported.
+func (s *stream) SendMsg(interface{}) error {
+	return errors.Wrap(errUnsupported, "SendMsg")
+}
+
+// RecvMsg is not supported, use Recv instead.
+func (s *stream) RecvMsg(interface{}) error {
+	return errors.Wrap(errUnsupported, "RecvMsg")
+}
+
+// wait blocks for the given duration, or until the context of the stream is done.
+func (s *stream) wait(d time.Duration) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// waitForChainStartStream polls the genesis endpoint until the chain has started.
+type waitForChainStartStream struct {
+	stream
+	client *beaconApiValidatorClient
+}
+
+// Recv blocks until the chain has started, and returns its genesis.
+func (s *waitForChainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesis, err := s.client.getGenesis(s.ctx)
+		if err == nil {
+			return &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           uint64(genesis.GenesisTime.Seconds),
+				GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+			}, nil
+		}
+		// The genesis endpoint returns 404 until the chain has started.
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		if err := s.wait(s.client.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForActivationStream polls the statuses of the validators once per poll interval.
+type waitForActivationStream struct {
+	stream
+	client     *beaconApiValidatorClient
+	publicKeys [][]byte
+	polled     bool
+}
+
+// Recv returns the statuses of the validators. The first call returns immediately, the following
+// ones after the poll interval.
+func (s *waitForActivationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.polled {
+		if err := s.wait(s.client.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+	s.polled = true
+	statuses, err := s.client.validatorStatuses(s.ctx, s.publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	res := &ethpb.ValidatorActivationResponse{
+		Statuses: make([]*ethpb.ValidatorActivationResponse_Status, len(s.publicKeys)),
+	}
+	for i, pubKey := range s.publicKeys {
+		res.Statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pubKey,
+			Status:    statuses[i].status,
+			Index:     statuses[i].index,
+		}
+	}
+	return res, nil
+}
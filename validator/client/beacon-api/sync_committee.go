@@ -0,0 +1,117 @@
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetSyncMessageBlockRoot returns the root of the head block.
+func (c *beaconApiValidatorClient) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error) {
+	root, err := c.headRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SyncMessageBlockRootResponse{Root: root}, nil
+}
+
+// SubmitSyncMessage submits a sync committee message to the pool of the beacon node.
+func (c *beaconApiValidatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	msg := &ethpbv2.SyncCommitteeMessage{
+		Slot:            in.Slot,
+		BeaconBlockRoot: in.BlockRoot,
+		ValidatorIndex:  in.ValidatorIndex,
+		Signature:       in.Signature,
+	}
+	if err := c.rest.post(ctx, "/eth/v1/beacon/pool/sync_committees", encodeMessages(msg), nil); err != nil {
+		return nil, errors.Wrap(err, "could not submit sync committee message")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex returns the indices of the validator in the sync committee of the slot.
+func (c *beaconApiValidatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest, _ ...grpc.CallOption) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	index, err := c.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ethpbv2.SyncCommitteeDutiesResponse{}
+	body := []interface{}{strconv.FormatUint(uint64(index.Index), 10)}
+	if err := c.rest.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/sync/%d", slots.ToEpoch(in.Slot)), body, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee duties")
+	}
+	indices := make([]types.CommitteeIndex, 0)
+	for _, duty := range resp.Data {
+		if duty.ValidatorIndex != index.Index {
+			continue
+		}
+		for _, idx := range duty.ValidatorSyncCommitteeIndices {
+			indices = append(indices, types.CommitteeIndex(idx))
+		}
+	}
+	return &ethpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+// GetSyncCommitteeContribution asks the beacon node to aggregate the sync committee messages of a
+// subcommittee for the head block.
+func (c *beaconApiValidatorClient) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest, _ ...grpc.CallOption) (*ethpb.SyncCommitteeContribution, error) {
+	root, err := c.headRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{
+		"slot":               []string{strconv.FormatUint(uint64(in.Slot), 10)},
+		"subcommittee_index": []string{strconv.FormatUint(in.SubnetId, 10)},
+		"beacon_block_root":  []string{hexutil.Encode(root)},
+	}
+	resp := &ethpbv2.ProduceSyncCommitteeContributionResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/validator/sync_committee_contribution", query, resp); err != nil {
+		return nil, errors.Wrap(err, "could not produce sync committee contribution")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty sync committee contribution response")
+	}
+	return &ethpb.SyncCommitteeContribution{
+		Slot:              resp.Data.Slot,
+		BlockRoot:         resp.Data.BeaconBlockRoot,
+		SubcommitteeIndex: resp.Data.SubcommitteeIndex,
+		AggregationBits:   resp.Data.AggregationBits,
+		Signature:         resp.Data.Signature,
+	}, nil
+}
+
+// SubmitSignedContributionAndProof publishes a signed sync committee contribution.
+func (c *beaconApiValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	if in.Message == nil || in.Message.Contribution == nil {
+		return nil, errors.New("nil signed contribution and proof")
+	}
+	body := encodeMessages(migration.V1Alpha1SignedContributionAndProofToV2(in))
+	if err := c.rest.post(ctx, "/eth/v1/validator/contribution_and_proofs", body, nil); err != nil {
+		return nil, errors.Wrap(err, "could not publish sync committee contribution")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// headRoot returns the root of the head block.
+func (c *beaconApiValidatorClient) headRoot(ctx context.Context) ([]byte, error) {
+	resp := &ethpbv1.BlockRootResponse{}
+	if err := c.rest.get(ctx, "/eth/v1/beacon/blocks/head/root", nil, resp); err != nil {
+		return nil, errors.Wrap(err, "could not get head block root")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty block root response")
+	}
+	return resp.Data.Root, nil
+}
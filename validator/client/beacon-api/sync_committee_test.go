@@ -0,0 +1,143 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func serveHeadRoot(t *testing.T, mux *http.ServeMux, root []byte) {
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/blocks/head/root", &ethpbv1.BlockRootResponse{
+		Data: &ethpbv1.BlockRootContainer{Root: root},
+	}, nil)
+}
+
+func TestGetSyncMessageBlockRoot(t *testing.T) {
+	root := bytesutil.PadTo([]byte("head"), 32)
+	mux := http.NewServeMux()
+	serveHeadRoot(t, mux, root)
+	c := setupClient(t, mux)
+
+	resp, err := c.GetSyncMessageBlockRoot(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, root, resp.Root)
+}
+
+func TestSubmitSyncMessage(t *testing.T) {
+	msg := &ethpb.SyncCommitteeMessage{
+		Slot:           12,
+		BlockRoot:      bytesutil.PadTo([]byte("head"), 32),
+		ValidatorIndex: 4,
+		Signature:      bytesutil.PadTo([]byte("signature"), 96),
+	}
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/beacon/pool/sync_committees", nil, func(_ *http.Request, body []byte) {
+		published := &ethpbv2.SubmitPoolSyncCommitteeSignatures{}
+		require.NoError(t, unmarshalJSON([]byte(`{"data":`+string(body)+`}`), published))
+		require.Equal(t, 1, len(published.Data))
+		assert.DeepEqual(t, &ethpbv2.SyncCommitteeMessage{
+			Slot:            msg.Slot,
+			BeaconBlockRoot: msg.BlockRoot,
+			ValidatorIndex:  msg.ValidatorIndex,
+			Signature:       msg.Signature,
+		}, published.Data[0])
+	})
+	c := setupClient(t, mux)
+
+	_, err := c.SubmitSyncMessage(context.Background(), msg)
+	require.NoError(t, err)
+}
+
+func TestGetSyncSubcommitteeIndex(t *testing.T) {
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/validators", &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{{Index: 4, Validator: &ethpbv1.Validator{Pubkey: pubKey(1)}}},
+	}, nil)
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/duties/sync/2", &ethpbv2.SyncCommitteeDutiesResponse{
+		Data: []*ethpbv2.SyncCommitteeDuty{{Pubkey: pubKey(1), ValidatorIndex: 4, ValidatorSyncCommitteeIndices: []uint64{3, 200}}},
+	}, func(_ *http.Request, body []byte) {
+		assert.Equal(t, `["4"]`, string(body))
+	})
+	c := setupClient(t, mux)
+
+	slot := 2*params.BeaconConfig().SlotsPerEpoch + 1
+	resp, err := c.GetSyncSubcommitteeIndex(context.Background(), &ethpb.SyncSubcommitteeIndexRequest{PublicKey: pubKey(1), Slot: slot})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.CommitteeIndex{3, 200}, resp.Indices)
+}
+
+func TestGetSyncCommitteeContribution(t *testing.T) {
+	root := bytesutil.PadTo([]byte("head"), 32)
+	contribution := &ethpb.SyncCommitteeContribution{
+		Slot:              12,
+		BlockRoot:         root,
+		SubcommitteeIndex: 1,
+		AggregationBits:   bytesutil.PadTo([]byte{0b11}, 16),
+		Signature:         bytesutil.PadTo([]byte("signature"), 96),
+	}
+	mux := http.NewServeMux()
+	serveHeadRoot(t, mux, root)
+	serve(t, mux, http.MethodGet, "/eth/v1/validator/sync_committee_contribution", &ethpbv2.ProduceSyncCommitteeContributionResponse{
+		Data: &ethpbv2.SyncCommitteeContribution{
+			Slot:              contribution.Slot,
+			BeaconBlockRoot:   contribution.BlockRoot,
+			SubcommitteeIndex: contribution.SubcommitteeIndex,
+			AggregationBits:   contribution.AggregationBits,
+			Signature:         contribution.Signature,
+		},
+	}, func(r *http.Request, _ []byte) {
+		assert.Equal(t, "12", r.URL.Query().Get("slot"))
+		assert.Equal(t, "1", r.URL.Query().Get("subcommittee_index"))
+		assert.Equal(t, hexutil.Encode(root), r.URL.Query().Get("beacon_block_root"))
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.GetSyncCommitteeContribution(context.Background(), &ethpb.SyncCommitteeContributionRequest{
+		Slot:      12,
+		PublicKey: pubKey(1),
+		SubnetId:  1,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, contribution, resp)
+}
+
+func TestSubmitSignedContributionAndProof(t *testing.T) {
+	signed := &ethpb.SignedContributionAndProof{
+		Message: &ethpb.ContributionAndProof{
+			AggregatorIndex: 4,
+			Contribution: &ethpb.SyncCommitteeContribution{
+				Slot:              12,
+				BlockRoot:         bytesutil.PadTo([]byte("head"), 32),
+				SubcommitteeIndex: 1,
+				AggregationBits:   bytesutil.PadTo([]byte{0b11}, 16),
+				Signature:         bytesutil.PadTo([]byte("contribution"), 96),
+			},
+			SelectionProof: bytesutil.PadTo([]byte("proof"), 96),
+		},
+		Signature: bytesutil.PadTo([]byte("signature"), 96),
+	}
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/contribution_and_proofs", nil, func(_ *http.Request, body []byte) {
+		published := &ethpbv2.SubmitContributionAndProofsRequest{}
+		require.NoError(t, unmarshalJSON([]byte(`{"data":`+string(body)+`}`), published))
+		require.Equal(t, 1, len(published.Data))
+		assert.Equal(t, signed.Message.AggregatorIndex, published.Data[0].Message.AggregatorIndex)
+		assert.DeepEqual(t, signed.Message.Contribution.AggregationBits, published.Data[0].Message.Contribution.AggregationBits)
+		assert.DeepEqual(t, signed.Signature, published.Data[0].Signature)
+	})
+	c := setupClient(t, mux)
+
+	_, err := c.SubmitSignedContributionAndProof(context.Background(), signed)
+	require.NoError(t, err)
+}
@@ -0,0 +1,115 @@
+// Package beacon_api implements the beacon node clients used by the validator client on top of
+// the standard beacon node REST API, so that the validator client can run against any beacon
+// node implementation rather than only against a Prysm beacon node over gRPC.
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// errUnsupported is returned by the calls which have no equivalent in the beacon API.
+var errUnsupported = errors.New("not supported by the beacon API")
+
+var _ = ethpb.BeaconNodeValidatorClient(&beaconApiValidatorClient{})
+
+// beaconApiValidatorClient implements the validator calls of the beacon node on top of the
+// /eth/v1 and /eth/v2 validator endpoints of the beacon API.
+type beaconApiValidatorClient struct {
+	rest         *restClient
+	pollInterval time.Duration
+
+	genesisLock sync.Mutex
+	genesis     *ethpbv1.GenesisResponse_Genesis
+
+	// The beacon API needs the validator index and the number of committees of the slot to
+	// subscribe to a committee subnet, which the Prysm API looks up on the beacon node. They are
+	// kept from the attester duties of the last call to GetDuties.
+	subscriptionsLock sync.RWMutex
+	subscriptions     map[subscriptionKey][]*ethpbv1.AttesterDuty
+}
+
+// NewBeaconApiValidatorClient returns a validator client backed by the beacon API served at the
+// given URL.
+func NewBeaconApiValidatorClient(endpoint string, timeout time.Duration) ethpb.BeaconNodeValidatorClient {
+	return newBeaconApiValidatorClient(newRestClient(endpoint, &http.Client{Timeout: timeout}))
+}
+
+func newBeaconApiValidatorClient(rest *restClient) *beaconApiValidatorClient {
+	return &beaconApiValidatorClient{
+		rest:          rest,
+		pollInterval:  time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		subscriptions: make(map[subscriptionKey][]*ethpbv1.AttesterDuty),
+	}
+}
+
+// DomainData computes the signature domain from the fork schedule of the configuration and the
+// genesis validators root of the beacon node.
+func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	genesis, err := c.getGenesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	fork, err := forks.Fork(in.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := signing.Domain(fork, in.Epoch, bytesutil.ToBytes4(in.Domain), genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// StreamDuties is not supported, the validator client polls for duties with GetDuties.
+func (c *beaconApiValidatorClient) StreamDuties(_ context.Context, _ *ethpb.DutiesRequest, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return nil, errors.Wrap(errUnsupported, "StreamDuties")
+}
+
+// CheckDoppelGanger is not supported.
+func (c *beaconApiValidatorClient) CheckDoppelGanger(_ context.Context, _ *ethpb.DoppelGangerRequest, _ ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	return nil, errors.Wrap(errUnsupported, "CheckDoppelGanger")
+}
+
+// WaitForChainStart returns a stream which polls the genesis endpoint until the chain has started.
+func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &waitForChainStartStream{stream: stream{ctx: ctx}, client: c}, nil
+}
+
+// getGenesis returns the genesis of the beacon node, which is cached once the chain has started.
+func (c *beaconApiValidatorClient) getGenesis(ctx context.Context) (*ethpbv1.GenesisResponse_Genesis, error) {
+	c.genesisLock.Lock()
+	defer c.genesisLock.Unlock()
+	if c.genesis != nil {
+		return c.genesis, nil
+	}
+	genesis, err := getGenesis(ctx, c.rest)
+	if err != nil {
+		return nil, err
+	}
+	c.genesis = genesis
+	return genesis, nil
+}
+
+func getGenesis(ctx context.Context, rest *restClient) (*ethpbv1.GenesisResponse_Genesis, error) {
+	resp := &ethpbv1.GenesisResponse{}
+	if err := rest.get(ctx, "/eth/v1/beacon/genesis", nil, resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil || resp.Data.GenesisTime == nil {
+		return nil, errors.New("empty genesis response")
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,321 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// setupClient returns a validator client talking to a stand-in beacon node serving mux.
+func setupClient(t *testing.T, mux *http.ServeMux) *beaconApiValidatorClient {
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return newBeaconApiValidatorClient(newRestClient(srv.URL, srv.Client()))
+}
+
+// serve responds to the requests to path with the beacon API representation of resp, after
+// checking their method. The body of the requests is passed to check, if set.
+func serve(t *testing.T, mux *http.ServeMux, method, path string, resp proto.Message, check func(r *http.Request, body []byte)) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, method, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		if check != nil {
+			check(r, body)
+		}
+		if resp == nil {
+			return
+		}
+		enc, err := json.Marshal(encodeMessage(resp))
+		require.NoError(t, err)
+		_, err = w.Write(enc)
+		require.NoError(t, err)
+	})
+}
+
+// serveNotFound responds to the requests to path with the error body of the beacon API.
+func serveNotFound(mux *http.ServeMux, path string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":404,"message":"not found"}`))
+	})
+}
+
+func pubKey(i byte) []byte {
+	return bytesutil.PadTo([]byte{i}, 48)
+}
+
+func TestGetDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/validators", &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{{
+			Index:     3,
+			Status:    ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+			Validator: &ethpbv1.Validator{Pubkey: pubKey(1)},
+		}},
+	}, func(r *http.Request, _ []byte) {
+		assert.DeepEqual(t, []string{hexutil.Encode(pubKey(1)), hexutil.Encode(pubKey(2))}, r.URL.Query()["id"])
+	})
+	checkIndices := func(_ *http.Request, body []byte) {
+		assert.Equal(t, `["3"]`, string(body))
+	}
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/duties/attester/0", &ethpbv1.AttesterDutiesResponse{
+		Data: []*ethpbv1.AttesterDuty{{Pubkey: pubKey(1), ValidatorIndex: 3, CommitteeIndex: 1, CommitteesAtSlot: 2, Slot: 5}},
+	}, checkIndices)
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/duties/attester/1", &ethpbv1.AttesterDutiesResponse{
+		Data: []*ethpbv1.AttesterDuty{{Pubkey: pubKey(1), ValidatorIndex: 3, CommitteeIndex: 0, CommitteesAtSlot: 2, Slot: 40}},
+	}, checkIndices)
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/committees", &ethpbv1.StateCommitteesResponse{
+		Data: []*ethpbv1.Committee{
+			{Slot: 5, Index: 1, Validators: []types.ValidatorIndex{7, 3}},
+			{Slot: 40, Index: 0, Validators: []types.ValidatorIndex{3, 9}},
+		},
+	}, nil)
+	serve(t, mux, http.MethodGet, "/eth/v1/validator/duties/proposer/0", &ethpbv1.ProposerDutiesResponse{
+		Data: []*ethpbv1.ProposerDuty{{Pubkey: pubKey(1), ValidatorIndex: 3, Slot: 6}, {ValidatorIndex: 8, Slot: 7}},
+	}, nil)
+	serveNotFound(mux, "/eth/v1/validator/duties/proposer/1")
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/duties/sync/0", &ethpbv2.SyncCommitteeDutiesResponse{}, checkIndices)
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/duties/sync/1", &ethpbv2.SyncCommitteeDutiesResponse{
+		Data: []*ethpbv2.SyncCommitteeDuty{{Pubkey: pubKey(1), ValidatorIndex: 3, ValidatorSyncCommitteeIndices: []uint64{4}}},
+	}, checkIndices)
+	c := setupClient(t, mux)
+
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 0, PublicKeys: [][]byte{pubKey(1), pubKey(2)}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	assert.DeepEqual(t, resp.CurrentEpochDuties, resp.Duties)
+	assert.DeepEqual(t, &ethpb.DutiesResponse_Duty{
+		Committee:      []types.ValidatorIndex{7, 3},
+		CommitteeIndex: 1,
+		AttesterSlot:   5,
+		ProposerSlots:  []types.Slot{6},
+		PublicKey:      pubKey(1),
+		Status:         ethpb.ValidatorStatus_ACTIVE,
+		ValidatorIndex: 3,
+	}, resp.CurrentEpochDuties[0])
+	assert.DeepEqual(t, &ethpb.DutiesResponse_Duty{
+		PublicKey:      pubKey(2),
+		Status:         ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		ValidatorIndex: nonExistentIndex,
+	}, resp.CurrentEpochDuties[1])
+	assert.DeepEqual(t, &ethpb.DutiesResponse_Duty{
+		Committee:       []types.ValidatorIndex{3, 9},
+		CommitteeIndex:  0,
+		AttesterSlot:    40,
+		PublicKey:       pubKey(1),
+		Status:          ethpb.ValidatorStatus_ACTIVE,
+		ValidatorIndex:  3,
+		IsSyncCommittee: true,
+	}, resp.NextEpochDuties[0])
+
+	// The attester duties are kept to subscribe to the committee subnets.
+	mux.HandleFunc("/eth/v1/validator/beacon_committee_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var subs []map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &subs))
+		require.Equal(t, 1, len(subs))
+		assert.DeepEqual(t, map[string]interface{}{
+			"validator_index":    "3",
+			"committee_index":    "1",
+			"committees_at_slot": "2",
+			"slot":               "5",
+			"is_aggregator":      true,
+		}, subs[0])
+	})
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{5, 6},
+		CommitteeIds: []types.CommitteeIndex{1, 1},
+		IsAggregator: []bool{true, false},
+	})
+	require.NoError(t, err)
+}
+
+func TestGetDuties_CurrentEpochProposersUnknown(t *testing.T) {
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/validators", &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{{
+			Index:     3,
+			Status:    ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+			Validator: &ethpbv1.Validator{Pubkey: pubKey(1)},
+		}},
+	}, nil)
+	serve(t, mux, http.MethodPost, "/eth/v1/validator/duties/attester/0", &ethpbv1.AttesterDutiesResponse{}, nil)
+	serveNotFound(mux, "/eth/v1/validator/duties/proposer/0")
+	c := setupClient(t, mux)
+
+	// Unlike those of the next epoch, the proposers of the current epoch must be known.
+	_, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 0, PublicKeys: [][]byte{pubKey(1)}})
+	require.ErrorContains(t, "could not get duties for epoch 0: could not get proposer duties", err)
+}
+func TestSubscribeCommitteeSubnets_NoDuties(t *testing.T) {
+	c := setupClient(t, http.NewServeMux())
+	_, err := c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{5},
+		CommitteeIds: []types.CommitteeIndex{1},
+		IsAggregator: []bool{true},
+	})
+	require.NoError(t, err)
+}
+
+func TestMultipleValidatorStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators", func(w http.ResponseWriter, r *http.Request) {
+		resp := &ethpbv1.StateValidatorsResponse{}
+		for _, id := range r.URL.Query()["id"] {
+			switch id {
+			case hexutil.Encode(pubKey(1)):
+				resp.Data = append(resp.Data, &ethpbv1.ValidatorContainer{
+					Index:     1,
+					Status:    ethpbv1.ValidatorStatus_PENDING_QUEUED,
+					Validator: &ethpbv1.Validator{Pubkey: pubKey(1), ActivationEpoch: params.BeaconConfig().FarFutureEpoch},
+				})
+			case "5", hexutil.Encode(pubKey(5)):
+				resp.Data = append(resp.Data, &ethpbv1.ValidatorContainer{
+					Index:     5,
+					Status:    ethpbv1.ValidatorStatus_EXITED_SLASHED,
+					Validator: &ethpbv1.Validator{Pubkey: pubKey(5), ActivationEpoch: 1, ExitEpoch: 3},
+				})
+			}
+		}
+		enc, err := json.Marshal(encodeMessage(resp))
+		require.NoError(t, err)
+		_, err = w.Write(enc)
+		require.NoError(t, err)
+	})
+	c := setupClient(t, mux)
+
+	resp, err := c.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{pubKey(1), pubKey(2)},
+		Indices:    []int64{5},
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKey(1), pubKey(2), pubKey(5)}, resp.PublicKeys)
+	assert.DeepEqual(t, []types.ValidatorIndex{1, nonExistentIndex, 5}, resp.Indices)
+	require.Equal(t, 3, len(resp.Statuses))
+	assert.Equal(t, ethpb.ValidatorStatus_PENDING, resp.Statuses[0].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_EXITED, resp.Statuses[2].Status)
+	assert.Equal(t, types.Epoch(1), resp.Statuses[2].ActivationEpoch)
+
+	index, err := c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey(5)})
+	require.NoError(t, err)
+	assert.Equal(t, types.ValidatorIndex(5), index.Index)
+	_, err = c.ValidatorIndex(context.Background(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey(2)})
+	require.ErrorContains(t, "could not find validator index", err)
+}
+
+func TestWaitForActivation(t *testing.T) {
+	mux := http.NewServeMux()
+	serve(t, mux, http.MethodGet, "/eth/v1/beacon/states/head/validators", &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{{
+			Index:     1,
+			Status:    ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+			Validator: &ethpbv1.Validator{Pubkey: pubKey(1)},
+		}},
+	}, nil)
+	c := setupClient(t, mux)
+	c.pollInterval = time.Millisecond
+
+	stream, err := c.WaitForActivation(context.Background(), &ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{pubKey(1), pubKey(2)}})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Statuses))
+		assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, resp.Statuses[0].Status.Status)
+		assert.Equal(t, types.ValidatorIndex(1), resp.Statuses[0].Index)
+		assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.Statuses[1].Status.Status)
+	}
+}
+
+func TestWaitForChainStart(t *testing.T) {
+	root := bytesutil.PadTo([]byte("root"), 32)
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		enc, err := json.Marshal(encodeMessage(&ethpbv1.GenesisResponse{Data: &ethpbv1.GenesisResponse_Genesis{
+			GenesisTime:           &timestamppb.Timestamp{Seconds: 1000},
+			GenesisValidatorsRoot: root,
+			GenesisForkVersion:    params.BeaconConfig().GenesisForkVersion,
+		}}))
+		require.NoError(t, err)
+		_, err = w.Write(enc)
+		require.NoError(t, err)
+	})
+	c := setupClient(t, mux)
+	c.pollInterval = time.Millisecond
+
+	stream, err := c.WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	assert.DeepEqual(t, &ethpb.ChainStartResponse{Started: true, GenesisTime: 1000, GenesisValidatorsRoot: root}, resp)
+
+	// The genesis is cached once the chain has started.
+	epoch := types.Epoch(0)
+	domain, err := c.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: epoch, Domain: params.BeaconConfig().DomainBeaconAttester[:]})
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	fork, err := forks.Fork(epoch)
+	require.NoError(t, err)
+	want, err := signing.Domain(fork, epoch, params.BeaconConfig().DomainBeaconAttester, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, domain.SignatureDomain)
+}
+
+func TestWaitForChainStart_ContextCanceled(t *testing.T) {
+	mux := http.NewServeMux()
+	serveNotFound(mux, "/eth/v1/beacon/genesis")
+	c := setupClient(t, mux)
+	c.pollInterval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.WaitForChainStart(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	cancel()
+	_, err = stream.Recv()
+	require.ErrorContains(t, "context canceled", err)
+}
+
+func TestRestClient_ErrorMessage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"code":503,"message":"beacon node is syncing"}`))
+	})
+	c := setupClient(t, mux)
+	_, err := c.getGenesis(context.Background())
+	require.ErrorContains(t, "unexpected status code 503 from /eth/v1/beacon/genesis: beacon node is syncing", err)
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// DefaultDoppelgangerEpochs is the default number of epochs during which the liveness of a newly
+// added key is watched before it is allowed to sign.
+const DefaultDoppelgangerEpochs = 2
+
+// doppelgangerCheck is the state of the doppelganger protection of a key.
+type doppelgangerCheck struct {
+	// lastCheckedEpoch is the latest epoch in which the key was seen inactive. It starts at the
+	// epoch in which the key was added, which is never checked as a previous run of this validator
+	// client may have signed in it.
+	lastCheckedEpoch types.Epoch
+	passedEpochs     types.Epoch
+	detected         bool
+}
+
+// doppelgangerTracker keeps the validating keys which are not allowed to sign until they were seen
+// inactive in the network for a number of epochs, meaning that no other instance signs with them.
+// Keys in which a doppelganger is detected are never allowed to sign.
+type doppelgangerTracker struct {
+	sync.RWMutex
+	epochs  types.Epoch
+	known   map[[fieldparams.BLSPubkeyLength]byte]bool
+	pending map[[fieldparams.BLSPubkeyLength]byte]*doppelgangerCheck
+}
+
+func newDoppelgangerTracker(epochs types.Epoch) *doppelgangerTracker {
+	return &doppelgangerTracker{
+		epochs:  epochs,
+		known:   make(map[[fieldparams.BLSPubkeyLength]byte]bool),
+		pending: make(map[[fieldparams.BLSPubkeyLength]byte]*doppelgangerCheck),
+	}
+}
+
+// trackKeys starts the doppelganger protection of the keys which were not validating keys before
+// the given epoch. Keys which are no longer validating keys are forgotten, so that they are checked
+// again if they are added back.
+func (t *doppelgangerTracker) trackKeys(keys [][fieldparams.BLSPubkeyLength]byte, epoch types.Epoch) {
+	t.Lock()
+	defer t.Unlock()
+	current := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(keys))
+	for _, key := range keys {
+		current[key] = true
+		if t.known[key] {
+			continue
+		}
+		t.known[key] = true
+		t.pending[key] = &doppelgangerCheck{lastCheckedEpoch: epoch}
+		log.WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(key[:])),
+			"epochs":    t.epochs,
+		}).Info("Validator key is waiting for doppelganger protection before signing")
+	}
+	for key := range t.known {
+		if !current[key] {
+			delete(t.known, key)
+			delete(t.pending, key)
+		}
+	}
+}
+
+// protected returns true if the key is not allowed to sign.
+func (t *doppelgangerTracker) protected(key [fieldparams.BLSPubkeyLength]byte) bool {
+	if t == nil {
+		return false
+	}
+	t.RLock()
+	defer t.RUnlock()
+	_, ok := t.pending[key]
+	return ok
+}
+
+// keysToCheck returns the keys whose liveness in the given epoch is not known yet.
+func (t *doppelgangerTracker) keysToCheck(epoch types.Epoch) map[[fieldparams.BLSPubkeyLength]byte]bool {
+	t.RLock()
+	defer t.RUnlock()
+	keys := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for key, check := range t.pending {
+		if !check.detected && epoch > check.lastCheckedEpoch {
+			keys[key] = true
+		}
+	}
+	return keys
+}
+
+// update records the liveness of the key in the given epoch. The key is allowed to sign once it
+// was seen inactive for enough epochs.
+func (t *doppelgangerTracker) update(key [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, live bool) {
+	t.Lock()
+	defer t.Unlock()
+	check, ok := t.pending[key]
+	if !ok || check.detected || epoch <= check.lastCheckedEpoch {
+		return
+	}
+	log := log.WithFields(logrus.Fields{
+		"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(key[:])),
+		"epoch":     epoch,
+	})
+	if live {
+		check.detected = true
+		log.Error("Doppelganger detected, another instance is signing with the validator key. " +
+			"The key will not be used to sign until the validator client is restarted")
+		return
+	}
+	check.lastCheckedEpoch = epoch
+	check.passedEpochs++
+	if check.passedEpochs >= t.epochs {
+		delete(t.pending, key)
+		log.Info("Doppelganger protection passed, validator key is allowed to sign")
+	}
+}
+
+// checkDoppelgangers requests the liveness in the previous epoch of the keys waiting for
+// doppelganger protection. Keys without an active validator cannot be seen active, so their
+// check passes without asking the beacon node.
+func (v *validator) checkDoppelgangers(ctx context.Context, epoch types.Epoch, duties []*ethpb.DutiesResponse_Duty) error {
+	if v.doppelganger == nil || epoch == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.checkDoppelgangers")
+	defer span.End()
+
+	checkedEpoch := epoch - 1
+	keys := v.doppelganger.keysToCheck(checkedEpoch)
+	if len(keys) == 0 {
+		return nil
+	}
+	indexToKey := make(map[types.ValidatorIndex][fieldparams.BLSPubkeyLength]byte)
+	indices := make([]types.ValidatorIndex, 0, len(keys))
+	for _, duty := range duties {
+		key := bytesutil.ToBytes48(duty.PublicKey)
+		if !keys[key] {
+			continue
+		}
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+			continue
+		}
+		indexToKey[duty.ValidatorIndex] = key
+		indices = append(indices, duty.ValidatorIndex)
+	}
+
+	live := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	if len(indices) > 0 {
+		resp, err := v.livenessClient.GetLiveness(ctx, &ethpbv1.GetLivenessRequest{
+			Epoch: checkedEpoch,
+			Index: indices,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not get liveness of validators")
+		}
+		if len(resp.Data) != len(indices) {
+			return fmt.Errorf("beacon node returned the liveness of %d validators, expected %d", len(resp.Data), len(indices))
+		}
+		for _, l := range resp.Data {
+			key, ok := indexToKey[l.Index]
+			if !ok {
+				return fmt.Errorf("beacon node returned the liveness of unrequested validator %d", l.Index)
+			}
+			live[key] = l.IsLive
+		}
+	}
+	for key := range keys {
+		v.doppelganger.update(key, checkedEpoch, live[key])
+	}
+	return nil
+}
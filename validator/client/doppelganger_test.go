@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"google.golang.org/grpc"
+)
+
+type fakeLivenessClient struct {
+	live     map[types.ValidatorIndex]bool
+	err      error
+	requests []*ethpbv1.GetLivenessRequest
+}
+
+func (c *fakeLivenessClient) GetLiveness(
+	_ context.Context, req *ethpbv1.GetLivenessRequest, _ ...grpc.CallOption,
+) (*ethpbv1.GetLivenessResponse, error) {
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := &ethpbv1.GetLivenessResponse{}
+	for _, idx := range req.Index {
+		resp.Data = append(resp.Data, &ethpbv1.GetLivenessResponse_Liveness{Index: idx, IsLive: c.live[idx]})
+	}
+	return resp, nil
+}
+
+func TestDoppelgangerTracker_TrackKeys(t *testing.T) {
+	key1 := [fieldparams.BLSPubkeyLength]byte{1}
+	key2 := [fieldparams.BLSPubkeyLength]byte{2}
+	tracker := newDoppelgangerTracker(2)
+
+	tracker.trackKeys([][fieldparams.BLSPubkeyLength]byte{key1}, 10)
+	assert.Equal(t, true, tracker.protected(key1))
+	assert.Equal(t, false, tracker.protected(key2))
+	// The epoch in which the key is added is not checked.
+	assert.Equal(t, 0, len(tracker.keysToCheck(10)))
+	assert.Equal(t, 1, len(tracker.keysToCheck(11)))
+
+	tracker.update(key1, 11, false)
+	tracker.update(key1, 12, false)
+	assert.Equal(t, false, tracker.protected(key1))
+
+	// A key added later is checked on its own, the keys which passed are not checked again.
+	tracker.trackKeys([][fieldparams.BLSPubkeyLength]byte{key1, key2}, 13)
+	assert.Equal(t, false, tracker.protected(key1))
+	assert.Equal(t, true, tracker.protected(key2))
+
+	// A key which is removed and added back is checked again.
+	tracker.trackKeys([][fieldparams.BLSPubkeyLength]byte{key2}, 14)
+	tracker.trackKeys([][fieldparams.BLSPubkeyLength]byte{key1, key2}, 15)
+	assert.Equal(t, true, tracker.protected(key1))
+}
+
+func TestDoppelgangerTracker_Update(t *testing.T) {
+	key := [fieldparams.BLSPubkeyLength]byte{1}
+	tracker := newDoppelgangerTracker(2)
+	tracker.trackKeys([][fieldparams.BLSPubkeyLength]byte{key}, 10)
+
+	// Epochs which were already checked are ignored.
+	tracker.update(key, 10, false)
+	tracker.update(key, 11, false)
+	tracker.update(key, 11, false)
+	assert.Equal(t, true, tracker.protected(key))
+
+	tracker.update(key, 12, true)
+	assert.Equal(t, true, tracker.protected(key))
+	assert.Equal(t, 0, len(tracker.keysToCheck(13)))
+	// A key in which a doppelganger was detected never passes.
+	tracker.update(key, 13, false)
+	tracker.update(key, 14, false)
+	assert.Equal(t, true, tracker.protected(key))
+}
+
+func TestDoppelgangerTracker_Nil(t *testing.T) {
+	var tracker *doppelgangerTracker
+	assert.Equal(t, false, tracker.protected([fieldparams.BLSPubkeyLength]byte{1}))
+}
+
+func TestCheckDoppelgangers(t *testing.T) {
+	activeKey := [fieldparams.BLSPubkeyLength]byte{1}
+	liveKey := [fieldparams.BLSPubkeyLength]byte{2}
+	pendingKey := [fieldparams.BLSPubkeyLength]byte{3}
+	client := &fakeLivenessClient{live: map[types.ValidatorIndex]bool{2: true}}
+	v := &validator{
+		livenessClient: client,
+		doppelganger:   newDoppelgangerTracker(2),
+	}
+	v.doppelganger.trackKeys([][fieldparams.BLSPubkeyLength]byte{activeKey, liveKey, pendingKey}, 10)
+	duties := []*ethpb.DutiesResponse_Duty{
+		{PublicKey: activeKey[:], ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE},
+		{PublicKey: liveKey[:], ValidatorIndex: 2, Status: ethpb.ValidatorStatus_ACTIVE},
+		{PublicKey: pendingKey[:], Status: ethpb.ValidatorStatus_PENDING},
+	}
+	ctx := context.Background()
+
+	// The epoch in which the keys were added is not checked.
+	require.NoError(t, v.checkDoppelgangers(ctx, 11, duties))
+	assert.Equal(t, 0, len(client.requests))
+
+	require.NoError(t, v.checkDoppelgangers(ctx, 12, duties))
+	require.NoError(t, v.checkDoppelgangers(ctx, 13, duties))
+	require.Equal(t, 2, len(client.requests))
+	assert.Equal(t, types.Epoch(11), client.requests[0].Epoch)
+	assert.Equal(t, 2, len(client.requests[0].Index))
+	// The key in which a doppelganger was detected is no longer checked.
+	assert.DeepEqual(t, []types.ValidatorIndex{1}, client.requests[1].Index)
+
+	assert.Equal(t, false, v.doppelganger.protected(activeKey))
+	assert.Equal(t, true, v.doppelganger.protected(liveKey))
+	assert.Equal(t, false, v.doppelganger.protected(pendingKey))
+}
+
+func TestCheckDoppelgangers_LivenessError(t *testing.T) {
+	key := [fieldparams.BLSPubkeyLength]byte{1}
+	v := &validator{
+		livenessClient: &fakeLivenessClient{err: errors.New("bad")},
+		doppelganger:   newDoppelgangerTracker(1),
+	}
+	v.doppelganger.trackKeys([][fieldparams.BLSPubkeyLength]byte{key}, 10)
+
+	err := v.checkDoppelgangers(context.Background(), 12, []*ethpb.DutiesResponse_Duty{
+		{PublicKey: key[:], ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE},
+	})
+	require.ErrorContains(t, "could not get liveness of validators", err)
+	assert.Equal(t, true, v.doppelganger.protected(key))
+}
+
+func TestRolesAt_DoppelgangerProtected(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := validatorKey.PublicKey().Marshal()
+	v.doppelganger = newDoppelgangerTracker(2)
+	v.doppelganger.trackKeys([][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(pubKey)}, 0)
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+				AttesterSlot:   1,
+				ProposerSlots:  []types.Slot{1},
+				PublicKey:      pubKey,
+			},
+		},
+	}
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roleMap))
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pushFeeRecipients registers the fee recipients of the active validators with the beacon node,
+// so that it builds the execution payloads of the blocks they propose with them. Validators without
+// a fee recipient are left to the fee recipient the beacon node is configured with.
+func (v *validator) pushFeeRecipients(ctx context.Context, duties []*ethpb.DutiesResponse_Duty) error {
+	ctx, span := trace.StartSpan(ctx, "validator.pushFeeRecipients")
+	defer span.End()
+
+	recipients := make([]*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer, 0, len(duties))
+	for _, duty := range duties {
+		if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+			continue
+		}
+		feeRecipient, ok, err := v.feeRecipient(ctx, bytesutil.ToBytes48(duty.PublicKey))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		recipients = append(recipients, &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			FeeRecipient:   feeRecipient.Bytes(),
+			ValidatorIndex: duty.ValidatorIndex,
+		})
+	}
+	return v.prepareBeaconProposer(ctx, recipients)
+}
+
+// PushFeeRecipient registers the fee recipient of a validator with the beacon node once it is set
+// or deleted through the keymanager API, rather than with the duties of the next epoch. A validator
+// left without a fee recipient keeps the one last registered until it expires on the beacon node.
+func (v *ValidatorService) PushFeeRecipient(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	// The validator is set once the service is started.
+	val, ok := v.validator.(*validator)
+	if !ok {
+		return nil
+	}
+	return val.pushFeeRecipient(ctx, pubKey)
+}
+
+// pushFeeRecipient registers the fee recipient of a validator with the beacon node, if it is active.
+func (v *validator) pushFeeRecipient(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	ctx, span := trace.StartSpan(ctx, "validator.pushFeeRecipient")
+	defer span.End()
+
+	feeRecipient, ok, err := v.feeRecipient(ctx, pubKey)
+	if err != nil || !ok {
+		return err
+	}
+	res, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{pubKey[:]},
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get validator status")
+	}
+	if len(res.Statuses) != 1 || len(res.Indices) != 1 {
+		return errors.New("number of status responses did not match number of requested keys")
+	}
+	s := res.Statuses[0].Status
+	if s != ethpb.ValidatorStatus_ACTIVE && s != ethpb.ValidatorStatus_EXITING {
+		return nil
+	}
+	return v.prepareBeaconProposer(ctx, []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{{
+		FeeRecipient:   feeRecipient.Bytes(),
+		ValidatorIndex: res.Indices[0],
+	}})
+}
+
+func (v *validator) prepareBeaconProposer(
+	ctx context.Context, recipients []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer,
+) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	if _, err := v.validatorClient.PrepareBeaconProposer(ctx, &ethpb.PrepareBeaconProposerRequest{
+		Recipients: recipients,
+	}); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			log.WithError(err).Debug("Beacon node does not support fee recipients per validator")
+			return nil
+		}
+		return err
+	}
+	log.WithField("count", len(recipients)).Debug("Pushed fee recipients to beacon node")
+	return nil
+}
+
+// feeRecipient returns the fee recipient of a validator set through the keymanager API, or else the
+// one of the proposer settings, and whether there is one.
+func (v *validator) feeRecipient(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool, error) {
+	feeRecipient, ok, err := v.db.FeeRecipientForPubKey(ctx, pubKey)
+	if err != nil || ok {
+		return feeRecipient, ok, err
+	}
+	feeRecipient, ok = v.proposerSettings.FeeRecipient(pubKey)
+	return feeRecipient, ok, nil
+}
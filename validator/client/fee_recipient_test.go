@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	proposersettings "github.com/prysmaticlabs/prysm/validator/proposer-settings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestPushFeeRecipients(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	ctx := context.Background()
+	pubKey := validatorKey.PublicKey().Marshal()
+	otherPubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	pendingPubKey := [fieldparams.BLSPubkeyLength]byte{2}
+	defaultFeeRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	apiFeeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	v.proposerSettings = &proposersettings.Settings{
+		DefaultConfig: &proposersettings.Option{FeeRecipient: defaultFeeRecipient},
+	}
+	// The fee recipient set through the keymanager API takes precedence over the proposer settings.
+	require.NoError(t, v.db.SaveFeeRecipientForPubKey(ctx, otherPubKey, apiFeeRecipient))
+
+	m.validatorClient.EXPECT().PrepareBeaconProposer(gomock.Any(), &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: defaultFeeRecipient.Bytes(), ValidatorIndex: 1},
+			{FeeRecipient: apiFeeRecipient.Bytes(), ValidatorIndex: 2},
+		},
+	}).Return(&emptypb.Empty{}, nil)
+
+	require.NoError(t, v.pushFeeRecipients(ctx, []*ethpb.DutiesResponse_Duty{
+		{PublicKey: pubKey, ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE},
+		{PublicKey: otherPubKey[:], ValidatorIndex: 2, Status: ethpb.ValidatorStatus_EXITING},
+		{PublicKey: pendingPubKey[:], Status: ethpb.ValidatorStatus_PENDING},
+	}))
+}
+
+func TestPushFeeRecipients_NoFeeRecipients(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+
+	// No request is made to the beacon node.
+	require.NoError(t, v.pushFeeRecipients(context.Background(), []*ethpb.DutiesResponse_Duty{
+		{PublicKey: validatorKey.PublicKey().Marshal(), ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE},
+	}))
+}
+
+func TestPushFeeRecipients_Unimplemented(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	v.proposerSettings = &proposersettings.Settings{
+		DefaultConfig: &proposersettings.Option{FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")},
+	}
+	m.validatorClient.EXPECT().PrepareBeaconProposer(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unimplemented, "not supported"))
+
+	require.NoError(t, v.pushFeeRecipients(context.Background(), []*ethpb.DutiesResponse_Duty{
+		{PublicKey: validatorKey.PublicKey().Marshal(), ValidatorIndex: 1, Status: ethpb.ValidatorStatus_ACTIVE},
+	}))
+}
+
+func TestPushFeeRecipient(t *testing.T) {
+	v, m, validatorKey, finish := setup(t)
+	defer finish()
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	apiFeeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	require.NoError(t, v.db.SaveFeeRecipientForPubKey(ctx, pubKey, apiFeeRecipient))
+
+	m.validatorClient.EXPECT().MultipleValidatorStatus(gomock.Any(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{pubKey[:]},
+	}).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{pubKey[:]},
+		Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_ACTIVE}},
+		Indices:    []types.ValidatorIndex{3},
+	}, nil)
+	m.validatorClient.EXPECT().PrepareBeaconProposer(gomock.Any(), &ethpb.PrepareBeaconProposerRequest{
+		Recipients: []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
+			{FeeRecipient: apiFeeRecipient.Bytes(), ValidatorIndex: 3},
+		},
+	}).Return(&emptypb.Empty{}, nil)
+	require.NoError(t, v.pushFeeRecipient(ctx, pubKey))
+
+	// The fee recipients of the validators not active yet are registered with their duties.
+	m.validatorClient.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			PublicKeys: [][]byte{pubKey[:]},
+			Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_PENDING}},
+			Indices:    []types.ValidatorIndex{3},
+		}, nil)
+	require.NoError(t, v.pushFeeRecipient(ctx, pubKey))
+}
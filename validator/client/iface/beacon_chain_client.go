@@ -0,0 +1,16 @@
+package iface
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// BeaconChainClient is the subset of the beacon chain API of the beacon node used by the
+// validator client.
+type BeaconChainClient interface {
+	GetChainHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error)
+}
@@ -0,0 +1,14 @@
+package iface
+
+import (
+	"context"
+
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"google.golang.org/grpc"
+)
+
+// LivenessClient is the liveness API of the beacon node, used by the validator client to detect
+// other instances signing with its keys.
+type LivenessClient interface {
+	GetLiveness(ctx context.Context, in *ethpbv1.GetLivenessRequest, opts ...grpc.CallOption) (*ethpbv1.GetLivenessResponse, error)
+}
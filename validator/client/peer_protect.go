@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
+)
+
+// peerRequestCheckerSetter is implemented by the keymanagers which sign the requests of their
+// peers, such as the threshold keymanager.
+type peerRequestCheckerSetter interface {
+	SetPeerRequestChecker(checker threshold.PeerRequestChecker)
+}
+
+// checkPeerSignRequest checks the sign request of a peer against the signing policy and the local
+// slashing protection, as if the validator client signed the object itself. The signed blocks and
+// attestations are recorded in the slashing protection history, and the outcome in the signing
+// audit log.
+func (v *validator) checkPeerSignRequest(ctx context.Context, req *validatorpb.SignRequest) error {
+	err := v.peerSignRequestRefusal(ctx, req)
+	v.auditSign(ctx, req, err)
+	return err
+}
+
+func (v *validator) peerSignRequestRefusal(ctx context.Context, req *validatorpb.SignRequest) error {
+	if v.signingPolicy != nil {
+		if err := v.signingPolicy.Check(ctx, req); err != nil {
+			return err
+		}
+	}
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	signingRoot := bytesutil.ToBytes32(req.SigningRoot)
+	var blk block.SignedBeaconBlock
+	var err error
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_AttestationData:
+		indexedAtt := &ethpb.IndexedAttestation{Data: o.AttestationData}
+		return v.slashableAttestationCheck(ctx, indexedAtt, pubKey, signingRoot)
+	case *validatorpb.SignRequest_Block:
+		blk, err = wrapper.WrappedSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: o.Block})
+	case *validatorpb.SignRequest_BlockV2:
+		blk, err = wrapper.WrappedSignedBeaconBlock(&ethpb.SignedBeaconBlockAltair{Block: o.BlockV2})
+	case *validatorpb.SignRequest_BlockV3:
+		blk, err = wrapper.WrappedSignedBeaconBlock(&ethpb.SignedBeaconBlockMerge{Block: o.BlockV3})
+	default:
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not wrap block")
+	}
+	return v.slashableProposalCheck(ctx, pubKey, blk, signingRoot)
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+)
+
+func Test_checkPeerSignRequest_Slashable(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	attestation := func(blockRoot string, signingRoot byte) *validatorpb.SignRequest {
+		data := util.HydrateAttestationData(&ethpb.AttestationData{
+			BeaconBlockRoot: bytesutil.PadTo([]byte(blockRoot), 32),
+			Source:          &ethpb.Checkpoint{Epoch: 4},
+			Target:          &ethpb.Checkpoint{Epoch: 10},
+		})
+		return &validatorpb.SignRequest{
+			PublicKey:   pubKey[:],
+			SigningRoot: bytesutil.PadTo([]byte{signingRoot}, 32),
+			Object:      &validatorpb.SignRequest_AttestationData{AttestationData: data},
+		}
+	}
+	require.NoError(t, validator.checkPeerSignRequest(ctx, attestation("block", 1)))
+	// The attestation signed for the peer is in the slashing protection history.
+	err := validator.checkPeerSignRequest(ctx, attestation("other block", 2))
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+
+	proposal := func(signingRoot byte) *validatorpb.SignRequest {
+		return &validatorpb.SignRequest{
+			PublicKey:   pubKey[:],
+			SigningRoot: bytesutil.PadTo([]byte{signingRoot}, 32),
+			Object: &validatorpb.SignRequest_Block{
+				Block: util.HydrateBeaconBlock(&ethpb.BeaconBlock{Slot: 10}),
+			},
+		}
+	}
+	require.NoError(t, validator.checkPeerSignRequest(ctx, proposal(1)))
+	err = validator.checkPeerSignRequest(ctx, proposal(2))
+	require.ErrorContains(t, failedBlockSignLocalErr, err)
+}
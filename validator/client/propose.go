@@ -4,6 +4,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -23,6 +24,8 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/time"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -77,7 +80,7 @@ func (v *validator) proposeBlockPhase0(ctx context.Context, slot types.Slot, pub
 		return
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, epoch)
 	if err != nil {
 		// Graffiti is not a critical enough to fail block production and cause
 		// validator to miss block reward. When failed, validator should continue
@@ -131,6 +134,7 @@ func (v *validator) proposeBlockPhase0(ctx context.Context, slot types.Slot, pub
 
 	// Propose and broadcast block via beacon node
 	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
+	v.auditBroadcast(ctx, pubKey, signingpolicy.BlockObject, slot, signingRoot[:], err)
 	if err != nil {
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
@@ -187,7 +191,7 @@ func (v *validator) proposeBlockAltair(ctx context.Context, slot types.Slot, pub
 		return
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, epoch)
 	if err != nil {
 		// Graffiti is not a critical enough to fail block production and cause
 		// validator to miss block reward. When failed, validator should continue
@@ -271,6 +275,7 @@ func (v *validator) proposeBlockAltair(ctx context.Context, slot types.Slot, pub
 	blkResp, err := v.validatorClient.ProposeBeaconBlock(ctx, &ethpb.GenericSignedBeaconBlock{
 		Block: &ethpb.GenericSignedBeaconBlock_Altair{Altair: blk},
 	})
+	v.auditBroadcast(ctx, pubKey, signingpolicy.BlockObject, slot, signingRoot[:], err)
 	if err != nil {
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
@@ -358,7 +363,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BL
 	if err != nil {
 		return nil, err
 	}
-	randaoReveal, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	randaoReveal, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -393,7 +398,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkey
 		if err != nil {
 			return nil, nil, errors.Wrap(err, signingRootErr)
 		}
-		sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+		sig, err = v.sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     blockRoot[:],
 			SignatureDomain: domain.SignatureDomain,
@@ -412,7 +417,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkey
 		if err != nil {
 			return nil, nil, errors.Wrap(err, signingRootErr)
 		}
-		sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+		sig, err = v.sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     blockRoot[:],
 			SignatureDomain: domain.SignatureDomain,
@@ -432,7 +437,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [fieldparams.BLSPubkey
 		if err != nil {
 			return nil, nil, errors.Wrap(err, signingRootErr)
 		}
-		sig, err = v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+		sig, err = v.sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     blockRoot[:],
 			SignatureDomain: domain.SignatureDomain,
@@ -486,8 +491,12 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
-func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+// Gets the graffiti from cli or file for the validator public key. The placeholders of the graffiti
+// templates are only replaced in the graffiti from the file, the cli graffiti being used as is.
+func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch) ([]byte, error) {
+	v.graffitiLock.Lock()
+	defer v.graffitiLock.Unlock()
+
 	// When specified, default graffiti from the command line takes the first priority.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
@@ -496,15 +505,21 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 	if v.graffitiStruct == nil {
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
+	if err := v.reloadGraffiti(ctx); err != nil {
+		return nil, err
+	}
 
 	// When specified, individual validator specified graffiti takes the second priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
 	}
+	if g, ok := v.graffitiStruct.ForPubkey(pubKey); ok {
+		return v.renderGraffiti(ctx, g, pubKey, epoch, idx.Index)
+	}
 	g, ok := v.graffitiStruct.Specific[idx.Index]
 	if ok {
-		return []byte(g), nil
+		return v.renderGraffiti(ctx, g, pubKey, epoch, idx.Index)
 	}
 
 	// When specified, a graffiti from the ordered list in the file take third priority.
@@ -515,7 +530,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to update graffiti ordered index")
 		}
-		return []byte(graffiti), nil
+		return v.renderGraffiti(ctx, graffiti, pubKey, epoch, idx.Index)
 	}
 
 	// When specified, a graffiti from the random list in the file take fourth priority.
@@ -523,17 +538,57 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
 		i := r.Uint64() % uint64(len(v.graffitiStruct.Random))
-		return []byte(v.graffitiStruct.Random[i]), nil
+		return v.renderGraffiti(ctx, v.graffitiStruct.Random[i], pubKey, epoch, idx.Index)
 	}
 
 	// Finally, default graffiti if specified in the file will be used.
 	if v.graffitiStruct.Default != "" {
-		return []byte(v.graffitiStruct.Default), nil
+		return v.renderGraffiti(ctx, v.graffitiStruct.Default, pubKey, epoch, idx.Index)
 	}
 
 	return []byte{}, nil
 }
 
+// reloadGraffiti picks up the changes of the graffiti file. The position in the ordered list of
+// graffiti starts over when the file changes.
+func (v *validator) reloadGraffiti(ctx context.Context) error {
+	if v.graffitiSource == nil {
+		return nil
+	}
+	g := v.graffitiSource.Graffiti()
+	if g.Hash == v.graffitiStruct.Hash {
+		return nil
+	}
+	orderedIndex, err := v.db.GraffitiOrderedIndex(ctx, g.Hash)
+	if err != nil {
+		return errors.Wrap(err, "failed to get graffiti ordered index")
+	}
+	v.graffitiStruct = g
+	v.graffitiOrderedIndex = orderedIndex
+	return nil
+}
+
+// renderGraffiti replaces the placeholders of the graffiti template. The execution client is only
+// requested from the beacon node when the template uses it.
+func (v *validator) renderGraffiti(
+	ctx context.Context,
+	template string,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	epoch types.Epoch,
+	index types.ValidatorIndex,
+) ([]byte, error) {
+	data := &graffiti.TemplateData{Index: index, PubKey: pubKey, Epoch: epoch}
+	if strings.Contains(template, graffiti.ExecutionClientPlaceholder) {
+		nodeVersion, err := v.node.GetVersion(ctx, &emptypb.Empty{})
+		if err != nil {
+			log.WithError(err).Warn("Could not get the execution client of the beacon node for the graffiti")
+		} else {
+			data.ExecutionClient = nodeVersion.ExecutionClient
+		}
+	}
+	return graffiti.Render(template, data), nil
+}
+
 // This is a routine to propose bellatrix compatible beacon blocks.
 func (v *validator) proposeBlockBellatrix(ctx context.Context, slot types.Slot, pubKey [48]byte) {
 	if slot == 0 {
@@ -562,7 +617,7 @@ func (v *validator) proposeBlockBellatrix(ctx context.Context, slot types.Slot,
 		return
 	}
 
-	g, err := v.getGraffiti(ctx, pubKey)
+	g, err := v.getGraffiti(ctx, pubKey, epoch)
 	if err != nil {
 		log.WithError(err).Warn("Could not get graffiti")
 	}
@@ -643,6 +698,7 @@ func (v *validator) proposeBlockBellatrix(ctx context.Context, slot types.Slot,
 	blkResp, err := v.validatorClient.ProposeBeaconBlock(ctx, &ethpb.GenericSignedBeaconBlock{
 		Block: &ethpb.GenericSignedBeaconBlock_Merge{Merge: blk},
 	})
+	v.auditBroadcast(ctx, pubKey, signingpolicy.BlockObject, slot, signingRoot[:], err)
 	if err != nil {
 		log.WithError(err).Error("Failed to propose block")
 		if v.emitAccountMetrics {
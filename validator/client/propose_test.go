@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	types "github.com/prysmaticlabs/eth2-types"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
@@ -24,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/testing/util"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -124,6 +128,33 @@ func TestProposeBlock_DomainDataFailed(t *testing.T) {
 	require.LogsContain(t, hook, "Failed to sign randao reveal")
 }
 
+func TestProposeBlock_RefusedBySigningPolicy(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.signingPolicy = signingpolicy.NewEnforcer(
+		&signingpolicy.Settings{DefaultConfig: &signingpolicy.Policy{MaxFutureSlots: 1}}, validator.db,
+	)
+	validator.signingPolicy.SetGenesisTime(uint64(time.Now().Unix()))
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	// The randao reveal of the next epoch is too far ahead of the current slot.
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().SlotsPerEpoch, pubKey)
+	require.LogsContain(t, hook, "Failed to sign randao reveal")
+	require.LogsContain(t, hook, "refused by signing policy")
+	decisions, err := validator.db.SigningPolicyDecisions(context.Background(), pubKey, 0, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decisions))
+	assert.Equal(t, false, decisions[0].Allowed)
+	assert.Equal(t, signingpolicy.RandaoRevealObject, decisions[0].ObjectType)
+}
+
 func TestProposeBlock_DomainDataIsNil(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, validatorKey, finish := setup(t)
@@ -1134,6 +1165,20 @@ func TestGetGraffiti_Ok(t *testing.T) {
 			},
 			want: []byte{'g'},
 		},
+		{name: "use pubkey file graffiti before validator file graffiti",
+			v: &validator{
+				validatorClient: m.validatorClient,
+				graffitiStruct: &graffiti.Graffiti{
+					Specific: map[types.ValidatorIndex]string{
+						2: "g",
+					},
+					Pubkeys: map[string]string{
+						hexutil.Encode(pubKey[:]): "h",
+					},
+				},
+			},
+			want: []byte{'h'},
+		},
 		{name: "use validator file graffiti, none specified",
 			v: &validator{
 				validatorClient: m.validatorClient,
@@ -1150,7 +1195,7 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
 			}
-			got, err := tt.v.getGraffiti(context.Background(), pubKey)
+			got, err := tt.v.getGraffiti(context.Background(), pubKey, 0)
 			require.NoError(t, err)
 			require.DeepEqual(t, tt.want, got)
 		})
@@ -1178,7 +1223,78 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		},
 	}
 	for _, want := range [][]byte{{'a'}, {'b'}, {'c'}, {'d'}, {'d'}} {
-		got, err := v.getGraffiti(context.Background(), pubKey)
+		got, err := v.getGraffiti(context.Background(), pubKey, 0)
+		require.NoError(t, err)
+		require.DeepEqual(t, want, got)
+	}
+}
+
+func TestGetGraffiti_Template(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		nodeClient:      mock.NewMockNodeClient(ctrl),
+	}
+	pubKey := [fieldparams.BLSPubkeyLength]byte{0xab, 0xcd, 0xef, 0x01, 0x23}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Return(&ethpb.ValidatorIndexResponse{Index: 7}, nil)
+	m.nodeClient.EXPECT().
+		GetVersion(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Version{ExecutionClient: "Geth/v1.10.17-stable/linux-amd64/go1.18"}, nil)
+
+	// The cli graffiti is used as is.
+	v := &validator{
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffiti:        []byte("{index} {pubkey}"),
+	}
+	got, err := v.getGraffiti(context.Background(), pubKey, 3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("{index} {pubkey}"), got)
+
+	v = &validator{
+		validatorClient: m.validatorClient,
+		node:            m.nodeClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Default: "epoch {epoch} index {index} {pubkey} {execution_client}",
+		},
+	}
+	got, err = v.getGraffiti(context.Background(), pubKey, 3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte("epoch 3 index 7 abcdef01 Geth"), got)
+}
+
+func TestGetGraffiti_ReloadsSource(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(3).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("ordered: [\"a\", \"b\"]"), 0600))
+	source, err := graffiti.NewSource(path)
+	require.NoError(t, err)
+	v := &validator{
+		db:              valDB,
+		validatorClient: m.validatorClient,
+		graffitiSource:  source,
+		graffitiStruct:  source.Graffiti(),
+	}
+	got, err := v.getGraffiti(context.Background(), pubKey, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{'a'}, got)
+
+	// The ordered list starts over when the file changes.
+	require.NoError(t, source.Update([]byte("ordered: [\"c\", \"d\"]")))
+	for _, want := range [][]byte{{'c'}, {'d'}} {
+		got, err := v.getGraffiti(context.Background(), pubKey, 0)
 		require.NoError(t, err)
 		require.DeepEqual(t, want, got)
 	}
@@ -3,14 +3,18 @@ package client
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/async/event"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/testing/assert"
 	"github.com/prysmaticlabs/prysm/testing/require"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/client/testutil"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
@@ -56,6 +60,21 @@ func TestRetry_On_ConnectionError(t *testing.T) {
 	assert.Equal(t, retry, v.ReceiveBlocksCalled, "Expected WaitForActivation() to be called")
 }
 
+func TestDoppelGangerCheck_BeaconApiClient(t *testing.T) {
+	reset := features.InitWithReset(&features.Flags{EnableDoppelGanger: true})
+	defer reset()
+	// The beacon API does not serve the one-shot doppelganger check.
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+	v := &validator{
+		validatorClient: beaconapi.NewBeaconApiValidatorClient(srv.URL, time.Second),
+		doppelganger:    newDoppelgangerTracker(DefaultDoppelgangerEpochs),
+	}
+	// The runner stops the validator client on an error of the check, which the liveness tracker
+	// does instead.
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
 func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	v := &testutil.FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
 	run(cancelledContext(), v)
@@ -16,24 +16,31 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/api/grpc"
 	"github.com/prysmaticlabs/prysm/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/cache/lru"
+	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	proposersettings "github.com/prysmaticlabs/prysm/validator/proposer-settings"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+var errNotConnected = errors.New("no connection to beacon node")
+
 // SyncChecker is able to determine if a beacon node is currently
 // going through chain synchronization.
 type SyncChecker interface {
@@ -54,6 +61,8 @@ type ValidatorService struct {
 	logValidatorBalances  bool
 	logDutyCountDown      bool
 	conn                  *grpc.ClientConn
+	nodeClient            ethpb.NodeClient
+	beaconApiEndpoint     string
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -69,6 +78,12 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	graffitiSource        *graffiti.Source
+	proposerSettings      *proposersettings.Settings
+	signingPolicy         *signingpolicy.Enforcer
+	signingAuditLog       bool
+	auditLogRetention     time.Duration
+	doppelgangerEpochs    types.Epoch
 }
 
 // Config for the validator service.
@@ -82,6 +97,7 @@ type Config struct {
 	GrpcRetryDelay             time.Duration
 	GrpcMaxCallRecvMsgSizeFlag int
 	Endpoint                   string
+	BeaconApiEndpoint          string
 	Validator                  iface.Validator
 	ValDB                      db.Database
 	KeyManager                 keymanager.IKeymanager
@@ -90,16 +106,27 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	GraffitiSource             *graffiti.Source
+	ProposerSettings           *proposersettings.Settings
+	SigningPolicy              *signingpolicy.Settings
+	SigningAuditLog            bool
+	SigningAuditLogRetention   time.Duration
+	DoppelgangerEpochs         types.Epoch
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	var policy *signingpolicy.Enforcer
+	if cfg.SigningPolicy != nil {
+		policy = signingpolicy.NewEnforcer(cfg.SigningPolicy, cfg.ValDB)
+	}
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconApiEndpoint:     cfg.BeaconApiEndpoint,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -115,35 +142,70 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
+		graffitiSource:        cfg.GraffitiSource,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		proposerSettings:      cfg.ProposerSettings,
+		signingPolicy:         policy,
+		signingAuditLog:       cfg.SigningAuditLog,
+		auditLogRetention:     cfg.SigningAuditLogRetention,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
 	}, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
+	var (
+		validatorClient ethpb.BeaconNodeValidatorClient
+		beaconClient    iface.BeaconChainClient
+		slasherClient   ethpb.SlasherClient
+		livenessClient  iface.LivenessClient
 	)
-	if dialOpts == nil {
-		return
-	}
+	if v.beaconApiEndpoint != "" {
+		if features.Get().RemoteSlasherProtection {
+			log.Error("Remote slashing protection requires a gRPC connection to a beacon node " +
+				"and cannot be used with the beacon API")
+			return
+		}
+		if v.logValidatorBalances {
+			log.Warn("Validator balances are not logged when using the beacon API")
+			v.logValidatorBalances = false
+		}
+		timeout := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		validatorClient = beaconapi.NewBeaconApiValidatorClient(v.beaconApiEndpoint, timeout)
+		beaconClient = beaconapi.NewBeaconApiBeaconChainClient(v.beaconApiEndpoint, timeout)
+		v.nodeClient = beaconapi.NewBeaconApiNodeClient(v.beaconApiEndpoint, timeout)
+		livenessClient = beaconapi.NewBeaconApiLivenessClient(v.beaconApiEndpoint, timeout)
+		log.WithField("endpoint", v.beaconApiEndpoint).Info("Using the beacon API of the beacon node")
+	} else {
+		dialOpts := ConstructDialOptions(
+			v.maxCallRecvMsgSize,
+			v.withCert,
+			v.grpcRetries,
+			v.grpcRetryDelay,
+		)
+		if dialOpts == nil {
+			return
+		}
 
-	v.ctx = grpcutil.AppendHeaders(v.ctx, v.grpcHeaders)
+		v.ctx = grpcutil.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
-	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
-	}
+		conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+			return
+		}
+		if v.withCert != "" {
+			log.Info("Established secure gRPC connection")
+		}
 
-	v.conn = conn
+		v.conn = conn
+		validatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+		beaconClient = ethpb.NewBeaconChainClient(v.conn)
+		slasherClient = ethpb.NewSlasherClient(v.conn)
+		v.nodeClient = ethpb.NewNodeClient(v.conn)
+		livenessClient = ethpbservice.NewBeaconValidatorClient(v.conn)
+	}
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -173,10 +235,11 @@ func (v *ValidatorService) Start() {
 
 	valStruct := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		slashingProtectionClient:       ethpb.NewSlasherClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                validatorClient,
+		livenessClient:                 livenessClient,
+		beaconClient:                   beaconClient,
+		slashingProtectionClient:       slasherClient,
+		node:                           v.nodeClient,
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -191,9 +254,22 @@ func (v *ValidatorService) Start() {
 		walletInitializedFeed:          v.walletInitializedFeed,
 		blockFeed:                      new(event.Feed),
 		graffitiStruct:                 v.graffitiStruct,
+		graffitiSource:                 v.graffitiSource,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		proposerSettings:               v.proposerSettings,
+		signingPolicy:                  v.signingPolicy,
+	}
+	if v.signingAuditLog {
+		valStruct.auditLog = newAuditLogQueue(v.db)
+	}
+	if features.Get().EnableDoppelGanger {
+		epochs := v.doppelgangerEpochs
+		if epochs == 0 {
+			epochs = DefaultDoppelgangerEpochs
+		}
+		valStruct.doppelganger = newDoppelgangerTracker(epochs)
 	}
 	// To resolve a race condition at startup due to the interface
 	// nature of the abstracted block type. We initialize
@@ -206,6 +282,18 @@ func (v *ValidatorService) Start() {
 	close(tempChan)
 
 	v.validator = valStruct
+	if v.graffitiSource != nil {
+		go v.graffitiSource.Watch(v.ctx)
+	}
+	if v.signingPolicy != nil {
+		go v.signingPolicy.PruneDecisions(v.ctx)
+	}
+	if valStruct.auditLog != nil {
+		go valStruct.auditLog.run(v.ctx)
+	}
+	if v.signingAuditLog && v.auditLogRetention > 0 {
+		go pruneSigningAuditLog(v.ctx, v.db, v.auditLogRetention)
+	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
 }
@@ -222,8 +310,8 @@ func (v *ValidatorService) Stop() error {
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
-		return errors.New("no connection to beacon RPC")
+	if v.nodeClient == nil {
+		return errNotConnected
 	}
 	return nil
 }
@@ -316,8 +404,10 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return false, errNotConnected
+	}
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -327,8 +417,25 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	if v.nodeClient == nil {
+		return nil, errNotConnected
+	}
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
+}
+
+// ProposerSettings returns the settings the validators propose blocks with.
+func (v *ValidatorService) ProposerSettings() *proposersettings.Settings {
+	return v.proposerSettings
+}
+
+// SigningPolicy returns the enforcer of the signing policy of the validators, if any.
+func (v *ValidatorService) SigningPolicy() *signingpolicy.Enforcer {
+	return v.signingPolicy
+}
+
+// GraffitiSource returns the graffiti file the validators propose blocks with, if any.
+func (v *ValidatorService) GraffitiSource() *graffiti.Source {
+	return v.graffitiSource
 }
 
 // to accounts changes in the keymanager, then updates those keys'
@@ -15,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/time/slots"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -52,7 +53,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		return
 	}
 
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     r[:],
 		SignatureDomain: d.SignatureDomain,
@@ -72,7 +73,9 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot types.S
 		ValidatorIndex: duty.ValidatorIndex,
 		Signature:      sig.Marshal(),
 	}
-	if _, err := v.validatorClient.SubmitSyncMessage(ctx, msg); err != nil {
+	_, err = v.validatorClient.SubmitSyncMessage(ctx, msg)
+	v.auditBroadcast(ctx, pubKey, signingpolicy.SyncCommitteeMessageObject, slot, r[:], err)
+	if err != nil {
 		log.WithError(err).Error("Could not submit sync committee message")
 		return
 	}
@@ -151,16 +154,18 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot t
 			Contribution:    contribution,
 			SelectionProof:  selectionProofs[i],
 		}
-		sig, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof, slot)
+		sig, root, err := v.signContributionAndProof(ctx, pubKey, contributionAndProof, slot)
 		if err != nil {
 			log.Errorf("Could not sign contribution and proof: %v", err)
 			return
 		}
 
-		if _, err := v.validatorClient.SubmitSignedContributionAndProof(ctx, &ethpb.SignedContributionAndProof{
+		_, err = v.validatorClient.SubmitSignedContributionAndProof(ctx, &ethpb.SignedContributionAndProof{
 			Message:   contributionAndProof,
 			Signature: sig,
-		}); err != nil {
+		})
+		v.auditBroadcast(ctx, pubKey, signingpolicy.ContributionAndProofObject, slot, root[:], err)
+		if err != nil {
 			log.Errorf("Could not submit signed contribution and proof: %v", err)
 			return
 		}
@@ -207,7 +212,7 @@ func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [fieldpara
 	if err != nil {
 		return nil, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -220,17 +225,17 @@ func (v *validator) signSyncSelectionData(ctx context.Context, pubKey [fieldpara
 	return sig.Marshal(), nil
 }
 
-// This returns the signature of validator signing over sync committee contribution and proof object.
-func (v *validator) signContributionAndProof(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, c *ethpb.ContributionAndProof, slot types.Slot) ([]byte, error) {
+// This returns the signature of validator signing over sync committee contribution and proof object, and its signing root.
+func (v *validator) signContributionAndProof(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, c *ethpb.ContributionAndProof, slot types.Slot) ([]byte, [32]byte, error) {
 	d, err := v.domainData(ctx, slots.ToEpoch(c.Contribution.Slot), params.BeaconConfig().DomainContributionAndProof[:])
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
 	root, err := signing.ComputeSigningRoot(c, d.SignatureDomain)
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
-	sig, err := v.keyManager.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
@@ -238,7 +243,7 @@ func (v *validator) signContributionAndProof(ctx context.Context, pubKey [fieldp
 		SigningSlot:     slot,
 	})
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
-	return sig.Marshal(), nil
+	return sig.Marshal(), root, nil
 }
@@ -23,10 +23,12 @@ import (
 	"github.com/prysmaticlabs/prysm/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
 	"github.com/prysmaticlabs/prysm/time/slots"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
@@ -36,6 +38,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	proposersettings "github.com/prysmaticlabs/prysm/validator/proposer-settings"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/protobuf/proto"
@@ -76,15 +80,22 @@ type validator struct {
 	attLogs                            map[[32]byte]*attSubmitted
 	node                               ethpb.NodeClient
 	keyManager                         keymanager.IKeymanager
-	beaconClient                       ethpb.BeaconChainClient
+	beaconClient                       iface.BeaconChainClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
+	livenessClient                     iface.LivenessClient
 	slashingProtectionClient           ethpb.SlasherClient
 	db                                 vdb.Database
 	graffiti                           []byte
 	voteStats                          voteStats
 	graffitiStruct                     *graffiti.Graffiti
 	graffitiOrderedIndex               uint64
+	graffitiSource                     *graffiti.Source
+	graffitiLock                       sync.Mutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
+	proposerSettings                   *proposersettings.Settings
+	signingPolicy                      *signingpolicy.Enforcer
+	auditLog                           *auditLogQueue
+	doppelganger                       *doppelgangerTracker
 }
 
 type validatorStatus struct {
@@ -129,6 +140,12 @@ func (v *validator) WaitForWalletInitialization(ctx context.Context) error {
 	}
 }
 
+// genesisValidatorsRootSetter is implemented by the keymanagers which need the genesis validators
+// root to sign, such as the web3signer keymanager.
+type genesisValidatorsRootSetter interface {
+	SetGenesisValidatorsRoot(genesisValidatorsRoot []byte)
+}
+
 // WaitForChainStart checks whether the beacon node has started its runtime. That is,
 // it calls to the beacon node which then verifies the ETH1.0 deposit contract logs to check
 // for the ChainStart log to have been emitted. If so, it starts a ticker based on the ChainStart
@@ -158,6 +175,9 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 			)
 		}
 		v.genesisTime = chainStartRes.GenesisTime
+		if v.signingPolicy != nil {
+			v.signingPolicy.SetGenesisTime(v.genesisTime)
+		}
 		curGenValRoot, err := v.db.GenesisValidatorsRoot(ctx)
 		if err != nil {
 			return errors.Wrap(err, "could not get current genesis validators root")
@@ -179,6 +199,13 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 				)
 			}
 		}
+		if km, ok := v.keyManager.(genesisValidatorsRootSetter); ok {
+			km.SetGenesisValidatorsRoot(chainStartRes.GenesisValidatorsRoot)
+		}
+		// The requests of the peers are checked once the genesis time is known to the signing policy.
+		if km, ok := v.keyManager.(peerRequestCheckerSetter); ok {
+			km.SetPeerRequestChecker(v.checkPeerSignRequest)
+		}
 	} else {
 		return iface.ErrConnectionIssue
 	}
@@ -361,6 +388,11 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	if !features.Get().EnableDoppelGanger {
 		return nil
 	}
+	// Every validating key is watched by the liveness tracker before it signs, which only needs the
+	// liveness endpoint, unlike the one-shot check not served by the beacon API.
+	if v.doppelganger != nil {
+		return nil
+	}
 	pubkeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
 	if err != nil {
 		return err
@@ -492,6 +524,12 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 		PublicKeys: bytesutil.FromBytes48Array(filteredKeys),
 	}
 
+	// Keys added since the previous update, including the ones added at runtime, are not
+	// allowed to sign until they pass doppelganger protection.
+	if v.doppelganger != nil {
+		v.doppelganger.trackKeys(validatingKeys, req.Epoch)
+	}
+
 	// If duties is nil it means we have had no prior duties and just started up.
 	resp, err := v.validatorClient.GetDuties(ctx, req)
 	if err != nil {
@@ -510,6 +548,22 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 		}
 	}()
 
+	// Non-blocking call for beacon node to build the payloads of the blocks proposed in the epoch
+	// with the fee recipients of the validators.
+	go func() {
+		if err := v.pushFeeRecipients(context.Background(), resp.CurrentEpochDuties); err != nil {
+			log.WithError(err).Error("Failed to push fee recipients")
+		}
+	}()
+
+	// Non-blocking call for beacon node to report the liveness of the keys waiting for
+	// doppelganger protection.
+	go func() {
+		if err := v.checkDoppelgangers(context.Background(), req.Epoch, resp.CurrentEpochDuties); err != nil {
+			log.WithError(err).Error("Failed to check for doppelgangers")
+		}
+	}()
+
 	return nil
 }
 
@@ -590,6 +644,9 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[fieldpar
 		if duty == nil {
 			continue
 		}
+		if v.doppelganger.protected(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
 		if len(duty.ProposerSlots) > 0 {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
@@ -756,6 +813,20 @@ func (v *validator) AllValidatorsAreExited(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// sign signs the request with the keymanager once the signing policy, if any, allows it, and
+// records the outcome in the signing audit log.
+func (v *validator) sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	if v.signingPolicy != nil {
+		if err := v.signingPolicy.Check(ctx, req); err != nil {
+			v.auditSign(ctx, req, err)
+			return nil, err
+		}
+	}
+	sig, err := v.keyManager.Sign(ctx, req)
+	v.auditSign(ctx, req, err)
+	return sig, err
+}
+
 func (v *validator) domainData(ctx context.Context, epoch types.Epoch, domain []byte) (*ethpb.DomainResponse, error) {
 	v.domainDataLock.Lock()
 	defer v.domainDataLock.Unlock()
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/io/file"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// Audit prints the entries of the signing audit log of a validator database selected by the
+// command line flags, the latest slots first.
+func Audit(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !file.FileExists(path.Join(dataDir, kv.ProtectionDbFileName)) {
+		return errors.New("No validator db found at path, nothing to audit")
+	}
+	filter := &kv.AuditLogFilter{
+		StartSlot: types.Slot(cliCtx.Uint64(flags.AuditLogStartSlotFlag.Name)),
+		EndSlot:   types.Slot(cliCtx.Uint64(flags.AuditLogEndSlotFlag.Name)),
+		Limit:     cliCtx.Int(flags.AuditLogLimitFlag.Name),
+	}
+	if key := cliCtx.String(flags.AuditLogPublicKeyFlag.Name); key != "" {
+		pubKey, err := hexutil.Decode(key)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		filter.PublicKey = pubKey
+	}
+
+	ctx := context.Background()
+	validatorDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator db")
+		}
+	}()
+	entries, err := validatorDB.AuditLog(ctx, filter)
+	if err != nil {
+		return errors.Wrap(err, "could not read signing audit log")
+	}
+	w := cliCtx.App.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+	return writeAuditLog(w, entries)
+}
+
+// writeAuditLog writes the entries of the signing audit log as a table.
+func writeAuditLog(w io.Writer, entries []*kv.AuditLogEntry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "TIME\tPUBLIC KEY\tOBJECT\tSLOT\tOUTCOME\tKEYMANAGER\tDOMAIN\tSIGNING ROOT\tERROR"); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(
+			tw,
+			"%s\t%#x\t%s\t%d\t%s\t%s\t%#x\t%#x\t%s\n",
+			time.Unix(e.Timestamp, 0).UTC().Format(time.RFC3339),
+			e.PublicKey,
+			e.ObjectType,
+			e.Slot,
+			e.Outcome,
+			e.Keymanager,
+			e.SignatureDomain,
+			e.SigningRoot,
+			e.Error,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
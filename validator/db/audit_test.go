@@ -0,0 +1,57 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/urfave/cli/v2"
+)
+
+func TestAudit_NoDBFound(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, ""))
+	cliCtx := cli.NewContext(&app, set, nil)
+	err := Audit(cliCtx)
+	assert.ErrorContains(t, "No validator db found at path", err)
+}
+
+func TestAudit_OK(t *testing.T) {
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, nil)
+	for _, e := range []*kv.AuditLogEntry{
+		{Timestamp: 1, PublicKey: []byte{1}, ObjectType: "block", Slot: 10, Outcome: kv.AuditOutcomeSigned},
+		{Timestamp: 2, PublicKey: []byte{2}, ObjectType: "attestation", Slot: 11, Outcome: kv.AuditOutcomeSigned},
+		{Timestamp: 3, PublicKey: []byte{1}, ObjectType: "block", Slot: 10, Outcome: kv.AuditOutcomeBroadcastFailed, Error: "rejected"},
+	} {
+		require.NoError(t, validatorDB.AppendAuditLogEntry(ctx, e))
+	}
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+
+	out := &bytes.Buffer{}
+	app := cli.App{Writer: out}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	set.String(flags.AuditLogPublicKeyFlag.Name, "0x01", "")
+	set.Int(flags.AuditLogLimitFlag.Name, 100, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+	require.NoError(t, Audit(cliCtx))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[0], "TIME"))
+	assert.Equal(t, true, strings.Contains(lines[1], "broadcast_failed"))
+	assert.Equal(t, true, strings.Contains(lines[1], "rejected"))
+	assert.Equal(t, true, strings.Contains(lines[2], "1970-01-01T00:00:01Z"))
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/ethereum/go-ethereum/common"
 	types "github.com/prysmaticlabs/eth2-types"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
@@ -62,4 +63,22 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Fee recipient related methods.
+	FeeRecipientForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool, error)
+	SaveFeeRecipientForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, feeRecipient common.Address) error
+	DeleteFeeRecipientForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) error
+
+	// Signing policy related methods.
+	SaveSigningPolicyDecision(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, decision *kv.SigningPolicyDecision) error
+	SigningPolicyDecisions(
+		ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, start, end types.Slot,
+	) ([]*kv.SigningPolicyDecision, error)
+	PruneSigningPolicyDecisions(ctx context.Context, before types.Slot) (int, error)
+
+	// Signing audit log related methods.
+	AppendAuditLogEntry(ctx context.Context, entry *kv.AuditLogEntry) error
+	AppendAuditLogEntries(ctx context.Context, entries []*kv.AuditLogEntry) error
+	AuditLog(ctx context.Context, filter *kv.AuditLogFilter) ([]*kv.AuditLogEntry, error)
+	PruneAuditLog(ctx context.Context, before int64) (int, error)
 }
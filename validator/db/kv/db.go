@@ -151,6 +151,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			feeRecipientsBucket,
+			signingPolicyDecisionsBucket,
+			signingAuditLogBucket,
 		)
 	}); err != nil {
 		return nil, err
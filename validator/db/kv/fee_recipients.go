@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// FeeRecipientForPubKey returns the fee recipient set for a public key through the keymanager API,
+// and whether one was set.
+func (s *Store) FeeRecipientForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool, error) {
+	_, span := trace.StartSpan(ctx, "Validator.FeeRecipientForPubKey")
+	defer span.End()
+	var feeRecipient common.Address
+	var exists bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(feeRecipientsBucket)
+		enc := bkt.Get(publicKey[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		feeRecipient = common.BytesToAddress(enc)
+		exists = true
+		return nil
+	})
+	return feeRecipient, exists, err
+}
+
+// SaveFeeRecipientForPubKey sets the fee recipient for a public key.
+func (s *Store) SaveFeeRecipientForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, feeRecipient common.Address) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveFeeRecipientForPubKey")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(feeRecipientsBucket)
+		return bkt.Put(publicKey[:], feeRecipient.Bytes())
+	})
+}
+
+// DeleteFeeRecipientForPubKey removes the fee recipient set for a public key.
+func (s *Store) DeleteFeeRecipientForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "Validator.DeleteFeeRecipientForPubKey")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(feeRecipientsBucket)
+		return bkt.Delete(publicKey[:])
+	})
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_FeeRecipientForPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	_, exists, err := db.FeeRecipientForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	feeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+	require.NoError(t, db.SaveFeeRecipientForPubKey(ctx, pubKey, feeRecipient))
+	got, exists, err := db.FeeRecipientForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, feeRecipient, got)
+
+	// Fee recipients of other keys are not affected.
+	_, exists, err = db.FeeRecipientForPubKey(ctx, [fieldparams.BLSPubkeyLength]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	require.NoError(t, db.DeleteFeeRecipientForPubKey(ctx, pubKey))
+	_, exists, err = db.FeeRecipientForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
@@ -37,4 +37,13 @@ var (
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
+
+	// Fee recipients set through the keymanager API, keyed by public key.
+	feeRecipientsBucket = []byte("fee-recipients-bucket")
+
+	// Decisions of the signing policy, keyed by public key then by slot.
+	signingPolicyDecisionsBucket = []byte("signing-policy-decisions-bucket")
+
+	// Append-only audit log of the signing requests and broadcasts, keyed by insertion order.
+	signingAuditLogBucket = []byte("signing-audit-log-bucket")
 )
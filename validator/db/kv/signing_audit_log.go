@@ -0,0 +1,218 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Outcomes of the entries of the signing audit log.
+const (
+	// AuditOutcomeSigned is the outcome of a request signed by the keymanager.
+	AuditOutcomeSigned = "signed"
+	// AuditOutcomeRefused is the outcome of a request refused by the signing policy.
+	AuditOutcomeRefused = "refused"
+	// AuditOutcomeFailed is the outcome of a request the keymanager failed to sign.
+	AuditOutcomeFailed = "failed"
+	// AuditOutcomeBroadcast is the outcome of a signed object accepted by the beacon node.
+	AuditOutcomeBroadcast = "broadcast"
+	// AuditOutcomeBroadcastFailed is the outcome of a signed object the beacon node did not accept.
+	AuditOutcomeBroadcastFailed = "broadcast_failed"
+)
+
+// AuditLogEntry is an entry of the signing audit log, recording what was signed or broadcast for a
+// public key, when, and with which outcome.
+type AuditLogEntry struct {
+	Timestamp       int64      `json:"timestamp"`
+	PublicKey       []byte     `json:"public_key"`
+	ObjectType      string     `json:"object_type"`
+	Slot            types.Slot `json:"slot"`
+	SignatureDomain []byte     `json:"signature_domain,omitempty"`
+	SigningRoot     []byte     `json:"signing_root,omitempty"`
+	Keymanager      string     `json:"keymanager,omitempty"`
+	Outcome         string     `json:"outcome"`
+	Error           string     `json:"error,omitempty"`
+}
+
+// AuditLogFilter selects entries of the signing audit log.
+type AuditLogFilter struct {
+	// PublicKey selects the entries of a public key, or of all public keys if empty.
+	PublicKey []byte
+	// StartSlot and EndSlot select the entries of objects between the slots, both included. An end
+	// slot of 0 means no upper bound.
+	StartSlot types.Slot
+	EndSlot   types.Slot
+	// Limit is the maximum number of entries returned, 0 meaning no limit.
+	Limit int
+}
+
+// AppendAuditLogEntry appends an entry to the signing audit log.
+func (s *Store) AppendAuditLogEntry(ctx context.Context, entry *AuditLogEntry) error {
+	return s.AppendAuditLogEntries(ctx, []*AuditLogEntry{entry})
+}
+
+// AppendAuditLogEntries appends entries to the signing audit log, in order, in a single
+// transaction.
+func (s *Store) AppendAuditLogEntries(ctx context.Context, entries []*AuditLogEntry) error {
+	_, span := trace.StartSpan(ctx, "Validator.AppendAuditLogEntries")
+	defer span.End()
+	encoded := make([][]byte, len(entries))
+	for i, entry := range entries {
+		enc, err := json.Marshal(entry)
+		if err != nil {
+			return errors.Wrap(err, "could not encode audit log entry")
+		}
+		encoded[i] = enc
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(signingAuditLogBucket)
+		for i, entry := range entries {
+			keyBucket, err := bucket.CreateBucketIfNotExists(entry.PublicKey)
+			if err != nil {
+				return errors.Wrap(err, "could not create audit log bucket for public key")
+			}
+			// Entries are keyed by public key, then by slot and by insertion order across all
+			// public keys, so that they can be read by slot range.
+			seq, err := bucket.NextSequence()
+			if err != nil {
+				return err
+			}
+			if err := keyBucket.Put(auditLogKey(entry.Slot, seq), encoded[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func auditLogKey(slot types.Slot, seq uint64) []byte {
+	return append(bytesutil.SlotToBytesBigEndian(slot), bytesutil.Uint64ToBytesBigEndian(seq)...)
+}
+
+// AuditLog returns the entries of the signing audit log matching the filter, the latest slots first
+// and the most recent first within a slot.
+func (s *Store) AuditLog(ctx context.Context, filter *AuditLogFilter) ([]*AuditLogEntry, error) {
+	_, span := trace.StartSpan(ctx, "Validator.AuditLog")
+	defer span.End()
+	if filter == nil {
+		filter = &AuditLogFilter{}
+	}
+	end := filter.EndSlot
+	if end == 0 {
+		end = types.Slot(math.MaxUint64)
+	}
+	type keyedEntry struct {
+		key   []byte
+		entry *AuditLogEntry
+	}
+	var keyed []keyedEntry
+	err := s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(signingAuditLogBucket)
+		var pubKeys [][]byte
+		if len(filter.PublicKey) > 0 {
+			pubKeys = [][]byte{filter.PublicKey}
+		} else if err := bucket.ForEach(func(pubKey, _ []byte) error {
+			pubKeys = append(pubKeys, pubKey)
+			return nil
+		}); err != nil {
+			return err
+		}
+		min := bytesutil.SlotToBytesBigEndian(filter.StartSlot)
+		for _, pubKey := range pubKeys {
+			keyBucket := bucket.Bucket(pubKey)
+			if keyBucket == nil {
+				continue
+			}
+			// Only the entries of the slot range are read, up to the limit for each public key.
+			c := keyBucket.Cursor()
+			k, v := c.Seek(auditLogKey(end, math.MaxUint64))
+			if k == nil {
+				k, v = c.Last()
+			}
+			if k != nil && bytesutil.BytesToSlotBigEndian(k[:len(min)]) > end {
+				k, v = c.Prev()
+			}
+			for n := 0; k != nil && bytes.Compare(k[:len(min)], min) >= 0; k, v = c.Prev() {
+				if filter.Limit > 0 && n >= filter.Limit {
+					break
+				}
+				entry := &AuditLogEntry{}
+				if err := json.Unmarshal(v, entry); err != nil {
+					return errors.Wrap(err, "could not decode audit log entry")
+				}
+				keyed = append(keyed, keyedEntry{key: bytesutil.SafeCopyBytes(k), entry: entry})
+				n++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(keyed, func(i, j int) bool {
+		return bytes.Compare(keyed[i].key, keyed[j].key) > 0
+	})
+	if filter.Limit > 0 && len(keyed) > filter.Limit {
+		keyed = keyed[:filter.Limit]
+	}
+	entries := make([]*AuditLogEntry, len(keyed))
+	for i, e := range keyed {
+		entries[i] = e.entry
+	}
+	return entries, nil
+}
+
+// PruneAuditLog deletes the entries of the signing audit log with a timestamp before the given
+// unix time, and returns how many were deleted.
+func (s *Store) PruneAuditLog(ctx context.Context, before int64) (int, error) {
+	_, span := trace.StartSpan(ctx, "Validator.PruneAuditLog")
+	defer span.End()
+	var pruned int
+	err := s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(signingAuditLogBucket)
+		var pubKeys [][]byte
+		if err := bucket.ForEach(func(pubKey, _ []byte) error {
+			pubKeys = append(pubKeys, pubKey)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, pubKey := range pubKeys {
+			keyBucket := bucket.Bucket(pubKey)
+			if keyBucket == nil {
+				continue
+			}
+			// Entries are in order of slot rather than of timestamp, as objects for future slots
+			// and voluntary exits are signed ahead of their slot, so every entry is checked.
+			var expired [][]byte
+			if err := keyBucket.ForEach(func(k, v []byte) error {
+				entry := &AuditLogEntry{}
+				if err := json.Unmarshal(v, entry); err != nil {
+					return errors.Wrap(err, "could not decode audit log entry")
+				}
+				if entry.Timestamp < before {
+					expired = append(expired, bytesutil.SafeCopyBytes(k))
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			for _, k := range expired {
+				if err := keyBucket.Delete(k); err != nil {
+					return err
+				}
+			}
+			pruned += len(expired)
+		}
+		return nil
+	})
+	return pruned, err
+}
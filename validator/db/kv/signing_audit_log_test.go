@@ -0,0 +1,114 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_AuditLog(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, nil)
+
+	entries, err := db.AuditLog(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+
+	for _, e := range []*AuditLogEntry{
+		{Timestamp: 1, PublicKey: []byte{1}, ObjectType: "block", Slot: 10, Outcome: AuditOutcomeSigned},
+		{Timestamp: 2, PublicKey: []byte{1}, ObjectType: "block", Slot: 10, Outcome: AuditOutcomeBroadcast},
+		{Timestamp: 3, PublicKey: []byte{2}, ObjectType: "attestation", Slot: 12, Outcome: AuditOutcomeRefused},
+		{Timestamp: 4, PublicKey: []byte{1}, ObjectType: "attestation", Slot: 20, Outcome: AuditOutcomeFailed, Error: "timeout"},
+	} {
+		require.NoError(t, db.AppendAuditLogEntry(ctx, e))
+	}
+
+	tests := []struct {
+		name   string
+		filter *AuditLogFilter
+		want   []int64
+	}{
+		{name: "all", filter: nil, want: []int64{4, 3, 2, 1}},
+		{name: "public key", filter: &AuditLogFilter{PublicKey: []byte{1}}, want: []int64{4, 2, 1}},
+		{name: "slots", filter: &AuditLogFilter{StartSlot: 11, EndSlot: 19}, want: []int64{3}},
+		{name: "start slot", filter: &AuditLogFilter{StartSlot: 12}, want: []int64{4, 3}},
+		{name: "limit", filter: &AuditLogFilter{PublicKey: []byte{1}, Limit: 2}, want: []int64{4, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := db.AuditLog(ctx, tt.filter)
+			require.NoError(t, err)
+			timestamps := make([]int64, len(entries))
+			for i, e := range entries {
+				timestamps[i] = e.Timestamp
+			}
+			assert.DeepEqual(t, tt.want, timestamps)
+		})
+	}
+
+	pruned, err := db.PruneAuditLog(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+	entries, err = db.AuditLog(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.DeepEqual(t, &AuditLogEntry{
+		Timestamp: 4, PublicKey: []byte{1}, ObjectType: "attestation", Slot: 20, Outcome: AuditOutcomeFailed, Error: "timeout",
+	}, entries[0])
+
+	// New entries are appended after the remaining ones of their slot.
+	require.NoError(t, db.AppendAuditLogEntry(ctx, &AuditLogEntry{Timestamp: 5, PublicKey: []byte{2}, Slot: 20}))
+	entries, err = db.AuditLog(ctx, &AuditLogFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, int64(5), entries[0].Timestamp)
+}
+
+func TestStore_AuditLog_SlotRange(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, nil)
+	for slot := types.Slot(0); slot < 10; slot++ {
+		for _, pubKey := range [][]byte{{1}, {2}} {
+			require.NoError(t, db.AppendAuditLogEntry(ctx, &AuditLogEntry{Timestamp: int64(slot), PublicKey: pubKey, Slot: slot}))
+		}
+	}
+
+	entries, err := db.AuditLog(ctx, &AuditLogFilter{StartSlot: 3, EndSlot: 5, Limit: 4})
+	require.NoError(t, err)
+	got := make([]string, len(entries))
+	for i, e := range entries {
+		got[i] = fmt.Sprintf("%d/%d", e.PublicKey[0], e.Slot)
+	}
+	assert.DeepEqual(t, []string{"2/5", "1/5", "2/4", "1/4"}, got)
+
+	entries, err = db.AuditLog(ctx, &AuditLogFilter{PublicKey: []byte{1}, StartSlot: 8, EndSlot: 20})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, types.Slot(9), entries[0].Slot)
+	assert.Equal(t, types.Slot(8), entries[1].Slot)
+}
+
+func TestStore_PruneAuditLog_OutOfOrderTimestamps(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, nil)
+	// A voluntary exit for a future epoch is signed before the attestations of earlier slots.
+	require.NoError(t, db.AppendAuditLogEntries(ctx, []*AuditLogEntry{
+		{Timestamp: 1, PublicKey: []byte{1}, ObjectType: "voluntary_exit", Slot: 100},
+		{Timestamp: 5, PublicKey: []byte{1}, ObjectType: "attestation", Slot: 10},
+		{Timestamp: 2, PublicKey: []byte{1}, ObjectType: "attestation", Slot: 20},
+		{Timestamp: 6, PublicKey: []byte{1}, ObjectType: "attestation", Slot: 30},
+	}))
+
+	pruned, err := db.PruneAuditLog(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 2, pruned)
+	entries, err := db.AuditLog(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, types.Slot(30), entries[0].Slot)
+	assert.Equal(t, types.Slot(10), entries[1].Slot)
+}
@@ -0,0 +1,114 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SigningPolicyDecision is a decision of the signing policy of the validator client on a request
+// to sign an object for a public key.
+type SigningPolicyDecision struct {
+	Slot        types.Slot `json:"slot"`
+	ObjectType  string     `json:"object_type"`
+	SigningRoot []byte     `json:"signing_root"`
+	Allowed     bool       `json:"allowed"`
+	Reason      string     `json:"reason,omitempty"`
+	Timestamp   int64      `json:"timestamp"`
+}
+
+// SaveSigningPolicyDecision records a decision of the signing policy for a public key.
+func (s *Store) SaveSigningPolicyDecision(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, decision *SigningPolicyDecision,
+) error {
+	_, span := trace.StartSpan(ctx, "Validator.SaveSigningPolicyDecision")
+	defer span.End()
+	enc, err := json.Marshal(decision)
+	if err != nil {
+		return errors.Wrap(err, "could not encode signing policy decision")
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(signingPolicyDecisionsBucket)
+		valBucket, err := bucket.CreateBucketIfNotExists(publicKey[:])
+		if err != nil {
+			return errors.Wrap(err, "could not create signing policy decisions bucket for public key")
+		}
+		// Decisions are keyed by slot, then by insertion order, so that they can be read by slot range.
+		seq, err := valBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		key := append(bytesutil.SlotToBytesBigEndian(decision.Slot), bytesutil.Uint64ToBytesBigEndian(seq)...)
+		return valBucket.Put(key, enc)
+	})
+}
+
+// SigningPolicyDecisions returns the decisions of the signing policy for a public key on objects
+// of slots between start and end, both included, in order of slot.
+func (s *Store) SigningPolicyDecisions(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, start, end types.Slot,
+) ([]*SigningPolicyDecision, error) {
+	_, span := trace.StartSpan(ctx, "Validator.SigningPolicyDecisions")
+	defer span.End()
+	decisions := make([]*SigningPolicyDecision, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(signingPolicyDecisionsBucket).Bucket(publicKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		min := bytesutil.SlotToBytesBigEndian(start)
+		max := bytesutil.SlotToBytesBigEndian(end)
+		c := valBucket.Cursor()
+		for k, v := c.Seek(min); k != nil && bytes.Compare(k[:len(max)], max) <= 0; k, v = c.Next() {
+			decision := &SigningPolicyDecision{}
+			if err := json.Unmarshal(v, decision); err != nil {
+				return errors.Wrap(err, "could not decode signing policy decision")
+			}
+			decisions = append(decisions, decision)
+		}
+		return nil
+	})
+	return decisions, err
+}
+
+// PruneSigningPolicyDecisions deletes the decisions of the signing policy on objects of slots
+// before the given slot, for every public key, and returns how many were deleted.
+func (s *Store) PruneSigningPolicyDecisions(ctx context.Context, before types.Slot) (int, error) {
+	_, span := trace.StartSpan(ctx, "Validator.PruneSigningPolicyDecisions")
+	defer span.End()
+	var pruned int
+	err := s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(signingPolicyDecisionsBucket)
+		var pubKeys [][]byte
+		if err := bucket.ForEach(func(pubKey, _ []byte) error {
+			pubKeys = append(pubKeys, pubKey)
+			return nil
+		}); err != nil {
+			return err
+		}
+		max := bytesutil.SlotToBytesBigEndian(before)
+		for _, pubKey := range pubKeys {
+			valBucket := bucket.Bucket(pubKey)
+			if valBucket == nil {
+				continue
+			}
+			// Decisions are in order of slot, so pruning stops at the first recent decision.
+			c := valBucket.Cursor()
+			for k, _ := c.First(); k != nil && bytes.Compare(k[:len(max)], max) < 0; k, _ = c.First() {
+				if err := c.Delete(); err != nil {
+					return errors.Wrapf(err, "could not prune signing policy decision of slot %d", bytesutil.BytesToSlotBigEndian(k))
+				}
+				pruned++
+			}
+		}
+		return nil
+	})
+	return pruned, err
+}
@@ -0,0 +1,75 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestStore_SigningPolicyDecisions(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	decisions, err := db.SigningPolicyDecisions(ctx, pubKey, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(decisions))
+
+	for _, d := range []*SigningPolicyDecision{
+		{Slot: 10, ObjectType: "block", Allowed: true, Timestamp: 1},
+		{Slot: 2, ObjectType: "randao_reveal", Allowed: true, Timestamp: 2},
+		{Slot: 10, ObjectType: "attestation", Allowed: false, Reason: "too far", Timestamp: 3},
+		{Slot: 300, ObjectType: "exit", Allowed: false, Reason: "unconfirmed", Timestamp: 4},
+	} {
+		require.NoError(t, db.SaveSigningPolicyDecision(ctx, pubKey, d))
+	}
+	require.NoError(t, db.SaveSigningPolicyDecision(ctx, [fieldparams.BLSPubkeyLength]byte{2}, &SigningPolicyDecision{Slot: 10}))
+
+	tests := []struct {
+		start, end types.Slot
+		want       []int64
+	}{
+		{start: 0, end: 1000, want: []int64{2, 1, 3, 4}},
+		{start: 10, end: 10, want: []int64{1, 3}},
+		{start: 3, end: 299, want: []int64{1, 3}},
+		{start: 301, end: 1000, want: []int64{}},
+	}
+	for _, tt := range tests {
+		decisions, err := db.SigningPolicyDecisions(ctx, pubKey, tt.start, tt.end)
+		require.NoError(t, err)
+		timestamps := make([]int64, len(decisions))
+		for i, d := range decisions {
+			timestamps[i] = d.Timestamp
+		}
+		assert.DeepEqual(t, tt.want, timestamps, "slots %d to %d", tt.start, tt.end)
+	}
+	decisions, err = db.SigningPolicyDecisions(ctx, pubKey, 10, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &SigningPolicyDecision{Slot: 10, ObjectType: "attestation", Reason: "too far", Timestamp: 3}, decisions[1])
+}
+
+func TestStore_PruneSigningPolicyDecisions(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	db := setupDB(t, pubKeys)
+	for _, pubKey := range pubKeys {
+		for _, slot := range []types.Slot{5, 9, 10, 300} {
+			require.NoError(t, db.SaveSigningPolicyDecision(ctx, pubKey, &SigningPolicyDecision{Slot: slot}))
+		}
+	}
+
+	pruned, err := db.PruneSigningPolicyDecisions(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, pruned)
+	for _, pubKey := range pubKeys {
+		decisions, err := db.SigningPolicyDecisions(ctx, pubKey, 0, 1000)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(decisions))
+		assert.Equal(t, types.Slot(10), decisions[0].Slot)
+		assert.Equal(t, types.Slot(300), decisions[1].Slot)
+	}
+}
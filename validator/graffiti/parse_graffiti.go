@@ -2,10 +2,13 @@ package graffiti
 
 import (
 	"encoding/hex"
+	"fmt"
 	"io/ioutil"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"gopkg.in/yaml.v2"
 )
@@ -15,13 +18,16 @@ const (
 	hex0xPrefix       = "0x"
 )
 
-// Graffiti is a graffiti container.
+// Graffiti is a graffiti container. Graffiti values may contain template placeholders, which are
+// replaced by Render.
 type Graffiti struct {
-	Hash     [32]byte
+	Hash     [32]byte                        `yaml:"-"`
 	Default  string                          `yaml:"default,omitempty"`
 	Ordered  []string                        `yaml:"ordered,omitempty"`
 	Random   []string                        `yaml:"random,omitempty"`
 	Specific map[types.ValidatorIndex]string `yaml:"specific,omitempty"`
+	// Pubkeys holds the graffiti of validators keyed by their hex encoded public key.
+	Pubkeys map[string]string `yaml:"pubkeys,omitempty"`
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
@@ -30,6 +36,11 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	if err != nil {
 		return nil, err
 	}
+	return ParseGraffiti(yamlFile)
+}
+
+// ParseGraffiti parses the YAML content of a graffiti file and returns the graffiti struct.
+func ParseGraffiti(yamlFile []byte) (*Graffiti, error) {
 	g := &Graffiti{}
 	if err := yaml.UnmarshalStrict(yamlFile, g); err != nil {
 		if _, ok := err.(*yaml.TypeError); !ok {
@@ -43,6 +54,18 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 		g.Specific[i] = ParseHexGraffiti(o)
 	}
 
+	if len(g.Pubkeys) > 0 {
+		pubkeys := make(map[string]string, len(g.Pubkeys))
+		for k, v := range g.Pubkeys {
+			pubKey, err := hexutil.Decode(k)
+			if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+				return nil, fmt.Errorf("%q is not a hex encoded validator public key", k)
+			}
+			pubkeys[hexutil.Encode(pubKey)] = ParseHexGraffiti(v)
+		}
+		g.Pubkeys = pubkeys
+	}
+
 	for i, v := range g.Ordered {
 		g.Ordered[i] = ParseHexGraffiti(v)
 	}
@@ -57,6 +80,12 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	return g, nil
 }
 
+// ForPubkey returns the graffiti of the validator with the given public key, if any.
+func (g *Graffiti) ForPubkey(pubKey [fieldparams.BLSPubkeyLength]byte) (string, bool) {
+	graffiti, ok := g.Pubkeys[hexutil.Encode(pubKey[:])]
+	return graffiti, ok
+}
+
 // ParseHexGraffiti checks if a graffiti input is being represented in hex and converts it to ASCII if so
 func ParseHexGraffiti(rawGraffiti string) string {
 	splitGraffiti := strings.SplitN(rawGraffiti, ":", 2)
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/crypto/hash"
 	"github.com/prysmaticlabs/prysm/testing/assert"
@@ -219,3 +220,37 @@ func TestParseHexGraffiti(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGraffiti_Pubkeys(t *testing.T) {
+	input := []byte(`
+pubkeys:
+  "0xA99A76ED7796F7BE22D5B7E85DEEB7C5677E88E511E0B337618F8C4EB61349B4BF2D153F649F7B53359FE8B94A38E44C": "Hello"
+  "0x8a6f4b52ba4a4a5fb7dd4a5d9b5ae4a2d1a4b2d6c54e9e23f0b9c3e8b0b5e2f1c4f1e5a3b2c6d7e8f9a0b1c2d3e4f5a6": "hex:686f6c61"`)
+
+	got, err := ParseGraffiti(input)
+	require.NoError(t, err)
+
+	wanted := &Graffiti{
+		Hash: hash.Hash(input),
+		Pubkeys: map[string]string{
+			"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c": "Hello",
+			"0x8a6f4b52ba4a4a5fb7dd4a5d9b5ae4a2d1a4b2d6c54e9e23f0b9c3e8b0b5e2f1c4f1e5a3b2c6d7e8f9a0b1c2d3e4f5a6": "hola",
+		},
+	}
+	require.DeepEqual(t, wanted, got)
+
+	var pubKey [48]byte
+	copy(pubKey[:], hexutil.MustDecode("0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"))
+	g, ok := got.ForPubkey(pubKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "Hello", g)
+	_, ok = got.ForPubkey([48]byte{})
+	assert.Equal(t, false, ok)
+}
+
+func TestParseGraffiti_InvalidPubkey(t *testing.T) {
+	_, err := ParseGraffiti([]byte(`
+pubkeys:
+  "0x1234": "Hello"`))
+	require.ErrorContains(t, "\"0x1234\" is not a hex encoded validator public key", err)
+}
@@ -0,0 +1,120 @@
+package graffiti
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/config/params"
+)
+
+// Source keeps the graffiti of a graffiti file, and reloads it when the file changes.
+type Source struct {
+	lock     sync.RWMutex
+	path     string
+	content  []byte
+	graffiti *Graffiti
+}
+
+// NewSource parses the graffiti file at the given path.
+func NewSource(path string) (*Source, error) {
+	s := &Source{path: path}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Path of the graffiti file.
+func (s *Source) Path() string {
+	return s.path
+}
+
+// Graffiti returns the graffiti of the last valid content of the file.
+func (s *Source) Graffiti() *Graffiti {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.graffiti
+}
+
+// Content returns the last valid content of the file.
+func (s *Source) Content() []byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.content
+}
+
+// Update replaces the content of the graffiti file, after checking that it can be parsed. The
+// permissions of an existing file are kept.
+func (s *Source) Update(content []byte) error {
+	g, err := ParseGraffiti(content)
+	if err != nil {
+		return errors.Wrap(err, "could not parse graffiti")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := ioutil.WriteFile(s.path, content, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return errors.Wrap(err, "could not write graffiti file")
+	}
+	s.content = content
+	s.graffiti = g
+	return nil
+}
+
+// Watch reloads the graffiti file when it changes, until the context is canceled. The directory of
+// the file is watched, so that files replaced by editors are reloaded too.
+func (s *Source) Watch(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	if err := watcher.Add(filepath.Dir(s.path)); err != nil {
+		log.WithError(err).Errorf("Could not add directory of file %s to file watcher", s.path)
+		return
+	}
+	for {
+		select {
+		case ev := <-watcher.Events:
+			if filepath.Clean(ev.Name) != filepath.Clean(s.path) || ev.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			if err := s.reload(); err != nil {
+				log.WithError(err).Error("Could not reload graffiti file, keeping the previous graffiti")
+				continue
+			}
+			log.WithField("path", s.path).Info("Reloaded graffiti file")
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", s.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Source) reload() error {
+	content, err := ioutil.ReadFile(s.path) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not read graffiti file")
+	}
+	g, err := ParseGraffiti(content)
+	if err != nil {
+		return errors.Wrap(err, "could not parse graffiti file")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.graffiti != nil && s.graffiti.Hash == g.Hash {
+		return nil
+	}
+	s.content = content
+	s.graffiti = g
+	return nil
+}
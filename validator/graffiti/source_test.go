@@ -0,0 +1,53 @@
+package graffiti
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/crypto/hash"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+func TestSource_Update(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "Mr T was here"`), 0600))
+	s, err := NewSource(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Mr T was here", s.Graffiti().Default)
+
+	content := []byte(`default: "Mr A was here"`)
+	require.NoError(t, s.Update(content))
+	assert.Equal(t, "Mr A was here", s.Graffiti().Default)
+	assert.Equal(t, hash.Hash(content), s.Graffiti().Hash)
+	written, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, content, written)
+
+	// Invalid content is rejected and the file is kept.
+	require.ErrorContains(t, "could not parse graffiti", s.Update([]byte(`pubkeys: {"0x01": "bad"}`)))
+	assert.Equal(t, "Mr A was here", s.Graffiti().Default)
+	assert.DeepEqual(t, content, s.Content())
+}
+
+func TestSource_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "Mr T was here"`), 0600))
+	s, err := NewSource(path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx)
+	// Give the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`default: "Mr A was here"`), 0600))
+	for i := 0; i < 50 && s.Graffiti().Default != "Mr A was here"; i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Equal(t, "Mr A was here", s.Graffiti().Default)
+}
@@ -0,0 +1,62 @@
+package graffiti
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/runtime/version"
+)
+
+// Placeholders which are replaced in graffiti templates.
+const (
+	// IndexPlaceholder is replaced by the index of the validator.
+	IndexPlaceholder = "{index}"
+	// PubkeyPlaceholder is replaced by the first 4 bytes of the public key of the validator, in hex.
+	PubkeyPlaceholder = "{pubkey}"
+	// VersionPlaceholder is replaced by the version of the validator client.
+	VersionPlaceholder = "{version}"
+	// ExecutionClientPlaceholder is replaced by the name of the execution client of the beacon node.
+	ExecutionClientPlaceholder = "{execution_client}"
+	// EpochPlaceholder is replaced by the epoch of the proposal.
+	EpochPlaceholder = "{epoch}"
+)
+
+// maxGraffitiLength is the size of the graffiti field of a block.
+const maxGraffitiLength = 32
+
+// TemplateData holds the values of the placeholders of a graffiti template.
+type TemplateData struct {
+	Index  types.ValidatorIndex
+	PubKey [fieldparams.BLSPubkeyLength]byte
+	Epoch  types.Epoch
+	// ExecutionClient is the version of the execution client as reported by web3_clientVersion,
+	// of which only the name is used.
+	ExecutionClient string
+}
+
+// Render replaces the placeholders of the graffiti template with the values of data. The result is
+// truncated to the size of the graffiti field of a block, without splitting a UTF-8 character.
+func Render(template string, data *TemplateData) []byte {
+	if strings.Contains(template, "{") {
+		executionClient := strings.SplitN(data.ExecutionClient, "/", 2)[0]
+		template = strings.NewReplacer(
+			IndexPlaceholder, strconv.FormatUint(uint64(data.Index), 10),
+			PubkeyPlaceholder, hex.EncodeToString(data.PubKey[:4]),
+			VersionPlaceholder, version.SemanticVersion(),
+			ExecutionClientPlaceholder, executionClient,
+			EpochPlaceholder, strconv.FormatUint(uint64(data.Epoch), 10),
+		).Replace(template)
+	}
+	if len(template) > maxGraffitiLength {
+		end := maxGraffitiLength
+		for end > 0 && !utf8.RuneStart(template[end]) {
+			end--
+		}
+		return []byte(template[:end])
+	}
+	return []byte(template)
+}
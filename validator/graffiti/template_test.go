@@ -0,0 +1,63 @@
+package graffiti
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/runtime/version"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+)
+
+func TestRender(t *testing.T) {
+	data := &TemplateData{
+		Index:           1234,
+		PubKey:          [48]byte{0xa9, 0x9a, 0x76, 0xed, 0x77},
+		Epoch:           56,
+		ExecutionClient: "Geth/v1.10.17-stable/linux-amd64/go1.18",
+	}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "no placeholder",
+			template: "Mr T was here",
+			want:     "Mr T was here",
+		},
+		{
+			name:     "index and pubkey",
+			template: "{index} {pubkey}",
+			want:     "1234 a99a76ed",
+		},
+		{
+			name:     "execution client and epoch",
+			template: "{execution_client} at {epoch}",
+			want:     "Geth at 56",
+		},
+		{
+			name:     "version",
+			template: "{version}",
+			want:     version.SemanticVersion(),
+		},
+		{
+			name:     "unknown placeholder",
+			template: "{foo}",
+			want:     "{foo}",
+		},
+		{
+			name:     "truncated",
+			template: "{pubkey} {pubkey} {pubkey} {pubkey}",
+			want:     "a99a76ed a99a76ed a99a76ed a99a7",
+		},
+		{
+			name:     "truncated at a character boundary",
+			template: "{pubkey} {pubkey} {pubkey} abcdé",
+			want:     "a99a76ed a99a76ed a99a76ed abcd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(Render(tt.template, data)))
+		})
+	}
+}
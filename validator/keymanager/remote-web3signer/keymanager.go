@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -20,7 +22,10 @@ import (
 // a keymanager, such as passwords, the wallet, and more.
 // Web3Signer contains one public keys option. Either through a URL or a static key list.
 type SetupConfig struct {
-	BaseEndpoint          string
+	BaseEndpoint string
+
+	// GenesisValidatorsRoot is sent to the web3signer with every sign request. It may be left
+	// empty and set with SetGenesisValidatorsRoot once the validator client knows it.
 	GenesisValidatorsRoot []byte
 
 	// Either URL or keylist must be set.
@@ -32,58 +37,120 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// PublicKeysPollInterval is how often the public keys are fetched again from the URL once
+	// account changes are subscribed to. Defaults to DefaultPublicKeysPollInterval.
+	PublicKeysPollInterval time.Duration
+
+	// RemoteKeysFile is the file the public keys imported through the remote keys API are
+	// persisted to. If empty, imported keys are lost on restart.
+	RemoteKeysFile string
 }
 
+const (
+	// DefaultPublicKeysPollInterval is how often the public keys are fetched again from the URL by
+	// default.
+	DefaultPublicKeysPollInterval = time.Minute
+	// DefaultRemoteKeysFileName is the name of the remote keys file in the wallet directory.
+	DefaultRemoteKeysFileName = "remote-keys.json"
+)
+
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
-	client                httpSignerClient
+	ctx                    context.Context
+	client                 httpSignerClient
+	baseEndpoint           string
+	publicKeysURL          string
+	publicKeysPollInterval time.Duration
+	remoteKeysFile         string
+	accountsChangedFeed    *event.Feed
+	pollOnce               sync.Once
+
+	keysLock sync.RWMutex
+	// genesisValidatorsRoot is guarded by the keys lock, as it may be set after initialization.
 	genesisValidatorsRoot []byte
-	publicKeysURL         string
-	providedPublicKeys    [][48]byte
-	accountsChangedFeed   *event.Feed
+	// providedPublicKeys are the keys provided in the setup config or fetched from the URL, which
+	// cannot be deleted through the remote keys API.
+	providedPublicKeys [][48]byte
+	// importedPublicKeys are the keys imported through the remote keys API.
+	importedPublicKeys [][48]byte
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
-	if cfg.BaseEndpoint == "" || len(cfg.GenesisValidatorsRoot) == 0 {
-		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %v", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.BaseEndpoint == "" {
+		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v", cfg.BaseEndpoint)
 	}
 	if cfg.PublicKeysURL != "" && len(cfg.ProvidedPublicKeys) != 0 {
 		return nil, errors.New("Either a provided list of public keys or a URL to a list of public keys must be provided, but not both")
 	}
-	if cfg.PublicKeysURL == "" && len(cfg.ProvidedPublicKeys) == 0 {
+	if cfg.PublicKeysURL == "" && len(cfg.ProvidedPublicKeys) == 0 && cfg.RemoteKeysFile == "" {
 		return nil, errors.New("no valid public key options provided")
 	}
 	client, err := newApiClient(cfg.BaseEndpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
-		client:                httpSignerClient(client),
-		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
-		accountsChangedFeed:   new(event.Feed),
-		publicKeysURL:         cfg.PublicKeysURL,
-		providedPublicKeys:    cfg.ProvidedPublicKeys,
-	}, nil
+	pollInterval := cfg.PublicKeysPollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultPublicKeysPollInterval
+	}
+	km := &Keymanager{
+		ctx:                    ctx,
+		client:                 httpSignerClient(client),
+		genesisValidatorsRoot:  cfg.GenesisValidatorsRoot,
+		baseEndpoint:           cfg.BaseEndpoint,
+		accountsChangedFeed:    new(event.Feed),
+		publicKeysURL:          cfg.PublicKeysURL,
+		publicKeysPollInterval: pollInterval,
+		remoteKeysFile:         cfg.RemoteKeysFile,
+		providedPublicKeys:     cfg.ProvidedPublicKeys,
+	}
+	if cfg.RemoteKeysFile != "" {
+		km.importedPublicKeys, err = readRemoteKeysFile(cfg.RemoteKeysFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read remote keys file")
+		}
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
-// or provides the existing keys in the keymanager.
+// or provides the existing keys in the keymanager, along with the keys imported through
+// the remote keys API.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
-	if km.publicKeysURL != "" && len(km.providedPublicKeys) == 0 {
+	km.keysLock.RLock()
+	fetch := km.publicKeysURL != "" && len(km.providedPublicKeys) == 0
+	km.keysLock.RUnlock()
+	if fetch {
 		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
 			return nil, err
 		}
+		km.keysLock.Lock()
 		km.providedPublicKeys = providedPublicKeys
+		km.keysLock.Unlock()
 	}
-	return km.providedPublicKeys, nil
+	km.keysLock.RLock()
+	defer km.keysLock.RUnlock()
+	return km.publicKeys(), nil
+}
+
+// SetGenesisValidatorsRoot sets the genesis validators root sent to the web3signer with every
+// sign request.
+func (km *Keymanager) SetGenesisValidatorsRoot(genesisValidatorsRoot []byte) {
+	km.keysLock.Lock()
+	defer km.keysLock.Unlock()
+	km.genesisValidatorsRoot = genesisValidatorsRoot
 }
 
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
-	signRequest, err := getSignRequestJson(request, km.genesisValidatorsRoot)
+	km.keysLock.RLock()
+	genesisValidatorsRoot := km.genesisValidatorsRoot
+	km.keysLock.RUnlock()
+	signRequest, err := getSignRequestJson(request, genesisValidatorsRoot)
 	if err != nil {
 		return nil, err
 	}
@@ -178,14 +245,16 @@ func getSignRequestJson(request *validatorpb.SignRequest, genesisValidatorsRoot
 	}
 }
 
-// SubscribeAccountChanges returns the event subscription for changes to public keys.
-func (*Keymanager) SubscribeAccountChanges(_ chan [][48]byte) event.Subscription {
-	// Not used right now.
-	// Returns a stub for the time being as there is a danger of being slashed if the apiClient reloads keys dynamically.
-	// Because there is no way to dynamically reload keys, add or remove remote keys we are returning a stub without any event updates for the time being.
-	return event.NewSubscription(func(i <-chan struct{}) error {
-		return nil
-	})
+// SubscribeAccountChanges returns the event subscription for changes to public keys, which are
+// sent when keys are imported or deleted through the remote keys API and, if the public keys are
+// fetched from a URL, when the keys served at the URL change.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	if km.publicKeysURL != "" {
+		km.pollOnce.Do(func() {
+			go km.pollPublicKeys(km.ctx)
+		})
+	}
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
 }
 
 // UnmarshalConfigFile attempts to JSON unmarshal a keymanager
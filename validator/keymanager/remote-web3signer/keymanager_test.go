@@ -177,6 +177,29 @@ func TestKeymanager_Sign(t *testing.T) {
 
 }
 
+func TestKeymanager_Sign_GenesisValidatorsRootSetLater(t *testing.T) {
+	client := &MockClient{
+		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
+	}
+	ctx := context.Background()
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:  "example.com",
+		PublicKeysURL: "example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = client
+
+	_, err = km.Sign(ctx, v1.GetMockSignRequest("ATTESTATION"))
+	require.ErrorContains(t, "invalid genesis validators root length", err)
+
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km.SetGenesisValidatorsRoot(root)
+	sig, err := km.Sign(ctx, v1.GetMockSignRequest("ATTESTATION"))
+	require.NoError(t, err)
+	require.DeepEqual(t, client.Signature, hexutil.Encode(sig.Marshal()))
+}
+
 func TestKeymanager_FetchValidatingPublicKeys_HappyPath_WithKeyList(t *testing.T) {
 	ctx := context.Background()
 	decodedKey, err := hexutil.Decode("0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820")
@@ -0,0 +1,308 @@
+package remote_web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/io/file"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+)
+
+// ListRemoteKeys returns the public keys the keymanager signs with, along with the URL of the
+// web3signer holding them. Keys provided in the setup config or fetched from the public keys URL
+// are read only.
+func (km *Keymanager) ListRemoteKeys(_ context.Context) ([]*ethpbservice.ListRemoteKeysResponse_Keystore, error) {
+	km.keysLock.RLock()
+	defer km.keysLock.RUnlock()
+	provided := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(km.providedPublicKeys))
+	for _, pubKey := range km.providedPublicKeys {
+		provided[pubKey] = true
+	}
+	pubKeys := km.publicKeys()
+	keys := make([]*ethpbservice.ListRemoteKeysResponse_Keystore, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		keys[i] = &ethpbservice.ListRemoteKeysResponse_Keystore{
+			Pubkey:   bytesutil.SafeCopyBytes(pubKey[:]),
+			Url:      km.baseEndpoint,
+			Readonly: provided[pubKey],
+		}
+	}
+	return keys, nil
+}
+
+// ImportRemoteKeys adds public keys held by the web3signer to the keys the keymanager signs with.
+// The imported keys are persisted to the remote keys file, and the subscribers to account changes
+// are notified of the new set of keys.
+func (km *Keymanager) ImportRemoteKeys(
+	_ context.Context, remoteKeys []*ethpbservice.ImportRemoteKeysRequest_RemoteKey,
+) ([]*ethpbservice.ImportedRemoteKeysStatus, error) {
+	statuses, pubKeys, err := km.importRemoteKeys(remoteKeys)
+	if err != nil {
+		return nil, err
+	}
+	if pubKeys != nil {
+		km.accountsChangedFeed.Send(pubKeys)
+	}
+	return statuses, nil
+}
+
+// importRemoteKeys returns the import statuses of the remote keys, along with the new set of
+// public keys if any were imported.
+func (km *Keymanager) importRemoteKeys(
+	remoteKeys []*ethpbservice.ImportRemoteKeysRequest_RemoteKey,
+) ([]*ethpbservice.ImportedRemoteKeysStatus, [][fieldparams.BLSPubkeyLength]byte, error) {
+	km.keysLock.Lock()
+	defer km.keysLock.Unlock()
+	known := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, pubKey := range km.publicKeys() {
+		known[pubKey] = true
+	}
+	imported := make([][fieldparams.BLSPubkeyLength]byte, 0, len(remoteKeys))
+	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(remoteKeys))
+	for i, remoteKey := range remoteKeys {
+		if len(remoteKey.Pubkey) != fieldparams.BLSPubkeyLength {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("invalid public key length %d", len(remoteKey.Pubkey)),
+			}
+			continue
+		}
+		if remoteKey.Url != "" && !sameEndpoint(remoteKey.Url, km.baseEndpoint) {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("keys can only be imported from the configured web3signer %s", km.baseEndpoint),
+			}
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(remoteKey.Pubkey)
+		if known[pubKey] {
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_DUPLICATE,
+				Message: fmt.Sprintf("duplicate public key %#x", remoteKey.Pubkey),
+			}
+			continue
+		}
+		known[pubKey] = true
+		imported = append(imported, pubKey)
+		statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{Status: ethpbservice.ImportedRemoteKeysStatus_IMPORTED}
+	}
+	if len(imported) == 0 {
+		return statuses, nil, nil
+	}
+
+	importedPublicKeys := append(append([][fieldparams.BLSPubkeyLength]byte{}, km.importedPublicKeys...), imported...)
+	if err := km.saveImportedPublicKeys(importedPublicKeys); err != nil {
+		return nil, nil, err
+	}
+	log.WithField("count", len(imported)).Info("Imported remote keys")
+	return statuses, km.publicKeys(), nil
+}
+
+// DeleteRemoteKeys removes public keys imported through the remote keys API from the keys the
+// keymanager signs with. Keys provided in the setup config or fetched from the public keys URL
+// cannot be deleted.
+func (km *Keymanager) DeleteRemoteKeys(_ context.Context, publicKeys [][]byte) ([]*ethpbservice.DeletedRemoteKeysStatus, error) {
+	statuses, pubKeys, err := km.deleteRemoteKeys(publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if pubKeys != nil {
+		km.accountsChangedFeed.Send(pubKeys)
+	}
+	return statuses, nil
+}
+
+// deleteRemoteKeys returns the deletion statuses of the public keys, along with the new set of
+// public keys if any were deleted.
+func (km *Keymanager) deleteRemoteKeys(
+	publicKeys [][]byte,
+) ([]*ethpbservice.DeletedRemoteKeysStatus, [][fieldparams.BLSPubkeyLength]byte, error) {
+	km.keysLock.Lock()
+	defer km.keysLock.Unlock()
+	provided := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(km.providedPublicKeys))
+	for _, pubKey := range km.providedPublicKeys {
+		provided[pubKey] = true
+	}
+	deleted := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(publicKeys))
+	imported := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(km.importedPublicKeys))
+	for _, pubKey := range km.importedPublicKeys {
+		imported[pubKey] = true
+	}
+	statuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		pubKey := bytesutil.ToBytes48(publicKey)
+		switch {
+		case len(publicKey) != fieldparams.BLSPubkeyLength:
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+				Status:  ethpbservice.DeletedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("invalid public key length %d", len(publicKey)),
+			}
+		case provided[pubKey]:
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+				Status:  ethpbservice.DeletedRemoteKeysStatus_ERROR,
+				Message: fmt.Sprintf("public key %#x is read only", publicKey),
+			}
+		case imported[pubKey] || deleted[pubKey]:
+			deleted[pubKey] = true
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{Status: ethpbservice.DeletedRemoteKeysStatus_DELETED}
+		default:
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{Status: ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND}
+		}
+	}
+	if len(deleted) == 0 {
+		return statuses, nil, nil
+	}
+
+	importedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.importedPublicKeys))
+	for _, pubKey := range km.importedPublicKeys {
+		if !deleted[pubKey] {
+			importedPublicKeys = append(importedPublicKeys, pubKey)
+		}
+	}
+	if err := km.saveImportedPublicKeys(importedPublicKeys); err != nil {
+		return nil, nil, err
+	}
+	log.WithField("count", len(deleted)).Info("Deleted remote keys")
+	return statuses, km.publicKeys(), nil
+}
+
+// pollPublicKeys fetches the public keys from the URL once per poll interval, and notifies the
+// subscribers to account changes when they change.
+func (km *Keymanager) pollPublicKeys(ctx context.Context) {
+	ticker := time.NewTicker(km.publicKeysPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := km.refreshPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not fetch public keys from web3signer")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshPublicKeys fetches the public keys from the URL, and notifies the subscribers to account
+// changes if they differ from the keys fetched before.
+func (km *Keymanager) refreshPublicKeys(ctx context.Context) error {
+	fetched, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		return err
+	}
+	km.keysLock.Lock()
+	if equalKeys(fetched, km.providedPublicKeys) {
+		km.keysLock.Unlock()
+		return nil
+	}
+	km.providedPublicKeys = fetched
+	pubKeys := km.publicKeys()
+	km.keysLock.Unlock()
+	km.accountsChangedFeed.Send(pubKeys)
+	log.WithField("count", len(fetched)).Info("Public keys of web3signer changed")
+	return nil
+}
+
+// publicKeys returns the provided keys followed by the imported keys, without duplicates.
+// The caller must hold the keys lock.
+func (km *Keymanager) publicKeys() [][fieldparams.BLSPubkeyLength]byte {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.providedPublicKeys)+len(km.importedPublicKeys))
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool, cap(keys))
+	for _, pubKey := range append(km.providedPublicKeys, km.importedPublicKeys...) {
+		if seen[pubKey] {
+			continue
+		}
+		seen[pubKey] = true
+		keys = append(keys, pubKey)
+	}
+	return keys
+}
+
+// saveImportedPublicKeys persists the imported keys to the remote keys file, if any, before
+// replacing them in memory. The caller must hold the keys lock.
+func (km *Keymanager) saveImportedPublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	if km.remoteKeysFile != "" {
+		if err := writeRemoteKeysFile(km.remoteKeysFile, pubKeys); err != nil {
+			return errors.Wrap(err, "could not write remote keys file")
+		}
+	}
+	km.importedPublicKeys = pubKeys
+	return nil
+}
+
+// readRemoteKeysFile reads the JSON list of hex encoded public keys in the remote keys file. A
+// missing file holds no keys.
+func readRemoteKeysFile(path string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	if !file.FileExists(path) {
+		return nil, nil
+	}
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	var hexKeys []string
+	if err := json.Unmarshal(enc, &hexKeys); err != nil {
+		return nil, err
+	}
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		pubKey, err := hexutil.Decode(hexKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", hexKey)
+		}
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("invalid public key length %d", len(pubKey))
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// writeRemoteKeysFile writes the public keys to the remote keys file as a JSON list of hex encoded
+// keys, the same format as the public keys endpoint of web3signer.
+func writeRemoteKeysFile(path string, pubKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	hexKeys := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		hexKeys[i] = hexutil.Encode(pubKey[:])
+	}
+	enc, err := json.MarshalIndent(hexKeys, "", "\t")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	exists, err := file.HasDir(dir)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := file.MkdirAll(dir); err != nil {
+			return err
+		}
+	}
+	return file.WriteFile(path, enc)
+}
+
+func equalKeys(a, b [][fieldparams.BLSPubkeyLength]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameEndpoint returns whether two URLs refer to the same web3signer, ignoring trailing slashes.
+func sameEndpoint(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
@@ -0,0 +1,157 @@
+package remote_web3signer
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+type mockPublicKeysClient struct {
+	sync.Mutex
+	publicKeys [][48]byte
+}
+
+func (*mockPublicKeysClient) Sign(_ context.Context, _ string, _ SignRequestJson) (bls.Signature, error) {
+	return nil, nil
+}
+
+func (mc *mockPublicKeysClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, error) {
+	mc.Lock()
+	defer mc.Unlock()
+	return append([][48]byte{}, mc.publicKeys...), nil
+}
+
+func (mc *mockPublicKeysClient) setPublicKeys(keys [][48]byte) {
+	mc.Lock()
+	defer mc.Unlock()
+	mc.publicKeys = keys
+}
+
+func testPubKey(b byte) [48]byte {
+	return bytesutil.ToBytes48(bytesutil.PadTo([]byte{b}, 48))
+}
+
+func newRemoteKeysKeymanager(t *testing.T, cfg *SetupConfig) *Keymanager {
+	cfg.BaseEndpoint = "http://example.com"
+	cfg.GenesisValidatorsRoot = make([]byte, 32)
+	km, err := NewKeymanager(context.Background(), cfg)
+	require.NoError(t, err)
+	return km
+}
+
+func TestKeymanager_ImportRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	remoteKeysFile := filepath.Join(t.TempDir(), "remote-keys.json")
+	provided := testPubKey(1)
+	km := newRemoteKeysKeymanager(t, &SetupConfig{
+		ProvidedPublicKeys: [][48]byte{provided},
+		RemoteKeysFile:     remoteKeysFile,
+	})
+	pubKeysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	imported := testPubKey(2)
+	statuses, err := km.ImportRemoteKeys(ctx, []*ethpbservice.ImportRemoteKeysRequest_RemoteKey{
+		{Pubkey: imported[:], Url: "http://example.com/"},
+		{Pubkey: provided[:]},
+		{Pubkey: imported[:]},
+		{Pubkey: []byte{1}},
+		{Pubkey: bytesutil.PadTo([]byte{3}, 48), Url: "http://other.com"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(statuses))
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, statuses[0].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_DUPLICATE, statuses[1].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_DUPLICATE, statuses[2].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_ERROR, statuses[3].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_ERROR, statuses[4].Status)
+
+	want := [][48]byte{provided, imported}
+	require.DeepEqual(t, want, <-pubKeysChan)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, keys)
+
+	listed, err := km.ListRemoteKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(listed))
+	require.DeepEqual(t, provided[:], listed[0].Pubkey)
+	require.Equal(t, true, listed[0].Readonly)
+	require.DeepEqual(t, imported[:], listed[1].Pubkey)
+	require.Equal(t, false, listed[1].Readonly)
+	require.Equal(t, "http://example.com", listed[1].Url)
+
+	// The imported keys are loaded again from the remote keys file.
+	km = newRemoteKeysKeymanager(t, &SetupConfig{RemoteKeysFile: remoteKeysFile})
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{imported}, keys)
+}
+
+func TestKeymanager_DeleteRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	remoteKeysFile := filepath.Join(t.TempDir(), "remote-keys.json")
+	provided := testPubKey(1)
+	imported := testPubKey(2)
+	km := newRemoteKeysKeymanager(t, &SetupConfig{
+		ProvidedPublicKeys: [][48]byte{provided},
+		RemoteKeysFile:     remoteKeysFile,
+	})
+	_, err := km.ImportRemoteKeys(ctx, []*ethpbservice.ImportRemoteKeysRequest_RemoteKey{{Pubkey: imported[:]}})
+	require.NoError(t, err)
+	pubKeysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	statuses, err := km.DeleteRemoteKeys(ctx, [][]byte{imported[:], provided[:], bytesutil.PadTo([]byte{3}, 48), imported[:]})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(statuses))
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, statuses[0].Status)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_ERROR, statuses[1].Status)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, statuses[2].Status)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, statuses[3].Status)
+	require.DeepEqual(t, [][48]byte{provided}, <-pubKeysChan)
+
+	km = newRemoteKeysKeymanager(t, &SetupConfig{RemoteKeysFile: remoteKeysFile})
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keys))
+}
+
+func TestKeymanager_PollPublicKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &mockPublicKeysClient{publicKeys: [][48]byte{testPubKey(1)}}
+	km := newRemoteKeysKeymanager(t, &SetupConfig{
+		PublicKeysURL:          "http://example.com/api/v1/eth2/publicKeys",
+		PublicKeysPollInterval: 10 * time.Millisecond,
+	})
+	km.ctx = ctx
+	km.client = client
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{testPubKey(1)}, keys)
+
+	pubKeysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+	want := [][48]byte{testPubKey(1), testPubKey(2)}
+	client.setPublicKeys(want)
+	select {
+	case keys := <-pubKeysChan:
+		require.DeepEqual(t, want, keys)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive changed public keys")
+	}
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, keys)
+}
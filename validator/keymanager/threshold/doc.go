@@ -0,0 +1,50 @@
+/*
+Package threshold defines a keymanager implementation in which validator keys are split into
+shares held by several signers, of which a threshold is needed to produce a signature. No signer
+ever holds the validator key itself.
+
+A validator key is split with Shamir's secret sharing by the keygen command, which decrypts an
+EIP-2335 keystore and writes one share file per signer, each encrypted with the password of the
+keystore. Shares are encrypted with the password of the wallet when imported. A share file has the following schema:
+
+ {
+   "index": 1,                  // Index of the share, starting at 1.
+   "threshold": 2,              // Number of shares needed to sign.
+   "public_key": "0x...",       // Public key of the validator.
+   "share_public_keys": {       // Public keys of all the shares, by index.
+     "1": "0x...",
+     "2": "0x...",
+     "3": "0x..."
+   },
+   "keystore": {...}            // EIP-2335 keystore of the secret key of the share.
+ }
+
+To sign, the keymanager signs with its own share and requests the partial signatures of the other
+shares from its peers over HTTP. Each partial signature is verified against the public key of its
+share, and the first valid threshold of them are combined into the signature of the validator by
+Lagrange interpolation. The keymanager of a running validator client serves the partial signatures
+of its own shares to its peers, so every signer can sign with the help of the others:
+
+ POST /threshold/v1/sign
+ Authorization: Bearer <auth_token>
+ {"publicKey": "...", "signingRoot": "...", "signatureDomain": "...", "attestationData": {...}}
+
+ {"index": 2, "signature": "0x..."}
+
+The request is the sign request of the validator client, with the object to sign. A peer only
+serves requests bearing the auth token shared by the signers, and refuses to start serving without
+one. It checks that the signing root is the one of the object with its signature domain, and runs
+its own slashing protection and signing policy on the object before returning a partial signature,
+so a peer cannot get a slashable object signed. Voluntary exits are refused by the peers whose
+signing policy requires exits to be confirmed.
+
+The threshold keymanager can be customized via a keymanageropts.json file
+which requires the following schema:
+
+ {
+   "peers": ["http://signer-2:7600", "http://signer-3:7600"], // Signers of the other shares.
+   "listen_address": "0.0.0.0:7600",                          // Address serving partial signatures.
+   "auth_token": "secret"                                     // Bearer token shared by the signers.
+ }
+*/
+package threshold
@@ -0,0 +1,273 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+const (
+	// SharesPath is the path of the shares file in the wallet.
+	SharesPath = "shares"
+	// SharesFileName is the name of the file holding the shares of the wallet.
+	SharesFileName = "all-shares.json"
+)
+
+// peerTimeout is how long the partial signatures of the peers are waited for.
+const peerTimeout = 2 * time.Second
+
+// KeymanagerOpts for a threshold keymanager.
+type KeymanagerOpts struct {
+	Peers         []string `json:"peers"`
+	ListenAddress string   `json:"listen_address"`
+	AuthToken     string   `json:"auth_token"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as passwords, the wallet, and more.
+type SetupConfig struct {
+	Wallet iface.Wallet
+	Opts   *KeymanagerOpts
+	// ServePeers serves the partial signatures of the shares to the peers on the listen address.
+	ServePeers bool
+}
+
+// Keymanager implementation signing with shares of the validator keys, and the partial signatures
+// of the shares of its peers.
+type Keymanager struct {
+	wallet              iface.Wallet
+	opts                *KeymanagerOpts
+	client              *http.Client
+	accountsChangedFeed *event.Feed
+
+	lock               sync.RWMutex
+	shares             map[[fieldparams.BLSPubkeyLength]byte]*share
+	peerRequestChecker PeerRequestChecker
+}
+
+// NewKeymanager instantiates a new threshold keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("missing keymanager options")
+	}
+	km := &Keymanager{
+		wallet:              cfg.Wallet,
+		opts:                cfg.Opts,
+		client:              &http.Client{Timeout: peerTimeout},
+		accountsChangedFeed: new(event.Feed),
+		shares:              make(map[[fieldparams.BLSPubkeyLength]byte]*share),
+	}
+	stored, err := km.readShares(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stored {
+		decrypted, err := decryptShare(s, cfg.Wallet.Password())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decrypt share of public key %s", s.PublicKey)
+		}
+		km.shares[decrypted.pubKey()] = decrypted
+	}
+	if cfg.ServePeers && cfg.Opts.ListenAddress != "" {
+		if cfg.Opts.AuthToken == "" {
+			return nil, errors.New("an auth token shared by the peers is required to serve partial signatures")
+		}
+		listener, err := net.Listen("tcp", cfg.Opts.ListenAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not listen for peers")
+		}
+		go km.servePeers(ctx, listener)
+	}
+	return km, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of threshold keymanager options. The auth token is not printed.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	strPeers := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Peers"), strings.Join(opts.Peers, ", "))
+	if _, err := b.WriteString(strPeers); err != nil {
+		log.Error(err)
+		return ""
+	}
+	strAddr := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Listen address"), opts.ListenAddress)
+	if _, err := b.WriteString(strAddr); err != nil {
+		log.Error(err)
+		return ""
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the threshold keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// SetPeerRequestChecker sets the checker of the sign requests of the peers. The requests of the
+// peers are refused until it is set.
+func (km *Keymanager) SetPeerRequestChecker(checker PeerRequestChecker) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	km.peerRequestChecker = checker
+}
+
+// FetchValidatingPublicKeys returns the public keys of the validators of which the keymanager holds
+// a share.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return km.publicKeys(), nil
+}
+
+// Sign signs a message with the share of the validator key and the partial signatures of the peers.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	km.lock.RLock()
+	s, ok := km.shares[bytesutil.ToBytes48(req.PublicKey)]
+	km.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no share of public key %#x", req.PublicKey)
+	}
+	indices, sigs, err := km.partialSignatures(ctx, s, req)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.RecoverSignature(sigs, indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not recover signature")
+	}
+	if !sig.Verify(s.publicKey, req.SigningRoot) {
+		return nil, errors.New("recovered signature is invalid")
+	}
+	return sig, nil
+}
+
+// ImportShares decrypts the shares with their passwords, encrypts them with the password of the
+// wallet and stores them, replacing the shares of the same validators.
+func (km *Keymanager) ImportShares(ctx context.Context, shares []*Share, passwords []string) error {
+	if len(passwords) != len(shares) {
+		return errors.New("number of passwords does not match number of shares")
+	}
+	decrypted := make([]*share, len(shares))
+	encrypted := make([]*Share, len(shares))
+	for i, s := range shares {
+		var err error
+		decrypted[i], err = decryptShare(s, passwords[i])
+		if err != nil {
+			return errors.Wrapf(err, "could not decrypt share of public key %s", s.PublicKey)
+		}
+		encrypted[i], err = encryptShare(s, decrypted[i].secretKey, km.wallet.Password())
+		if err != nil {
+			return errors.Wrapf(err, "could not encrypt share of public key %s", s.PublicKey)
+		}
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	stored, err := km.readShares(ctx)
+	if err != nil {
+		return err
+	}
+	byPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*Share, len(stored)+len(shares))
+	for _, s := range stored {
+		pubKey, err := publicKeyFromHex(s.PublicKey)
+		if err != nil {
+			return errors.Wrap(err, "invalid public key in shares file")
+		}
+		byPubKey[bytesutil.ToBytes48(pubKey.Marshal())] = s
+	}
+	for i, s := range encrypted {
+		byPubKey[decrypted[i].pubKey()] = s
+	}
+	all := make([]*Share, 0, len(byPubKey))
+	for _, s := range byPubKey {
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].PublicKey < all[j].PublicKey })
+	encoded, err := json.MarshalIndent(all, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal shares")
+	}
+	if err := km.wallet.WriteFileAtPath(ctx, SharesPath, SharesFileName, encoded); err != nil {
+		return errors.Wrap(err, "could not write shares file")
+	}
+	for _, s := range decrypted {
+		km.shares[s.pubKey()] = s
+	}
+	log.Info(keymanager.KeysReloaded)
+	km.accountsChangedFeed.Send(km.publicKeys())
+	return nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when new shares
+// are imported into the keymanager while the validator process is running.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+func (km *Keymanager) readShares(ctx context.Context) ([]*Share, error) {
+	encoded, err := km.wallet.ReadFileAtPath(ctx, SharesPath, SharesFileName)
+	if err != nil && strings.Contains(err.Error(), "no files found") {
+		// If there are no shares at all, just exit.
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "could not read shares file %s", SharesFileName)
+	}
+	var shares []*Share
+	if err := json.Unmarshal(encoded, &shares); err != nil {
+		return nil, errors.Wrapf(err, "could not decode shares file %s", SharesFileName)
+	}
+	return shares, nil
+}
+
+// publicKeys returns the sorted public keys of the shares, the lock must be held.
+func (km *Keymanager) publicKeys() [][fieldparams.BLSPubkeyLength]byte {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.shares))
+	for pubKey := range km.shares {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	return pubKeys
+}
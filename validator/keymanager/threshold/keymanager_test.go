@@ -0,0 +1,293 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/testing/util"
+	mock "github.com/prysmaticlabs/prysm/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const password = "secretPassw0rd$1999"
+
+func passwords(shares []*Share) []string {
+	p := make([]string, len(shares))
+	for i := range p {
+		p[i] = password
+	}
+	return p
+}
+
+func splitKey(t *testing.T, threshold, numShares uint64) (bls.SecretKey, []*Share) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(secretKey.Marshal(), password)
+	require.NoError(t, err)
+	shares, err := SplitKeystore(&keymanager.Keystore{Crypto: cryptoFields}, password, threshold, numShares)
+	require.NoError(t, err)
+	require.Equal(t, int(numShares), len(shares))
+	return secretKey, shares
+}
+
+// attestationRequest returns the sign request of attestation data with the given target epoch.
+func attestationRequest(t *testing.T, pubKey []byte, targetEpoch types.Epoch) *validatorpb.SignRequest {
+	data := util.HydrateAttestationData(&ethpb.AttestationData{
+		Target: &ethpb.Checkpoint{Epoch: targetEpoch},
+	})
+	domain := bytesutil.PadTo(params.BeaconConfig().DomainBeaconAttester[:], 32)
+	root, err := signing.ComputeSigningRoot(data, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	}
+}
+
+func newKeymanager(t *testing.T, opts *KeymanagerOpts, shares ...*Share) *Keymanager {
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Wallet: &mock.Wallet{Files: make(map[string]map[string][]byte), WalletPassword: password},
+		Opts:   opts,
+	})
+	require.NoError(t, err)
+	require.NoError(t, km.ImportShares(context.Background(), shares, passwords(shares)))
+	return km
+}
+
+// newPeer serves the partial signatures of the keymanager, which signs all the requests of its
+// peers.
+func newPeer(t *testing.T, km *Keymanager) string {
+	km.SetPeerRequestChecker(func(context.Context, *validatorpb.SignRequest) error { return nil })
+	srv := httptest.NewServer(http.HandlerFunc(km.handleSign))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	secretKey, shares := splitKey(t, 2, 3)
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+	req := attestationRequest(t, pubKey[:], 1)
+	root := req.SigningRoot
+	want := secretKey.Sign(root).Marshal()
+
+	peer2 := newPeer(t, newKeymanager(t, &KeymanagerOpts{AuthToken: "token"}, shares[1]))
+	peer3 := newPeer(t, newKeymanager(t, &KeymanagerOpts{AuthToken: "token"}, shares[2]))
+
+	t.Run("with peers", func(t *testing.T) {
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{peer2, peer3}, AuthToken: "token"}, shares[0])
+		pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+		require.NoError(t, err)
+		assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, pubKeys)
+		sig, err := km.Sign(context.Background(), req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal())
+	})
+	t.Run("unreachable peer", func(t *testing.T) {
+		closed := httptest.NewServer(http.NotFoundHandler())
+		closed.Close()
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{closed.URL, peer3}, AuthToken: "token"}, shares[0])
+		sig, err := km.Sign(context.Background(), req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal())
+
+		km = newKeymanager(t, &KeymanagerOpts{Peers: []string{closed.URL}, AuthToken: "token"}, shares[0])
+		_, err = km.Sign(context.Background(), req)
+		require.ErrorContains(t, "got 1 valid partial signatures, 2 are needed", err)
+	})
+	t.Run("invalid partial signature", func(t *testing.T) {
+		otherKey, err := bls.RandKey()
+		require.NoError(t, err)
+		liar := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			require.NoError(t, json.NewEncoder(w).Encode(&signResponse{
+				Index:     2,
+				Signature: hexutil.Encode(otherKey.Sign(root).Marshal()),
+			}))
+		}))
+		defer liar.Close()
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{liar.URL}, AuthToken: "token"}, shares[0])
+		_, err = km.Sign(context.Background(), req)
+		require.ErrorContains(t, "got 1 valid partial signatures, 2 are needed", err)
+
+		km = newKeymanager(t, &KeymanagerOpts{Peers: []string{liar.URL, peer3}, AuthToken: "token"}, shares[0])
+		sig, err := km.Sign(context.Background(), req)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal())
+	})
+	t.Run("unknown public key", func(t *testing.T) {
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{peer2}, AuthToken: "token"}, shares[0])
+		_, err := km.Sign(context.Background(), &validatorpb.SignRequest{PublicKey: make([]byte, 48), SigningRoot: root})
+		require.ErrorContains(t, "no share of public key", err)
+	})
+}
+
+func TestKeymanager_PeerRequests(t *testing.T) {
+	secretKey, shares := splitKey(t, 2, 2)
+	req := attestationRequest(t, secretKey.PublicKey().Marshal(), 1)
+	peerKm := newKeymanager(t, &KeymanagerOpts{AuthToken: "token"}, shares[1])
+	peer := newPeer(t, peerKm)
+
+	post := func(t *testing.T, url, token string, req *validatorpb.SignRequest) (int, string) {
+		body, err := protojson.Marshal(req)
+		require.NoError(t, err)
+		httpReq, err := http.NewRequest(http.MethodPost, url+signPath, bytes.NewReader(body))
+		require.NoError(t, err)
+		if token != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(httpReq)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, resp.Body.Close())
+		}()
+		msg, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(msg)
+	}
+
+	t.Run("authenticated", func(t *testing.T) {
+		code, _ := post(t, peer, "token", req)
+		assert.Equal(t, http.StatusOK, code)
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{peer}, AuthToken: "token"}, shares[0])
+		sig, err := km.Sign(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, true, sig.Verify(secretKey.PublicKey(), req.SigningRoot))
+	})
+	t.Run("unauthenticated", func(t *testing.T) {
+		code, _ := post(t, peer, "", req)
+		assert.Equal(t, http.StatusUnauthorized, code)
+		code, _ = post(t, peer, "wrong", req)
+		assert.Equal(t, http.StatusUnauthorized, code)
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{peer}, AuthToken: "wrong"}, shares[0])
+		_, err := km.Sign(context.Background(), req)
+		require.ErrorContains(t, "got 1 valid partial signatures, 2 are needed", err)
+
+		// A peer without an auth token serves nobody.
+		noToken := newPeer(t, newKeymanager(t, &KeymanagerOpts{}, shares[1]))
+		code, _ = post(t, noToken, "", req)
+		assert.Equal(t, http.StatusUnauthorized, code)
+	})
+	t.Run("signing root of another object", func(t *testing.T) {
+		forged := attestationRequest(t, req.PublicKey, 1)
+		forged.SigningRoot = bytesutil.PadTo([]byte("root"), 32)
+		code, msg := post(t, peer, "token", forged)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, true, strings.Contains(msg, "signing root does not match the object of the request"), msg)
+
+		forged = attestationRequest(t, req.PublicKey, 1)
+		forged.SignatureDomain = bytesutil.PadTo(params.BeaconConfig().DomainBeaconProposer[:], 32)
+		code, msg = post(t, peer, "token", forged)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, true, strings.Contains(msg, "signature domain does not match the object of the request"), msg)
+	})
+	t.Run("slashable request", func(t *testing.T) {
+		// The checker of the validator client refuses to sign a second attestation for a target.
+		signed := make(map[types.Epoch][]byte)
+		peerKm.SetPeerRequestChecker(func(_ context.Context, req *validatorpb.SignRequest) error {
+			target := req.GetAttestationData().Target.Epoch
+			if root, ok := signed[target]; ok && !bytes.Equal(root, req.SigningRoot) {
+				return errors.New("attempted to make slashable attestation")
+			}
+			signed[target] = req.SigningRoot
+			return nil
+		})
+		km := newKeymanager(t, &KeymanagerOpts{Peers: []string{peer}, AuthToken: "token"}, shares[0])
+		_, err := km.Sign(context.Background(), attestationRequest(t, req.PublicKey, 2))
+		require.NoError(t, err)
+
+		slashable := attestationRequest(t, req.PublicKey, 2)
+		slashable.GetAttestationData().BeaconBlockRoot = bytesutil.PadTo([]byte("other"), 32)
+		root, err := signing.ComputeSigningRoot(slashable.GetAttestationData(), slashable.SignatureDomain)
+		require.NoError(t, err)
+		slashable.SigningRoot = root[:]
+		code, msg := post(t, peer, "token", slashable)
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.Equal(t, true, strings.Contains(msg, "attempted to make slashable attestation"), msg)
+		_, err = km.Sign(context.Background(), slashable)
+		require.ErrorContains(t, "got 1 valid partial signatures, 2 are needed", err)
+	})
+	t.Run("no checker", func(t *testing.T) {
+		km := newKeymanager(t, &KeymanagerOpts{AuthToken: "token"}, shares[1])
+		srv := httptest.NewServer(http.HandlerFunc(km.handleSign))
+		defer srv.Close()
+		code, _ := post(t, srv.URL, "token", req)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+	})
+}
+
+func TestNewKeymanager_ServePeersRequiresAuthToken(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{
+		Wallet:     &mock.Wallet{Files: make(map[string]map[string][]byte), WalletPassword: password},
+		Opts:       &KeymanagerOpts{ListenAddress: "127.0.0.1:0"},
+		ServePeers: true,
+	})
+	require.ErrorContains(t, "an auth token shared by the peers is required to serve partial signatures", err)
+}
+
+func TestKeymanager_ImportShares(t *testing.T) {
+	ctx := context.Background()
+	key1, shares1 := splitKey(t, 2, 3)
+	key2, shares2 := splitKey(t, 2, 3)
+	// The shares are encrypted with the password of the wallet on import.
+	wallet := &mock.Wallet{Files: make(map[string]map[string][]byte), WalletPassword: "walletPassw0rd$"}
+	km, err := NewKeymanager(ctx, &SetupConfig{Wallet: wallet, Opts: &KeymanagerOpts{}})
+	require.NoError(t, err)
+	pubKeysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 2)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	require.ErrorContains(t, "wrong password for wallet entered", km.ImportShares(ctx, shares1[:1], []string{"wrong"}))
+	require.ErrorContains(t, "number of passwords does not match", km.ImportShares(ctx, shares1[:1], nil))
+	require.NoError(t, km.ImportShares(ctx, shares1[:1], passwords(shares1[:1])))
+	require.NoError(t, km.ImportShares(ctx, shares2[:1], passwords(shares2[:1])))
+	assert.Equal(t, 1, len(<-pubKeysChan))
+	assert.Equal(t, 2, len(<-pubKeysChan))
+
+	// The shares are read back from the wallet.
+	km, err = NewKeymanager(ctx, &SetupConfig{Wallet: wallet, Opts: &KeymanagerOpts{}})
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+	for _, k := range []bls.SecretKey{key1, key2} {
+		_, ok := km.shares[bytesutil.ToBytes48(k.PublicKey().Marshal())]
+		assert.Equal(t, true, ok)
+	}
+
+	wallet.WalletPassword = password
+	_, err = NewKeymanager(ctx, &SetupConfig{Wallet: wallet, Opts: &KeymanagerOpts{}})
+	require.ErrorContains(t, "wrong password for wallet entered", err)
+}
+
+func TestSplitKeystore_WrongPassword(t *testing.T) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	cryptoFields, err := keystorev4.New().Encrypt(secretKey.Marshal(), password)
+	require.NoError(t, err)
+	_, err = SplitKeystore(&keymanager.Keystore{Crypto: cryptoFields}, "wrong", 2, 3)
+	require.ErrorContains(t, "incorrect password for keystore", err)
+	_, err = SplitKeystore(&keymanager.Keystore{Crypto: cryptoFields}, password, 4, 3)
+	require.ErrorContains(t, "threshold must be between 1 and the number of shares 3, got 4", err)
+}
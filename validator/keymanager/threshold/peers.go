@@ -0,0 +1,274 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// signPath is the path of the endpoint serving partial signatures to the peers.
+const signPath = "/threshold/v1/sign"
+
+// PeerRequestChecker checks a sign request of a peer before the partial signature of the share is
+// returned, such as against the slashing protection and the signing policy of the validator client.
+type PeerRequestChecker func(ctx context.Context, req *validatorpb.SignRequest) error
+
+// maxSignRequestSize is the maximum size of the sign request of a peer, large enough for blocks.
+const maxSignRequestSize = 10 << 20
+
+type signResponse struct {
+	Index     uint64 `json:"index"`
+	Signature string `json:"signature"`
+}
+
+type partialSignature struct {
+	peer  string
+	index uint64
+	sig   bls.Signature
+	err   error
+}
+
+// partialSignatures signs the root of the request with the share, and gathers the partial
+// signatures of the peers until the threshold of the share is reached. Partial signatures which do
+// not verify against the public key of their share are ignored.
+func (km *Keymanager) partialSignatures(
+	ctx context.Context, s *share, req *validatorpb.SignRequest,
+) ([]uint64, []bls.Signature, error) {
+	root := req.SigningRoot
+	indices := []uint64{s.index}
+	sigs := []bls.Signature{s.secretKey.Sign(root)}
+	if s.threshold <= 1 {
+		return indices, sigs, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, peerTimeout)
+	defer cancel()
+	// The channel is buffered so that the requests still running once the threshold is reached
+	// do not block.
+	results := make(chan *partialSignature, len(km.opts.Peers))
+	for _, peer := range km.opts.Peers {
+		go func(peer string) {
+			index, sig, err := km.requestPartialSignature(ctx, peer, req)
+			results <- &partialSignature{peer: peer, index: index, sig: sig, err: err}
+		}(peer)
+	}
+	seen := map[uint64]bool{s.index: true}
+	for range km.opts.Peers {
+		if uint64(len(sigs)) >= s.threshold {
+			break
+		}
+		res := <-results
+		if res.err != nil {
+			log.WithError(res.err).WithField("peer", res.peer).Warn("Could not get partial signature from peer")
+			continue
+		}
+		pubKey, ok := s.sharePublicKeys[res.index]
+		if !ok || seen[res.index] || !res.sig.Verify(pubKey, root) {
+			log.WithFields(logrus.Fields{
+				"peer":  res.peer,
+				"index": res.index,
+			}).Warn("Ignoring invalid partial signature from peer")
+			continue
+		}
+		seen[res.index] = true
+		indices = append(indices, res.index)
+		sigs = append(sigs, res.sig)
+	}
+	if uint64(len(sigs)) < s.threshold {
+		return nil, nil, fmt.Errorf("got %d valid partial signatures, %d are needed", len(sigs), s.threshold)
+	}
+	return indices, sigs, nil
+}
+
+// requestPartialSignature requests the partial signature of the request from the share of the peer.
+func (km *Keymanager) requestPartialSignature(
+	ctx context.Context, peer string, signReq *validatorpb.SignRequest,
+) (uint64, bls.Signature, error) {
+	body, err := protojson.Marshal(signReq)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not marshal request")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, peer+signPath, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+km.opts.AuthToken)
+	resp, err := km.client.Do(req)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not send request")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		msg, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return 0, nil, fmt.Errorf("peer returned status %d", resp.StatusCode)
+		}
+		return 0, nil, fmt.Errorf("peer returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	signResp := &signResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(signResp); err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode response")
+	}
+	sigBytes, err := hexutil.Decode(signResp.Signature)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode signature")
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid signature")
+	}
+	return signResp.Index, sig, nil
+}
+
+// servePeers serves the partial signatures of the shares until the context is canceled.
+func (km *Keymanager) servePeers(ctx context.Context, listener net.Listener) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(signPath, km.handleSign)
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: peerTimeout}
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			log.WithError(err).Error("Could not close peer server")
+		}
+	}()
+	log.WithField("address", listener.Addr().String()).Info("Serving partial signatures to peers")
+	if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+		log.WithError(err).Error("Could not serve partial signatures to peers")
+	}
+}
+
+// handleSign signs the signing root of the request with the share of the requested validator, once
+// the request is authenticated, its signing root matches its object, and the checker of the
+// validator client allows it.
+func (km *Keymanager) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// Requests are refused when no auth token is configured, so that the shares never sign for
+	// unauthenticated callers.
+	expected := []byte("Bearer " + km.opts.AuthToken)
+	if km.opts.AuthToken == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxSignRequestSize))
+	if err != nil {
+		http.Error(w, "could not read request", http.StatusBadRequest)
+		return
+	}
+	req := &validatorpb.SignRequest{}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		http.Error(w, "could not decode request", http.StatusBadRequest)
+		return
+	}
+	if len(req.PublicKey) != fieldparams.BLSPubkeyLength {
+		http.Error(w, "invalid public key", http.StatusBadRequest)
+		return
+	}
+	root, err := requestSigningRoot(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !bytes.Equal(root[:], req.SigningRoot) {
+		http.Error(w, "signing root does not match the object of the request", http.StatusBadRequest)
+		return
+	}
+	km.lock.RLock()
+	s, ok := km.shares[bytesutil.ToBytes48(req.PublicKey)]
+	checker := km.peerRequestChecker
+	km.lock.RUnlock()
+	if !ok {
+		http.Error(w, "no share of public key", http.StatusNotFound)
+		return
+	}
+	if checker == nil {
+		http.Error(w, "validator client is not ready to check requests", http.StatusServiceUnavailable)
+		return
+	}
+	if err := checker(r.Context(), req); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(req.PublicKey)),
+			"peer":      r.RemoteAddr,
+		}).Warn("Refused to sign request of peer")
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&signResponse{
+		Index:     s.index,
+		Signature: hexutil.Encode(s.secretKey.Sign(root[:]).Marshal()),
+	}); err != nil {
+		log.WithError(err).Error("Could not write partial signature")
+	}
+}
+
+// requestSigningRoot computes the signing root of the object of the request with its signature
+// domain, which must be of the domain type of the object.
+func requestSigningRoot(req *validatorpb.SignRequest) ([32]byte, error) {
+	cfg := params.BeaconConfig()
+	var obj fssz.HashRoot
+	var domainType [4]byte
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		obj, domainType = o.Block, cfg.DomainBeaconProposer
+	case *validatorpb.SignRequest_BlockV2:
+		obj, domainType = o.BlockV2, cfg.DomainBeaconProposer
+	case *validatorpb.SignRequest_BlockV3:
+		obj, domainType = o.BlockV3, cfg.DomainBeaconProposer
+	case *validatorpb.SignRequest_AttestationData:
+		obj, domainType = o.AttestationData, cfg.DomainBeaconAttester
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		obj, domainType = o.AggregateAttestationAndProof, cfg.DomainAggregateAndProof
+	case *validatorpb.SignRequest_Exit:
+		obj, domainType = o.Exit, cfg.DomainVoluntaryExit
+	case *validatorpb.SignRequest_Slot:
+		slot := types.SSZUint64(o.Slot)
+		obj, domainType = &slot, cfg.DomainSelectionProof
+	case *validatorpb.SignRequest_Epoch:
+		epoch := types.SSZUint64(o.Epoch)
+		obj, domainType = &epoch, cfg.DomainRandao
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		obj, domainType = o.SyncAggregatorSelectionData, cfg.DomainSyncCommitteeSelectionProof
+	case *validatorpb.SignRequest_ContributionAndProof:
+		obj, domainType = o.ContributionAndProof, cfg.DomainContributionAndProof
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		root := types.SSZBytes(o.SyncMessageBlockRoot)
+		obj, domainType = &root, cfg.DomainSyncCommittee
+	default:
+		return [32]byte{}, errors.New("unsupported object type")
+	}
+	if len(req.SignatureDomain) != fieldparams.RootLength || !bytes.Equal(req.SignatureDomain[:4], domainType[:]) {
+		return [32]byte{}, errors.New("signature domain does not match the object of the request")
+	}
+	root, err := signing.ComputeSigningRoot(obj, req.SignatureDomain)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute signing root")
+	}
+	return root, nil
+}
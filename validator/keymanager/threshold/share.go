@@ -0,0 +1,153 @@
+package threshold
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// Share of a validator key held by one of the signers.
+type Share struct {
+	Index           uint64               `json:"index"`
+	Threshold       uint64               `json:"threshold"`
+	PublicKey       string               `json:"public_key"`
+	SharePublicKeys map[uint64]string    `json:"share_public_keys"`
+	Keystore        *keymanager.Keystore `json:"keystore"`
+}
+
+// share is a decrypted share of a validator key.
+type share struct {
+	index           uint64
+	threshold       uint64
+	publicKey       bls.PublicKey
+	secretKey       bls.SecretKey
+	sharePublicKeys map[uint64]bls.PublicKey
+}
+
+// SplitKeystore decrypts an EIP-2335 keystore and splits its secret key into numShares shares, of
+// which threshold are needed to sign. The shares are encrypted with the password of the keystore,
+// the share of index i being at position i-1.
+func SplitKeystore(keystore *keymanager.Keystore, password string, threshold, numShares uint64) ([]*Share, error) {
+	encryptor := keystorev4.New()
+	secretKeyBytes, err := encryptor.Decrypt(keystore.Crypto, password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return nil, errors.New("incorrect password for keystore")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	secretKey, err := bls.SecretKeyFromBytes(secretKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize secret key from bytes")
+	}
+	secretShares, err := bls.SplitSecretKey(secretKey, threshold, numShares)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not split secret key")
+	}
+	sharePublicKeys := make(map[uint64]string, len(secretShares))
+	for i, s := range secretShares {
+		sharePublicKeys[uint64(i+1)] = hexutil.Encode(s.PublicKey().Marshal())
+	}
+	shares := make([]*Share, len(secretShares))
+	for i, s := range secretShares {
+		shares[i], err = encryptShare(&Share{
+			Index:           uint64(i + 1),
+			Threshold:       threshold,
+			PublicKey:       hexutil.Encode(secretKey.PublicKey().Marshal()),
+			SharePublicKeys: sharePublicKeys,
+		}, s, password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encrypt share %d", i+1)
+		}
+	}
+	return shares, nil
+}
+
+// encryptShare returns a copy of the share holding the secret key encrypted with the password.
+func encryptShare(s *Share, secretKey bls.SecretKey, password string) (*Share, error) {
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(secretKey.Marshal(), password)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return &Share{
+		Index:           s.Index,
+		Threshold:       s.Threshold,
+		PublicKey:       s.PublicKey,
+		SharePublicKeys: s.SharePublicKeys,
+		Keystore: &keymanager.Keystore{
+			Crypto:  cryptoFields,
+			ID:      id.String(),
+			Pubkey:  fmt.Sprintf("%x", secretKey.PublicKey().Marshal()),
+			Version: encryptor.Version(),
+			Name:    encryptor.Name(),
+		},
+	}, nil
+}
+
+// decryptShare decrypts the secret key of the share with the password, and checks that it matches
+// the public key of the share.
+func decryptShare(s *Share, password string) (*share, error) {
+	if s.Keystore == nil {
+		return nil, errors.New("missing keystore")
+	}
+	if s.Index == 0 || s.Threshold == 0 || s.Threshold > uint64(len(s.SharePublicKeys)) {
+		return nil, fmt.Errorf("invalid index %d or threshold %d", s.Index, s.Threshold)
+	}
+	publicKey, err := publicKeyFromHex(s.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	sharePublicKeys := make(map[uint64]bls.PublicKey, len(s.SharePublicKeys))
+	for idx, k := range s.SharePublicKeys {
+		sharePublicKeys[idx], err = publicKeyFromHex(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key of share %d", idx)
+		}
+	}
+	secretKeyBytes, err := keystorev4.New().Decrypt(s.Keystore.Crypto, password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return nil, errors.Wrap(err, "wrong password for wallet entered")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	secretKey, err := bls.SecretKeyFromBytes(secretKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize secret key from bytes")
+	}
+	ownPublicKey, ok := sharePublicKeys[s.Index]
+	if !ok || !bytes.Equal(ownPublicKey.Marshal(), secretKey.PublicKey().Marshal()) {
+		return nil, fmt.Errorf("secret key does not match the public key of share %d", s.Index)
+	}
+	return &share{
+		index:           s.Index,
+		threshold:       s.Threshold,
+		publicKey:       publicKey,
+		secretKey:       secretKey,
+		sharePublicKeys: sharePublicKeys,
+	}, nil
+}
+
+func publicKeyFromHex(k string) (bls.PublicKey, error) {
+	b, err := hexutil.Decode(k)
+	if err != nil {
+		return nil, err
+	}
+	return bls.PublicKeyFromBytes(b)
+}
+
+func (s *share) pubKey() [fieldparams.BLSPubkeyLength]byte {
+	return bytesutil.ToBytes48(s.publicKey.Marshal())
+}
@@ -46,6 +46,16 @@ type Deleter interface {
 	DeleteKeystores(ctx context.Context, publicKeys [][]byte) ([]*ethpbservice.DeletedKeystoreStatus, error)
 }
 
+// RemoteKeysManager can list, import and delete the public keys of a remote signer
+// which the keymanager signs with.
+type RemoteKeysManager interface {
+	ListRemoteKeys(ctx context.Context) ([]*ethpbservice.ListRemoteKeysResponse_Keystore, error)
+	ImportRemoteKeys(
+		ctx context.Context, remoteKeys []*ethpbservice.ImportRemoteKeysRequest_RemoteKey,
+	) ([]*ethpbservice.ImportedRemoteKeysStatus, error)
+	DeleteRemoteKeys(ctx context.Context, publicKeys [][]byte) ([]*ethpbservice.DeletedRemoteKeysStatus, error)
+}
+
 // KeyChangeSubscriber allows subscribing to changes made to the underlying keys.
 type KeyChangeSubscriber interface {
 	SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription
@@ -60,7 +70,7 @@ type Keystore struct {
 	Name    string                 `json:"name"`
 }
 
-// Kind defines an enum for either imported, derived, or remote-signing
+// Kind defines an enum for either imported, derived, remote-signing or threshold
 // keystores for Prysm wallets.
 type Kind int
 
@@ -71,6 +81,8 @@ const (
 	Derived
 	// Remote keymanager capable of remote-signing data.
 	Remote
+	// Threshold keymanager signing with shares of the keys, together with peer signers.
+	Threshold
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -86,6 +98,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Remote:
 		return "remote"
+	case Threshold:
+		return "threshold"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -100,6 +114,8 @@ func ParseKind(k string) (Kind, error) {
 		return Imported, nil
 	case "remote":
 		return Remote, nil
+	case "threshold":
+		return Threshold, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -5,12 +5,16 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/threshold"
 )
 
 var (
 	_ = keymanager.IKeymanager(&imported.Keymanager{})
 	_ = keymanager.IKeymanager(&derived.Keymanager{})
 	_ = keymanager.IKeymanager(&remote.Keymanager{})
+	_ = keymanager.IKeymanager(&remoteweb3signer.Keymanager{})
+	_ = keymanager.IKeymanager(&threshold.Keymanager{})
 
 	// More granular assertions.
 	_ = keymanager.KeysFetcher(&imported.Keymanager{})
@@ -19,4 +23,5 @@ var (
 	_ = keymanager.Importer(&derived.Keymanager{})
 	_ = keymanager.Deleter(&imported.Keymanager{})
 	_ = keymanager.Deleter(&derived.Keymanager{})
+	_ = keymanager.RemoteKeysManager(&remoteweb3signer.Keymanager{})
 )
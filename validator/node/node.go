@@ -14,15 +14,20 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/api/gateway"
 	"github.com/prysmaticlabs/prysm/api/gateway/apimiddleware"
 	"github.com/prysmaticlabs/prysm/async/event"
 	"github.com/prysmaticlabs/prysm/cmd"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/io/file"
 	"github.com/prysmaticlabs/prysm/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/monitoring/prometheus"
@@ -41,8 +46,11 @@ import (
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	proposersettings "github.com/prysmaticlabs/prysm/validator/proposer-settings"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	validatorMiddleware "github.com/prysmaticlabs/prysm/validator/rpc/apimiddleware"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	"github.com/prysmaticlabs/prysm/validator/web"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -184,6 +192,15 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "could not generate interop keys")
 		}
+	} else if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		cfg, err := web3SignerConfig(cliCtx)
+		if err != nil {
+			return err
+		}
+		keyManager, err = remoteweb3signer.NewKeymanager(cliCtx.Context, cfg)
+		if err != nil {
+			return errors.Wrap(err, "could not initialize web3signer keymanager")
+		}
 	} else {
 		// Read the wallet from the specified path.
 		w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
@@ -387,17 +404,33 @@ func (c *ValidatorClient) registerValidatorService(
 	grpcRetries := c.cliCtx.Uint(flags.GrpcRetriesFlag.Name)
 	grpcRetryDelay := c.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
 	gStruct := &g.Graffiti{}
+	var gSource *g.Source
 	var err error
 	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
 		n := c.cliCtx.String(flags.GraffitiFileFlag.Name)
-		gStruct, err = g.ParseGraffitiFile(n)
+		gSource, err = g.NewSource(n)
 		if err != nil {
 			log.WithError(err).Warn("Could not parse graffiti file")
+		} else {
+			gStruct = gSource.Graffiti()
+		}
+	}
+	proposerSettings, err := proposerSettings(c.cliCtx)
+	if err != nil {
+		return err
+	}
+	var signingPolicy *signingpolicy.Settings
+	if c.cliCtx.IsSet(flags.SigningPolicyFileFlag.Name) {
+		signingPolicy, err = signingpolicy.ParseFile(c.cliCtx.String(flags.SigningPolicyFileFlag.Name))
+		if err != nil {
+			return err
 		}
 	}
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		DoppelgangerEpochs:         types.Epoch(c.cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name)),
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
@@ -412,7 +445,12 @@ func (c *ValidatorClient) registerValidatorService(
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
+		GraffitiSource:             gSource,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		ProposerSettings:           proposerSettings,
+		SigningPolicy:              signingPolicy,
+		SigningAuditLog:            c.cliCtx.Bool(flags.EnableSigningAuditLogFlag.Name),
+		SigningAuditLogRetention:   c.cliCtx.Duration(flags.SigningAuditLogRetentionFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -421,6 +459,61 @@ func (c *ValidatorClient) registerValidatorService(
 	return c.services.RegisterService(v)
 }
 
+// proposerSettings reads the proposer settings file, if any, with the suggested fee recipient as the
+// default fee recipient of the validators.
+func proposerSettings(cliCtx *cli.Context) (*proposersettings.Settings, error) {
+	var settings *proposersettings.Settings
+	if cliCtx.IsSet(flags.ProposerSettingsFileFlag.Name) {
+		var err error
+		settings, err = proposersettings.ParseFile(cliCtx.String(flags.ProposerSettingsFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cliCtx.IsSet(flags.SuggestedFeeRecipientFlag.Name) {
+		suggestedFeeRecipient := cliCtx.String(flags.SuggestedFeeRecipientFlag.Name)
+		if !common.IsHexAddress(suggestedFeeRecipient) {
+			return nil, errors.Errorf("--%s %q is not a hex encoded address", flags.SuggestedFeeRecipientFlag.Name, suggestedFeeRecipient)
+		}
+		if settings == nil {
+			settings = &proposersettings.Settings{}
+		}
+		if settings.DefaultConfig == nil {
+			settings.DefaultConfig = &proposersettings.Option{FeeRecipient: common.HexToAddress(suggestedFeeRecipient)}
+		}
+	}
+	return settings, nil
+}
+
+// web3SignerConfig builds the config of the web3signer keymanager from the flags. The keys imported
+// through the remote keys API are persisted in the wallet directory unless another file is set.
+func web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
+	cfg := &remoteweb3signer.SetupConfig{
+		BaseEndpoint:           cliCtx.String(flags.Web3SignerURLFlag.Name),
+		PublicKeysPollInterval: cliCtx.Duration(flags.Web3SignerPublicKeysPollIntervalFlag.Name),
+		RemoteKeysFile:         cliCtx.String(flags.Web3SignerRemoteKeysFileFlag.Name),
+	}
+	if cfg.RemoteKeysFile == "" {
+		cfg.RemoteKeysFile = filepath.Join(cliCtx.String(flags.WalletDirFlag.Name), remoteweb3signer.DefaultRemoteKeysFileName)
+	}
+	publicKeys := cliCtx.StringSlice(flags.Web3SignerPublicValidatorKeysFlag.Name)
+	if len(publicKeys) == 1 && (strings.HasPrefix(publicKeys[0], "http://") || strings.HasPrefix(publicKeys[0], "https://")) {
+		cfg.PublicKeysURL = publicKeys[0]
+		return cfg, nil
+	}
+	for _, key := range publicKeys {
+		pubKey, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("public key %s has length %d, expected %d", key, len(pubKey), fieldparams.BLSPubkeyLength)
+		}
+		cfg.ProvidedPublicKeys = append(cfg.ProvidedPublicKeys, bytesutil.ToBytes48(pubKey))
+	}
+	return cfg, nil
+}
+
 func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.IKeymanager) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
@@ -462,6 +555,8 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 		ClientGrpcRetryDelay:     grpcRetryDelay,
 		ClientGrpcHeaders:        strings.Split(grpcHeaders, ","),
 		ClientWithCert:           clientCert,
+		ProposerSettings:         vs.ProposerSettings(),
+		GraffitiSource:           vs.GraffitiSource(),
 	})
 	return c.services.RegisterService(server)
 }
@@ -490,6 +585,8 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		validatorpb.RegisterAccountsHandler,
 		validatorpb.RegisterBeaconHandler,
 		validatorpb.RegisterSlashingProtectionHandler,
+		validatorpb.RegisterGraffitiHandler,
+		validatorpb.RegisterSigningAuditLogHandler,
 		ethpbservice.RegisterKeyManagementHandler,
 	}
 	gwmux := gwruntime.NewServeMux(
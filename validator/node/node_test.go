@@ -7,12 +7,18 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	"github.com/prysmaticlabs/prysm/testing/require"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
 )
@@ -60,3 +66,37 @@ func TestClearDB(t *testing.T) {
 	require.NoError(t, clearDB(context.Background(), tmp, true))
 	require.LogsContain(t, hook, "Removing database")
 }
+
+func TestWeb3SignerConfig_RemoteKeysPersistAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	walletDir := filepath.Join(t.TempDir(), "wallet")
+	provided := bytesutil.ToBytes48(bytesutil.PadTo([]byte{1}, fieldparams.BLSPubkeyLength))
+	imported := bytesutil.ToBytes48(bytesutil.PadTo([]byte{2}, fieldparams.BLSPubkeyLength))
+
+	newKeymanager := func() *remoteweb3signer.Keymanager {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.WalletDirFlag.Name, walletDir, "")
+		set.String(flags.Web3SignerURLFlag.Name, "http://example.com", "")
+		set.Var(cli.NewStringSlice(hexutil.Encode(provided[:])), flags.Web3SignerPublicValidatorKeysFlag.Name, "")
+		set.Duration(flags.Web3SignerPublicKeysPollIntervalFlag.Name, time.Second, "")
+		set.String(flags.Web3SignerRemoteKeysFileFlag.Name, "", "")
+		cfg, err := web3SignerConfig(cli.NewContext(&app, set, nil))
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(walletDir, remoteweb3signer.DefaultRemoteKeysFileName), cfg.RemoteKeysFile)
+		require.Equal(t, time.Second, cfg.PublicKeysPollInterval)
+		km, err := remoteweb3signer.NewKeymanager(ctx, cfg)
+		require.NoError(t, err)
+		return km
+	}
+
+	km := newKeymanager()
+	_, err := km.ImportRemoteKeys(ctx, []*ethpbservice.ImportRemoteKeysRequest_RemoteKey{{Pubkey: imported[:]}})
+	require.NoError(t, err)
+
+	// The key imported before the restart is loaded again from the wallet directory.
+	km = newKeymanager()
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{provided, imported}, keys)
+}
@@ -0,0 +1,108 @@
+// Package proposer_settings defines the settings the validator client proposes blocks with, such as
+// the fee recipient of each validator, and how they are read from a file.
+package proposer_settings
+
+import (
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"gopkg.in/yaml.v2"
+)
+
+// Option holds the settings a validator proposes blocks with.
+type Option struct {
+	FeeRecipient common.Address
+}
+
+// Settings holds the settings of each validator, keyed by public key, along with the default
+// settings of validators which have none of their own.
+type Settings struct {
+	ProposeConfig map[[fieldparams.BLSPubkeyLength]byte]*Option
+	DefaultConfig *Option
+}
+
+// fileOption and filePayload are the representation of the settings in a file.
+type fileOption struct {
+	FeeRecipient string `yaml:"fee_recipient"`
+}
+
+type filePayload struct {
+	ProposerConfig map[string]*fileOption `yaml:"proposer_config"`
+	DefaultConfig  *fileOption            `yaml:"default_config"`
+}
+
+// ParseFile reads the proposer settings from a JSON or YAML file of the form
+//
+//	{
+//	  "proposer_config": {
+//	    "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a": {
+//	      "fee_recipient": "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+//	    }
+//	  },
+//	  "default_config": {
+//	    "fee_recipient": "0x6e35733c5af9B61374A128e6F85f553aF09ff89A"
+//	  }
+//	}
+func ParseFile(path string) (*Settings, error) {
+	enc, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read proposer settings file")
+	}
+	payload := &filePayload{}
+	if err := yaml.UnmarshalStrict(enc, payload); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer settings file")
+	}
+	settings := &Settings{
+		ProposeConfig: make(map[[fieldparams.BLSPubkeyLength]byte]*Option, len(payload.ProposerConfig)),
+	}
+	for key, option := range payload.ProposerConfig {
+		pubKey, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("public key %s has length %d, expected %d", key, len(pubKey), fieldparams.BLSPubkeyLength)
+		}
+		o, err := parseOption(option)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid settings for public key %s", key)
+		}
+		settings.ProposeConfig[bytesutil.ToBytes48(pubKey)] = o
+	}
+	if payload.DefaultConfig != nil {
+		settings.DefaultConfig, err = parseOption(payload.DefaultConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid default settings")
+		}
+	}
+	return settings, nil
+}
+
+func parseOption(option *fileOption) (*Option, error) {
+	if option == nil {
+		return nil, errors.New("no settings")
+	}
+	if !common.IsHexAddress(option.FeeRecipient) {
+		return nil, errors.Errorf("fee recipient %q is not a hex encoded address", option.FeeRecipient)
+	}
+	return &Option{FeeRecipient: common.HexToAddress(option.FeeRecipient)}, nil
+}
+
+// FeeRecipient returns the fee recipient of a validator from its own settings or else from the
+// default settings, and whether there is one.
+func (s *Settings) FeeRecipient(pubKey [fieldparams.BLSPubkeyLength]byte) (common.Address, bool) {
+	if s == nil {
+		return common.Address{}, false
+	}
+	if option, ok := s.ProposeConfig[pubKey]; ok && option != nil {
+		return option.FeeRecipient, true
+	}
+	if s.DefaultConfig != nil {
+		return s.DefaultConfig.FeeRecipient, true
+	}
+	return common.Address{}, false
+}
@@ -0,0 +1,115 @@
+package proposer_settings
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+const (
+	pubKeyHex           = "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+	feeRecipientHex     = "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+	defaultRecipientHex = "0x6e35733c5af9B61374A128e6F85f553aF09ff89A"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestParseFile(t *testing.T) {
+	pubKey := bytesutil.ToBytes48(hexutil.MustDecode(pubKeyHex))
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "json",
+			file: "settings.json",
+			content: `{
+  "proposer_config": {
+    "` + pubKeyHex + `": {"fee_recipient": "` + feeRecipientHex + `"}
+  },
+  "default_config": {"fee_recipient": "` + defaultRecipientHex + `"}
+}`,
+		},
+		{
+			name: "yaml",
+			file: "settings.yaml",
+			content: `proposer_config:
+  "` + pubKeyHex + `":
+    fee_recipient: "` + feeRecipientHex + `"
+default_config:
+  fee_recipient: "` + defaultRecipientHex + `"
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := ParseFile(writeFile(t, tt.file, tt.content))
+			require.NoError(t, err)
+			feeRecipient, ok := settings.FeeRecipient(pubKey)
+			require.Equal(t, true, ok)
+			assert.Equal(t, common.HexToAddress(feeRecipientHex), feeRecipient)
+			feeRecipient, ok = settings.FeeRecipient([48]byte{1})
+			require.Equal(t, true, ok)
+			assert.Equal(t, common.HexToAddress(defaultRecipientHex), feeRecipient)
+		})
+	}
+}
+
+func TestParseFile_NoDefault(t *testing.T) {
+	settings, err := ParseFile(writeFile(t, "settings.json", `{"proposer_config": {"`+pubKeyHex+`": {"fee_recipient": "`+feeRecipientHex+`"}}}`))
+	require.NoError(t, err)
+	_, ok := settings.FeeRecipient([48]byte{1})
+	assert.Equal(t, false, ok)
+}
+
+func TestParseFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "invalid public key",
+			content: `{"proposer_config": {"0x1234": {"fee_recipient": "` + feeRecipientHex + `"}}}`,
+			wantErr: "public key 0x1234 has length 2",
+		},
+		{
+			name:    "invalid fee recipient",
+			content: `{"proposer_config": {"` + pubKeyHex + `": {"fee_recipient": "0x1234"}}}`,
+			wantErr: "is not a hex encoded address",
+		},
+		{
+			name:    "invalid default fee recipient",
+			content: `{"default_config": {"fee_recipient": "recipient"}}`,
+			wantErr: "invalid default settings",
+		},
+		{
+			name:    "unknown field",
+			content: `{"default_config": {"fee_recipient": "` + defaultRecipientHex + `", "gas_limit": 1}}`,
+			wantErr: "could not unmarshal proposer settings file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFile(writeFile(t, "settings.json", tt.content))
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSettings_FeeRecipient_Nil(t *testing.T) {
+	var settings *Settings
+	_, ok := settings.FeeRecipient([48]byte{})
+	assert.Equal(t, false, ok)
+}
@@ -200,6 +200,13 @@ func (s *Server) VoluntaryExit(
 		Keymanager:       s.keymanager,
 		RawPubKeys:       req.PublicKeys,
 		FormattedPubKeys: formattedKeys,
+		// The web UI asks the user to confirm the exits before requesting them, so they are
+		// confirmed for the signing policy.
+		Confirmed: true,
+	}
+	if s.validatorService != nil {
+		cfg.SigningPolicy = s.validatorService.SigningPolicy()
+		cfg.AuditLog = s.validatorService
 	}
 	rawExitedKeys, _, err := accounts.PerformVoluntaryExit(ctx, cfg)
 	if err != nil {
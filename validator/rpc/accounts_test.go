@@ -22,8 +22,11 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	signingpolicy "github.com/prysmaticlabs/prysm/validator/signing-policy"
 	constant "github.com/prysmaticlabs/prysm/validator/testing"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -288,12 +291,20 @@ func TestServer_VoluntaryExit(t *testing.T) {
 	require.NoError(t, err)
 	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
 	require.NoError(t, err)
+	// The signing policy refuses unconfirmed exits, but the web UI has the user confirm them.
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		SigningPolicy: &signingpolicy.Settings{DefaultConfig: signingpolicy.DefaultPolicy()},
+		ValDB:         dbtest.SetupDB(t, nil),
+	})
+	require.NoError(t, err)
+	vs.SigningPolicy().SetGenesisTime(uint64(genesisTime.Seconds))
 	s := &Server{
 		keymanager:                km,
 		walletInitialized:         true,
 		wallet:                    w,
 		beaconNodeClient:          mockNodeClient,
 		beaconNodeValidatorClient: mockValidatorClient,
+		validatorService:          vs,
 	}
 	numAccounts := 2
 	dr, ok := km.(*derived.Keymanager)
@@ -17,6 +17,8 @@ func (f *ValidatorEndpointFactory) IsNil() bool {
 func (*ValidatorEndpointFactory) Paths() []string {
 	return []string{
 		"/eth/v1/keystores",
+		"/eth/v1/remotekeys",
+		"/eth/v1/validator/{pubkey}/feerecipient",
 	}
 }
 
@@ -30,6 +32,15 @@ func (*ValidatorEndpointFactory) Create(path string) (*apimiddleware.Endpoint, e
 		endpoint.PostResponse = &importKeystoresResponseJson{}
 		endpoint.DeleteRequest = &deleteKeystoresRequestJson{}
 		endpoint.DeleteResponse = &deleteKeystoresResponseJson{}
+	case "/eth/v1/remotekeys":
+		endpoint.GetResponse = &listRemoteKeysResponseJson{}
+		endpoint.PostRequest = &importRemoteKeysRequestJson{}
+		endpoint.PostResponse = &importRemoteKeysResponseJson{}
+		endpoint.DeleteRequest = &deleteRemoteKeysRequestJson{}
+		endpoint.DeleteResponse = &deleteRemoteKeysResponseJson{}
+	case "/eth/v1/validator/{pubkey}/feerecipient":
+		endpoint.GetResponse = &getFeeRecipientByPubkeyResponseJson{}
+		endpoint.PostRequest = &setFeeRecipientByPubkeyRequestJson{}
 	default:
 		return nil, errors.New("invalid path")
 	}
@@ -32,3 +32,47 @@ type deleteKeystoresResponseJson struct {
 	Statuses           []*statusJson `json:"statuses"`
 	SlashingProtection string        `json:"slashing_protection"`
 }
+
+type listRemoteKeysResponseJson struct {
+	Data []*remoteKeyJson `json:"data"`
+}
+
+type remoteKeyJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Url      string `json:"url"`
+	Readonly bool   `json:"readonly"`
+}
+
+type importRemoteKeysRequestJson struct {
+	RemoteKeys []*importRemoteKeyJson `json:"remote_keys"`
+}
+
+type importRemoteKeyJson struct {
+	Pubkey string `json:"pubkey" hex:"true"`
+	Url    string `json:"url"`
+}
+
+type importRemoteKeysResponseJson struct {
+	Data []*statusJson `json:"data"`
+}
+
+type deleteRemoteKeysRequestJson struct {
+	Pubkeys []string `json:"pubkeys" hex:"true"`
+}
+
+type deleteRemoteKeysResponseJson struct {
+	Data []*statusJson `json:"data"`
+}
+
+type getFeeRecipientByPubkeyResponseJson struct {
+	Data *feeRecipientJson `json:"data"`
+}
+
+type feeRecipientJson struct {
+	Pubkey     string `json:"pubkey" hex:"true"`
+	Ethaddress string `json:"ethaddress" hex:"true"`
+}
+
+type setFeeRecipientByPubkeyRequestJson struct {
+	Ethaddress string `json:"ethaddress" hex:"true"`
+}
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSigningAuditLog returns the entries of the signing audit log matching the request, the most
+// recent first.
+func (s *Server) ListSigningAuditLog(ctx context.Context, req *pb.SigningAuditLogRequest) (*pb.SigningAuditLogResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database is not initialized")
+	}
+	entries, err := s.valDB.AuditLog(ctx, &kv.AuditLogFilter{
+		PublicKey: req.PublicKey,
+		StartSlot: types.Slot(req.StartSlot),
+		EndSlot:   types.Slot(req.EndSlot),
+		Limit:     int(req.Limit),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read signing audit log: %v", err)
+	}
+	resp := &pb.SigningAuditLogResponse{
+		Entries: make([]*pb.SigningAuditLogEntry, len(entries)),
+	}
+	for i, e := range entries {
+		resp.Entries[i] = &pb.SigningAuditLogEntry{
+			Timestamp:       e.Timestamp,
+			PublicKey:       e.PublicKey,
+			ObjectType:      e.ObjectType,
+			Slot:            uint64(e.Slot),
+			SignatureDomain: e.SignatureDomain,
+			SigningRoot:     e.SigningRoot,
+			Keymanager:      e.Keymanager,
+			Outcome:         e.Outcome,
+			Error:           e.Error,
+		}
+	}
+	return resp, nil
+}
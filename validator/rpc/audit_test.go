@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_ListSigningAuditLog(t *testing.T) {
+	ctx := context.Background()
+	_, err := (&Server{}).ListSigningAuditLog(ctx, &pb.SigningAuditLogRequest{})
+	require.ErrorContains(t, "Validator database is not initialized", err)
+
+	validatorDB := dbtest.SetupDB(t, nil)
+	for _, e := range []*kv.AuditLogEntry{
+		{Timestamp: 1, PublicKey: []byte{1}, ObjectType: "block", Slot: 10, SigningRoot: []byte("root"), Outcome: kv.AuditOutcomeSigned},
+		{Timestamp: 2, PublicKey: []byte{2}, ObjectType: "attestation", Slot: 11, Outcome: kv.AuditOutcomeSigned},
+		{Timestamp: 3, PublicKey: []byte{1}, ObjectType: "block", Slot: 10, Outcome: kv.AuditOutcomeBroadcast},
+	} {
+		require.NoError(t, validatorDB.AppendAuditLogEntry(ctx, e))
+	}
+	s := &Server{valDB: validatorDB}
+
+	resp, err := s.ListSigningAuditLog(ctx, &pb.SigningAuditLogRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Entries))
+	assert.Equal(t, int64(2), resp.Entries[0].Timestamp)
+	assert.Equal(t, int64(3), resp.Entries[1].Timestamp)
+
+	resp, err = s.ListSigningAuditLog(ctx, &pb.SigningAuditLogRequest{PublicKey: []byte{1}, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Entries))
+	assert.Equal(t, kv.AuditOutcomeBroadcast, resp.Entries[0].Outcome)
+
+	resp, err = s.ListSigningAuditLog(ctx, &pb.SigningAuditLogRequest{StartSlot: 10, EndSlot: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Entries))
+	assert.DeepEqual(t, &pb.SigningAuditLogEntry{
+		Timestamp:   1,
+		PublicKey:   []byte{1},
+		ObjectType:  "block",
+		Slot:        10,
+		SigningRoot: []byte("root"),
+		Outcome:     kv.AuditOutcomeSigned,
+	}, resp.Entries[1])
+}
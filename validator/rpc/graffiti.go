@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGraffiti returns the content of the graffiti file of the validator client.
+func (s *Server) GetGraffiti(_ context.Context, _ *empty.Empty) (*pb.GraffitiResponse, error) {
+	if s.graffitiSource == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No graffiti file was provided to the validator client")
+	}
+	return &pb.GraffitiResponse{
+		Path:    s.graffitiSource.Path(),
+		Content: string(s.graffitiSource.Content()),
+	}, nil
+}
+
+// SetGraffiti replaces the content of the graffiti file of the validator client. The new graffiti
+// is used from the next block proposal.
+func (s *Server) SetGraffiti(_ context.Context, req *pb.SetGraffitiRequest) (*pb.GraffitiResponse, error) {
+	if s.graffitiSource == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No graffiti file was provided to the validator client")
+	}
+	if err := s.graffitiSource.Update([]byte(req.Content)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not update graffiti: %v", err)
+	}
+	return &pb.GraffitiResponse{
+		Path:    s.graffitiSource.Path(),
+		Content: string(s.graffitiSource.Content()),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+)
+
+func TestServer_Graffiti(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	content := "default: \"Prysm {version}\"\n"
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	source, err := graffiti.NewSource(path)
+	require.NoError(t, err)
+	s := &Server{graffitiSource: source}
+
+	resp, err := s.GetGraffiti(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, path, resp.Path)
+	assert.Equal(t, content, resp.Content)
+
+	newContent := "default: \"Prysm\"\nspecific:\n  1: \"validator one\"\n"
+	resp, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{Content: newContent})
+	require.NoError(t, err)
+	assert.Equal(t, newContent, resp.Content)
+	assert.Equal(t, "validator one", source.Graffiti().Specific[1])
+	written, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, newContent, string(written))
+
+	// Invalid content does not replace the file.
+	_, err = s.SetGraffiti(ctx, &pb.SetGraffitiRequest{Content: "pubkeys:\n  0x01: \"bad\"\n"})
+	require.ErrorContains(t, "Could not update graffiti", err)
+	written, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, newContent, string(written))
+}
+
+func TestServer_Graffiti_NoFile(t *testing.T) {
+	s := &Server{}
+	_, err := s.GetGraffiti(context.Background(), &empty.Empty{})
+	require.ErrorContains(t, "No graffiti file was provided", err)
+	_, err = s.SetGraffiti(context.Background(), &pb.SetGraffitiRequest{Content: "default: \"Prysm\""})
+	require.ErrorContains(t, "No graffiti file was provided", err)
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	proposersettings "github.com/prysmaticlabs/prysm/validator/proposer-settings"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -53,6 +55,8 @@ type Config struct {
 	NodeGatewayEndpoint      string
 	Wallet                   *wallet.Wallet
 	Keymanager               keymanager.IKeymanager
+	ProposerSettings         *proposersettings.Settings
+	GraffitiSource           *graffiti.Source
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -93,6 +97,8 @@ type Server struct {
 	validatorMonitoringPort   int
 	validatorGatewayHost      string
 	validatorGatewayPort      int
+	proposerSettings          *proposersettings.Settings
+	graffitiSource            *graffiti.Source
 }
 
 // NewServer instantiates a new gRPC server.
@@ -127,6 +133,8 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorMonitoringPort:  cfg.ValidatorMonitoringPort,
 		validatorGatewayHost:     cfg.ValidatorGatewayHost,
 		validatorGatewayPort:     cfg.ValidatorGatewayPort,
+		proposerSettings:         cfg.ProposerSettings,
+		graffitiSource:           cfg.GraffitiSource,
 	}
 }
 
@@ -182,6 +190,8 @@ func (s *Server) Start() {
 	validatorpb.RegisterAccountsServer(s.grpcServer, s)
 	ethpbservice.RegisterKeyManagementServer(s.grpcServer, s)
 	validatorpb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	validatorpb.RegisterGraffitiServer(s.grpcServer, s)
+	validatorpb.RegisterSigningAuditLogServer(s.grpcServer, s)
 
 	go func() {
 		if s.listener != nil {
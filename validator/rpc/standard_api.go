@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/ptypes/empty"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
 	"google.golang.org/grpc/codes"
@@ -203,3 +205,169 @@ func (s *Server) slashingProtectionHistoryForDeletedKeys(
 	}
 	return slashingprotection.ExportStandardProtectionJSON(ctx, s.valDB, filteredKeys...)
 }
+
+// remoteKeysManager returns the web3signer keymanager, whose remote keys are managed without a
+// wallet, or an error naming the action if the validator signs with another kind of keymanager.
+func (s *Server) remoteKeysManager(action string) (*remoteweb3signer.Keymanager, error) {
+	km, ok := s.keymanager.(*remoteweb3signer.Keymanager)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "Keymanager kind cannot %s remote keys", action)
+	}
+	return km, nil
+}
+
+// ListRemoteKeys returns the public keys the remote signer keymanager signs with.
+func (s *Server) ListRemoteKeys(
+	ctx context.Context, _ *empty.Empty,
+) (*ethpbservice.ListRemoteKeysResponse, error) {
+	remoteKeysManager, err := s.remoteKeysManager("list")
+	if err != nil {
+		return nil, err
+	}
+	keys, err := remoteKeysManager.ListRemoteKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not list remote keys: %v", err)
+	}
+	return &ethpbservice.ListRemoteKeysResponse{
+		Data: keys,
+	}, nil
+}
+
+// ImportRemoteKeys adds public keys held by the remote signer to the keys the keymanager signs with.
+func (s *Server) ImportRemoteKeys(
+	ctx context.Context, req *ethpbservice.ImportRemoteKeysRequest,
+) (*ethpbservice.ImportRemoteKeysResponse, error) {
+	remoteKeysManager, err := s.remoteKeysManager("import")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.RemoteKeys) == 0 {
+		return &ethpbservice.ImportRemoteKeysResponse{Data: make([]*ethpbservice.ImportedRemoteKeysStatus, 0)}, nil
+	}
+	statuses, err := remoteKeysManager.ImportRemoteKeys(ctx, req.RemoteKeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not import remote keys: %v", err)
+	}
+	return &ethpbservice.ImportRemoteKeysResponse{
+		Data: statuses,
+	}, nil
+}
+
+// DeleteRemoteKeys removes public keys imported through the remote keys API from the keys the
+// keymanager signs with.
+func (s *Server) DeleteRemoteKeys(
+	ctx context.Context, req *ethpbservice.DeleteRemoteKeysRequest,
+) (*ethpbservice.DeleteRemoteKeysResponse, error) {
+	remoteKeysManager, err := s.remoteKeysManager("delete")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Pubkeys) == 0 {
+		return &ethpbservice.DeleteRemoteKeysResponse{Data: make([]*ethpbservice.DeletedRemoteKeysStatus, 0)}, nil
+	}
+	statuses, err := remoteKeysManager.DeleteRemoteKeys(ctx, req.Pubkeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete remote keys: %v", err)
+	}
+	return &ethpbservice.DeleteRemoteKeysResponse{
+		Data: statuses,
+	}, nil
+}
+
+// ListFeeRecipientByPubkey returns the fee recipient of a validator, set through the keymanager API
+// or else from the proposer settings.
+func (s *Server) ListFeeRecipientByPubkey(
+	ctx context.Context, req *ethpbservice.PubkeyRequest,
+) (*ethpbservice.GetFeeRecipientByPubkeyResponse, error) {
+	pubKey, err := s.validatingPublicKey(ctx, req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	feeRecipient, ok, err := s.valDB.FeeRecipientForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get fee recipient: %v", err)
+	}
+	if !ok {
+		feeRecipient, ok = s.proposerSettings.FeeRecipient(pubKey)
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "No fee recipient set for public key")
+	}
+	return &ethpbservice.GetFeeRecipientByPubkeyResponse{
+		Data: &ethpbservice.GetFeeRecipientByPubkeyResponse_FeeRecipient{
+			Pubkey:     req.Pubkey,
+			Ethaddress: feeRecipient.Bytes(),
+		},
+	}, nil
+}
+
+// SetFeeRecipientByPubkey sets the fee recipient of a validator, which takes precedence over the
+// proposer settings.
+func (s *Server) SetFeeRecipientByPubkey(
+	ctx context.Context, req *ethpbservice.SetFeeRecipientByPubkeyRequest,
+) (*empty.Empty, error) {
+	pubKey, err := s.validatingPublicKey(ctx, req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Ethaddress) != fieldparams.FeeRecipientLength {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Fee recipient has length %d, expected %d", len(req.Ethaddress), fieldparams.FeeRecipientLength,
+		)
+	}
+	if err := s.valDB.SaveFeeRecipientForPubKey(ctx, pubKey, common.BytesToAddress(req.Ethaddress)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save fee recipient: %v", err)
+	}
+	s.pushFeeRecipient(ctx, pubKey)
+	return &empty.Empty{}, nil
+}
+
+// DeleteFeeRecipientByPubkey removes the fee recipient set for a validator through the keymanager
+// API, so that the one of the proposer settings is used again.
+func (s *Server) DeleteFeeRecipientByPubkey(
+	ctx context.Context, req *ethpbservice.PubkeyRequest,
+) (*empty.Empty, error) {
+	pubKey, err := s.validatingPublicKey(ctx, req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.valDB.DeleteFeeRecipientForPubKey(ctx, pubKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete fee recipient: %v", err)
+	}
+	s.pushFeeRecipient(ctx, pubKey)
+	return &empty.Empty{}, nil
+}
+
+// pushFeeRecipient registers the fee recipient of a validator with the beacon node once it changes.
+// It is registered again with the duties of the next epoch if it cannot be now.
+func (s *Server) pushFeeRecipient(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) {
+	if s.validatorService == nil {
+		return
+	}
+	if err := s.validatorService.PushFeeRecipient(ctx, pubKey); err != nil {
+		log.WithError(err).Warn("Could not push fee recipient to beacon node")
+	}
+}
+
+// Checks that a public key is one of the validating keys of the keymanager.
+func (s *Server) validatingPublicKey(ctx context.Context, pubKey []byte) ([fieldparams.BLSPubkeyLength]byte, error) {
+	if !s.walletInitialized {
+		return [fieldparams.BLSPubkeyLength]byte{}, status.Error(codes.Internal, "Wallet not ready")
+	}
+	if len(pubKey) != fieldparams.BLSPubkeyLength {
+		return [fieldparams.BLSPubkeyLength]byte{}, status.Errorf(
+			codes.InvalidArgument, "Public key has length %d, expected %d", len(pubKey), fieldparams.BLSPubkeyLength,
+		)
+	}
+	validatingKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return [fieldparams.BLSPubkeyLength]byte{}, status.Errorf(codes.Internal, "Could not get validating public keys: %v", err)
+	}
+	key := bytesutil.ToBytes48(pubKey)
+	for _, validatingKey := range validatingKeys {
+		if validatingKey == key {
+			return key, nil
+		}
+	}
+	return [fieldparams.BLSPubkeyLength]byte{}, status.Error(codes.NotFound, "Public key is not a validating key")
+}
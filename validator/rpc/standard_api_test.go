@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
@@ -21,6 +23,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/validator/keymanager/remote-web3signer"
+	proposersettings "github.com/prysmaticlabs/prysm/validator/proposer-settings"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/validator/testing"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
@@ -384,3 +388,139 @@ func createRandomKeystore(t testing.TB, password string) *keymanager.Keystore {
 		Name:    encryptor.Name(),
 	}
 }
+
+func TestServer_RemoteKeys_WithoutWallet(t *testing.T) {
+	ctx := context.Background()
+	t.Run("no keymanager", func(t *testing.T) {
+		s := Server{}
+		_, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+		require.ErrorContains(t, "Keymanager kind cannot list remote keys", err)
+	})
+	t.Run("keymanager kind without remote keys", func(t *testing.T) {
+		s := setupServerWithWallet(t)
+		_, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+		require.ErrorContains(t, "Keymanager kind cannot list remote keys", err)
+		_, err = s.ImportRemoteKeys(ctx, &ethpbservice.ImportRemoteKeysRequest{})
+		require.ErrorContains(t, "Keymanager kind cannot import remote keys", err)
+		_, err = s.DeleteRemoteKeys(ctx, &ethpbservice.DeleteRemoteKeysRequest{})
+		require.ErrorContains(t, "Keymanager kind cannot delete remote keys", err)
+	})
+
+	provided := bytesutil.ToBytes48(bytesutil.PadTo([]byte{1}, fieldparams.BLSPubkeyLength))
+	imported := bytesutil.PadTo([]byte{2}, fieldparams.BLSPubkeyLength)
+	km, err := remoteweb3signer.NewKeymanager(ctx, &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: make([]byte, 32),
+		ProvidedPublicKeys:    [][fieldparams.BLSPubkeyLength]byte{provided},
+		RemoteKeysFile:        filepath.Join(t.TempDir(), "remote-keys.json"),
+	})
+	require.NoError(t, err)
+	// The web3signer keymanager is built from flags, without a wallet.
+	s := &Server{
+		keymanager: km,
+	}
+
+	importResp, err := s.ImportRemoteKeys(ctx, &ethpbservice.ImportRemoteKeysRequest{
+		RemoteKeys: []*ethpbservice.ImportRemoteKeysRequest_RemoteKey{
+			{Pubkey: imported, Url: "http://example.com"},
+			{Pubkey: provided[:]},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(importResp.Data))
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, importResp.Data[0].Status)
+	require.Equal(t, ethpbservice.ImportedRemoteKeysStatus_DUPLICATE, importResp.Data[1].Status)
+
+	listResp, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(listResp.Data))
+	require.DeepEqual(t, provided[:], listResp.Data[0].Pubkey)
+	require.Equal(t, true, listResp.Data[0].Readonly)
+	require.DeepEqual(t, imported, listResp.Data[1].Pubkey)
+	require.Equal(t, false, listResp.Data[1].Readonly)
+
+	deleteResp, err := s.DeleteRemoteKeys(ctx, &ethpbservice.DeleteRemoteKeysRequest{
+		Pubkeys: [][]byte{imported, provided[:]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deleteResp.Data))
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, deleteResp.Data[0].Status)
+	require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_ERROR, deleteResp.Data[1].Status)
+
+	listResp, err = s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Data))
+}
+
+func TestServer_FeeRecipientByPubkey(t *testing.T) {
+	t.Run("wallet not ready", func(t *testing.T) {
+		s := Server{}
+		_, err := s.ListFeeRecipientByPubkey(context.Background(), &ethpbservice.PubkeyRequest{})
+		require.ErrorContains(t, "Wallet not ready", err)
+	})
+
+	ctx := context.Background()
+	srv := setupServerWithWallet(t)
+	dr, ok := srv.keymanager.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, mocks.TestMnemonic, "", 1))
+	publicKeys, err := dr.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	validatorDB, err := kv.NewKVStore(ctx, defaultWalletPath, &kv.Config{
+		PubKeys: publicKeys,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	srv.valDB = validatorDB
+	pubKey := publicKeys[0][:]
+
+	t.Run("not a validating key", func(t *testing.T) {
+		_, err := srv.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{
+			Pubkey: bytesutil.PadTo([]byte{1}, fieldparams.BLSPubkeyLength),
+		})
+		require.ErrorContains(t, "Public key is not a validating key", err)
+		_, err = srv.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: []byte{1}})
+		require.ErrorContains(t, "Public key has length 1", err)
+	})
+	t.Run("no fee recipient", func(t *testing.T) {
+		_, err := srv.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey})
+		require.ErrorContains(t, "No fee recipient set for public key", err)
+	})
+
+	defaultFeeRecipient := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	srv.proposerSettings = &proposersettings.Settings{
+		DefaultConfig: &proposersettings.Option{FeeRecipient: defaultFeeRecipient},
+	}
+	t.Run("fee recipient from proposer settings", func(t *testing.T) {
+		resp, err := srv.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey})
+		require.NoError(t, err)
+		require.DeepEqual(t, pubKey, resp.Data.Pubkey)
+		require.DeepEqual(t, defaultFeeRecipient.Bytes(), resp.Data.Ethaddress)
+	})
+	t.Run("invalid fee recipient", func(t *testing.T) {
+		_, err := srv.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{
+			Pubkey:     pubKey,
+			Ethaddress: []byte{1},
+		})
+		require.ErrorContains(t, "Fee recipient has length 1", err)
+	})
+	t.Run("set and delete fee recipient", func(t *testing.T) {
+		feeRecipient := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+		_, err := srv.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{
+			Pubkey:     pubKey,
+			Ethaddress: feeRecipient.Bytes(),
+		})
+		require.NoError(t, err)
+		resp, err := srv.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey})
+		require.NoError(t, err)
+		require.DeepEqual(t, feeRecipient.Bytes(), resp.Data.Ethaddress)
+
+		_, err = srv.DeleteFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey})
+		require.NoError(t, err)
+		resp, err = srv.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey})
+		require.NoError(t, err)
+		require.DeepEqual(t, defaultFeeRecipient.Bytes(), resp.Data.Ethaddress)
+	})
+}
@@ -0,0 +1,253 @@
+package signing_policy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/async"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	prysmTime "github.com/prysmaticlabs/prysm/time"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrRefused is returned for sign requests refused by the signing policy.
+var ErrRefused = errors.New("refused by signing policy")
+
+// Object types of the sign requests, as recorded in the decisions of the signing policy.
+const (
+	BlockObject                = "block"
+	AttestationObject          = "attestation"
+	AggregateAndProofObject    = "aggregate_and_proof"
+	VoluntaryExitObject        = "voluntary_exit"
+	AggregationSlotObject      = "aggregation_slot"
+	RandaoRevealObject         = "randao_reveal"
+	SyncSelectionDataObject    = "sync_committee_selection_data"
+	ContributionAndProofObject = "sync_committee_contribution_and_proof"
+	SyncCommitteeMessageObject = "sync_committee_message"
+	unknownObject              = "unknown"
+)
+
+// decisionsPruneInterval is how often the decisions of the signing policy past their retention
+// period are pruned.
+const decisionsPruneInterval = time.Hour
+
+// DecisionStore records the decisions of the signing policy.
+type DecisionStore interface {
+	SaveSigningPolicyDecision(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, decision *kv.SigningPolicyDecision) error
+	SigningPolicyDecisions(
+		ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, start, end types.Slot,
+	) ([]*kv.SigningPolicyDecision, error)
+	PruneSigningPolicyDecisions(ctx context.Context, before types.Slot) (int, error)
+}
+
+type exitConfirmationKey struct{}
+
+// WithExitConfirmation marks the voluntary exits signed with the context as confirmed by the user.
+func WithExitConfirmation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, exitConfirmationKey{}, true)
+}
+
+func exitConfirmed(ctx context.Context) bool {
+	confirmed, ok := ctx.Value(exitConfirmationKey{}).(bool)
+	return ok && confirmed
+}
+
+// Enforcer checks sign requests against the policy of their public key, and records its refusals
+// along with the randao reveals it allows.
+type Enforcer struct {
+	settings *Settings
+	db       DecisionStore
+
+	genesisLock sync.RWMutex
+	genesisTime uint64
+}
+
+// NewEnforcer of the signing policy settings, recording its decisions in the store.
+func NewEnforcer(settings *Settings, db DecisionStore) *Enforcer {
+	return &Enforcer{
+		settings: settings,
+		db:       db,
+	}
+}
+
+// SetGenesisTime sets the genesis time from which the current slot is computed. Requests are
+// refused until it is set.
+func (e *Enforcer) SetGenesisTime(genesisTime uint64) {
+	e.genesisLock.Lock()
+	defer e.genesisLock.Unlock()
+	e.genesisTime = genesisTime
+}
+
+// currentSlot returns the current slot, and false if the genesis time is not set yet.
+func (e *Enforcer) currentSlot() (types.Slot, bool) {
+	e.genesisLock.RLock()
+	defer e.genesisLock.RUnlock()
+	if e.genesisTime == 0 {
+		return 0, false
+	}
+	return slots.CurrentSlot(e.genesisTime), true
+}
+
+// Check decides whether the request may be signed, and returns an error wrapping ErrRefused if it
+// may not. Refusals are recorded, as are allowed randao reveals, which are counted per epoch.
+func (e *Enforcer) Check(ctx context.Context, req *validatorpb.SignRequest) error {
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	// The checks of a public key are serialized, so that the randao reveals of an epoch are counted
+	// exactly.
+	lock := async.NewMultilock(fmt.Sprintf("signing-policy-%#x", pubKey))
+	lock.Lock()
+	defer lock.Unlock()
+	objectType, slot, err := RequestObject(req)
+	if err != nil {
+		return errors.Wrap(err, "could not determine the object of the request")
+	}
+	reason, err := e.refusal(ctx, e.settings.PolicyFor(pubKey), pubKey, objectType, slot)
+	if err != nil {
+		return err
+	}
+	if reason == "" && objectType != RandaoRevealObject {
+		return nil
+	}
+	decision := &kv.SigningPolicyDecision{
+		Slot:        slot,
+		ObjectType:  objectType,
+		SigningRoot: req.SigningRoot,
+		Allowed:     reason == "",
+		Reason:      reason,
+		Timestamp:   prysmTime.Now().Unix(),
+	}
+	if err := e.db.SaveSigningPolicyDecision(ctx, pubKey, decision); err != nil {
+		return errors.Wrap(err, "could not record signing policy decision")
+	}
+	if reason != "" {
+		log.WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"object":    objectType,
+			"slot":      slot,
+			"reason":    reason,
+		}).Warn("Signing policy refused to sign")
+		return errors.Wrap(ErrRefused, reason)
+	}
+	return nil
+}
+
+// refusal returns the reason the policy refuses to sign the object, or an empty string if it may be
+// signed.
+func (e *Enforcer) refusal(
+	ctx context.Context, policy *Policy, pubKey [fieldparams.BLSPubkeyLength]byte, objectType string, slot types.Slot,
+) (string, error) {
+	if objectType == unknownObject {
+		return "unknown object type", nil
+	}
+	currentSlot, ok := e.currentSlot()
+	if !ok {
+		return "current slot is unknown", nil
+	}
+	if slot > currentSlot+policy.MaxFutureSlots {
+		return fmt.Sprintf(
+			"slot %d is more than %d slots ahead of current slot %d", slot, policy.MaxFutureSlots, currentSlot,
+		), nil
+	}
+	if objectType == VoluntaryExitObject && !policy.AllowUnconfirmedExits && !exitConfirmed(ctx) {
+		return "voluntary exit was not confirmed", nil
+	}
+	if objectType == RandaoRevealObject && policy.MaxRandaoRevealsPerEpoch > 0 {
+		// Randao reveals are recorded at the start slot of their epoch.
+		decisions, err := e.db.SigningPolicyDecisions(ctx, pubKey, slot, slot)
+		if err != nil {
+			return "", errors.Wrap(err, "could not read signing policy decisions")
+		}
+		var reveals uint64
+		for _, d := range decisions {
+			if d.ObjectType == RandaoRevealObject && d.Allowed {
+				reveals++
+			}
+		}
+		if reveals >= policy.MaxRandaoRevealsPerEpoch {
+			return fmt.Sprintf(
+				"%d randao reveals were already signed for epoch %d", reveals, slots.ToEpoch(slot),
+			), nil
+		}
+	}
+	return "", nil
+}
+
+// PruneDecisions periodically deletes the recorded decisions on objects older than the weak
+// subjectivity period, as the slashing protection history is pruned, until the context is done.
+func (e *Enforcer) PruneDecisions(ctx context.Context) {
+	ticker := time.NewTicker(decisionsPruneInterval)
+	defer ticker.Stop()
+	for {
+		if err := e.pruneDecisions(ctx); err != nil {
+			log.WithError(err).Error("Could not prune signing policy decisions")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (e *Enforcer) pruneDecisions(ctx context.Context) error {
+	currentSlot, ok := e.currentSlot()
+	if !ok {
+		return nil
+	}
+	retention, err := slots.EpochStart(params.BeaconConfig().WeakSubjectivityPeriod)
+	if err != nil {
+		return err
+	}
+	if currentSlot <= retention {
+		return nil
+	}
+	pruned, err := e.db.PruneSigningPolicyDecisions(ctx, currentSlot-retention)
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		log.WithField("decisions", pruned).Debug("Pruned signing policy decisions")
+	}
+	return nil
+}
+
+// RequestObject returns the type of the object of the request, and the slot it is signed for.
+func RequestObject(req *validatorpb.SignRequest) (string, types.Slot, error) {
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		return BlockObject, o.Block.GetSlot(), nil
+	case *validatorpb.SignRequest_BlockV2:
+		return BlockObject, o.BlockV2.GetSlot(), nil
+	case *validatorpb.SignRequest_BlockV3:
+		return BlockObject, o.BlockV3.GetSlot(), nil
+	case *validatorpb.SignRequest_AttestationData:
+		return AttestationObject, o.AttestationData.GetSlot(), nil
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		return AggregateAndProofObject, o.AggregateAttestationAndProof.GetAggregate().GetData().GetSlot(), nil
+	case *validatorpb.SignRequest_Exit:
+		slot, err := slots.EpochStart(o.Exit.GetEpoch())
+		return VoluntaryExitObject, slot, err
+	case *validatorpb.SignRequest_Slot:
+		return AggregationSlotObject, o.Slot, nil
+	case *validatorpb.SignRequest_Epoch:
+		slot, err := slots.EpochStart(o.Epoch)
+		return RandaoRevealObject, slot, err
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		return SyncSelectionDataObject, o.SyncAggregatorSelectionData.GetSlot(), nil
+	case *validatorpb.SignRequest_ContributionAndProof:
+		return ContributionAndProofObject, o.ContributionAndProof.GetContribution().GetSlot(), nil
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		return SyncCommitteeMessageObject, req.SigningSlot, nil
+	default:
+		return unknownObject, req.SigningSlot, nil
+	}
+}
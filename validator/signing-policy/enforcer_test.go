@@ -0,0 +1,217 @@
+package signing_policy
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+	"github.com/prysmaticlabs/prysm/time/slots"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+// setupEnforcer returns an enforcer of the settings with the current slot at the start of epoch 10.
+func setupEnforcer(t *testing.T, settings *Settings) (*Enforcer, DecisionStore, types.Slot) {
+	db := dbtest.SetupDB(t, nil)
+	e := NewEnforcer(settings, db)
+	currentSlot := 10 * params.BeaconConfig().SlotsPerEpoch
+	secondsSinceGenesis := uint64(currentSlot) * params.BeaconConfig().SecondsPerSlot
+	e.SetGenesisTime(uint64(time.Now().Unix()) - secondsSinceGenesis)
+	return e, db, currentSlot
+}
+
+func TestEnforcer_MaxFutureSlots(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	e, db, currentSlot := setupEnforcer(t, &Settings{DefaultConfig: &Policy{MaxFutureSlots: 2}})
+
+	attestation := func(slot types.Slot) *validatorpb.SignRequest {
+		return &validatorpb.SignRequest{
+			PublicKey:   pubKey[:],
+			SigningRoot: []byte("root"),
+			Object:      &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{Slot: slot}},
+		}
+	}
+	require.NoError(t, e.Check(ctx, attestation(currentSlot-1)))
+	require.NoError(t, e.Check(ctx, attestation(currentSlot+2)))
+	err := e.Check(ctx, attestation(currentSlot+3))
+	require.ErrorIs(t, err, ErrRefused)
+	require.ErrorContains(t, "is more than 2 slots ahead of current slot", err)
+
+	// Only the refused request is recorded.
+	decisions, err := db.SigningPolicyDecisions(ctx, pubKey, 0, currentSlot+10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decisions))
+	assert.Equal(t, false, decisions[0].Allowed)
+	assert.Equal(t, currentSlot+3, decisions[0].Slot)
+	assert.Equal(t, AttestationObject, decisions[0].ObjectType)
+	assert.DeepEqual(t, []byte("root"), decisions[0].SigningRoot)
+	assert.Equal(t, "slot 323 is more than 2 slots ahead of current slot 320", decisions[0].Reason)
+}
+
+func TestEnforcer_Exits(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	allowed := [fieldparams.BLSPubkeyLength]byte{2}
+	e, _, currentSlot := setupEnforcer(t, &Settings{
+		PolicyConfig: map[[fieldparams.BLSPubkeyLength]byte]*Policy{
+			allowed: {MaxFutureSlots: 2, AllowUnconfirmedExits: true},
+		},
+	})
+	exit := func(pubKey [fieldparams.BLSPubkeyLength]byte) *validatorpb.SignRequest {
+		return &validatorpb.SignRequest{
+			PublicKey: pubKey[:],
+			Object:    &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: slots.ToEpoch(currentSlot)}},
+		}
+	}
+	require.ErrorContains(t, "voluntary exit was not confirmed", e.Check(ctx, exit(pubKey)))
+	require.NoError(t, e.Check(WithExitConfirmation(ctx), exit(pubKey)))
+	require.NoError(t, e.Check(ctx, exit(allowed)))
+}
+
+func TestEnforcer_MaxRandaoRevealsPerEpoch(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	e, _, currentSlot := setupEnforcer(t, &Settings{DefaultConfig: &Policy{MaxFutureSlots: 64, MaxRandaoRevealsPerEpoch: 2}})
+	randao := func(pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch) *validatorpb.SignRequest {
+		return &validatorpb.SignRequest{PublicKey: pubKey[:], Object: &validatorpb.SignRequest_Epoch{Epoch: epoch}}
+	}
+	epoch := slots.ToEpoch(currentSlot)
+	require.NoError(t, e.Check(ctx, randao(pubKey, epoch)))
+	require.NoError(t, e.Check(ctx, randao(pubKey, epoch)))
+	require.ErrorContains(t, "2 randao reveals were already signed for epoch 10", e.Check(ctx, randao(pubKey, epoch)))
+	// Refused reveals do not count towards the limit, and the limit applies per epoch and key.
+	require.ErrorContains(t, "2 randao reveals were already signed for epoch 10", e.Check(ctx, randao(pubKey, epoch)))
+	require.NoError(t, e.Check(ctx, randao(pubKey, epoch+1)))
+	require.NoError(t, e.Check(ctx, randao([fieldparams.BLSPubkeyLength]byte{2}, epoch)))
+}
+
+func TestEnforcer_MaxRandaoRevealsPerEpoch_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	e, _, currentSlot := setupEnforcer(t, &Settings{DefaultConfig: &Policy{MaxFutureSlots: 64, MaxRandaoRevealsPerEpoch: 2}})
+	req := &validatorpb.SignRequest{PublicKey: pubKey[:], Object: &validatorpb.SignRequest_Epoch{Epoch: slots.ToEpoch(currentSlot)}}
+
+	var wg sync.WaitGroup
+	var allowed int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if e.Check(ctx, req) == nil {
+				atomic.AddInt32(&allowed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), allowed)
+}
+
+func TestEnforcer_PruneDecisions(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	e, db, _ := setupEnforcer(t, &Settings{DefaultConfig: &Policy{}})
+	// The current slot is well past the weak subjectivity period.
+	currentEpoch := 2 * params.BeaconConfig().WeakSubjectivityPeriod
+	currentSlot, err := slots.EpochStart(currentEpoch)
+	require.NoError(t, err)
+	secondsSinceGenesis := uint64(currentSlot) * params.BeaconConfig().SecondsPerSlot
+	e.SetGenesisTime(uint64(time.Now().Unix()) - secondsSinceGenesis)
+
+	oldSlot, err := slots.EpochStart(currentEpoch - params.BeaconConfig().WeakSubjectivityPeriod - 1)
+	require.NoError(t, err)
+	for _, slot := range []types.Slot{oldSlot, currentSlot} {
+		require.NoError(t, db.SaveSigningPolicyDecision(ctx, pubKey, &kv.SigningPolicyDecision{Slot: slot}))
+	}
+
+	// The decisions are pruned once before the done context stops the routine.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	e.PruneDecisions(ctx)
+
+	decisions, err := db.SigningPolicyDecisions(context.Background(), pubKey, 0, currentSlot)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decisions))
+	assert.Equal(t, currentSlot, decisions[0].Slot)
+}
+
+func TestEnforcer_Refusals(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	e := NewEnforcer(nil, dbtest.SetupDB(t, nil))
+	block := &validatorpb.SignRequest{
+		PublicKey: pubKey[:],
+		Object:    &validatorpb.SignRequest_Block{Block: &ethpb.BeaconBlock{Slot: 1}},
+	}
+	require.ErrorContains(t, "current slot is unknown", e.Check(ctx, block))
+	e.SetGenesisTime(uint64(time.Now().Unix()))
+	require.NoError(t, e.Check(ctx, block))
+	require.ErrorContains(t, "unknown object type", e.Check(ctx, &validatorpb.SignRequest{PublicKey: pubKey[:]}))
+}
+
+func TestRequestObject(t *testing.T) {
+	tests := []struct {
+		req        *validatorpb.SignRequest
+		objectType string
+		slot       types.Slot
+	}{
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockV2{BlockV2: &ethpb.BeaconBlockAltair{Slot: 3}}},
+			objectType: BlockObject,
+			slot:       3,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{
+				AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{Aggregate: &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 4}}},
+			}},
+			objectType: AggregateAndProofObject,
+			slot:       4,
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 5}},
+			objectType: AggregationSlotObject,
+			slot:       5,
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 2}, SigningSlot: 70},
+			objectType: RandaoRevealObject,
+			slot:       64,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_SyncAggregatorSelectionData{
+				SyncAggregatorSelectionData: &ethpb.SyncAggregatorSelectionData{Slot: 6},
+			}},
+			objectType: SyncSelectionDataObject,
+			slot:       6,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_ContributionAndProof{
+				ContributionAndProof: &ethpb.ContributionAndProof{Contribution: &ethpb.SyncCommitteeContribution{Slot: 7}},
+			}},
+			objectType: ContributionAndProofObject,
+			slot:       7,
+		},
+		{
+			req:        &validatorpb.SignRequest{Object: &validatorpb.SignRequest_SyncMessageBlockRoot{}, SigningSlot: 8},
+			objectType: SyncCommitteeMessageObject,
+			slot:       8,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.objectType, func(t *testing.T) {
+			objectType, slot, err := RequestObject(tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.objectType, objectType)
+			assert.Equal(t, tt.slot, slot)
+		})
+	}
+}
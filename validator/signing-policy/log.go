@@ -0,0 +1,5 @@
+package signing_policy
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "signing-policy")
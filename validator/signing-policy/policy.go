@@ -0,0 +1,130 @@
+// Package signing_policy defines the policy the validator client enforces on every object it signs,
+// in addition to slashing protection, such as how far ahead of the current slot objects may be
+// signed, and how it is read from a file.
+package signing_policy
+
+import (
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"gopkg.in/yaml.v2"
+)
+
+// Policy holds the restrictions on the objects a validator signs.
+type Policy struct {
+	// MaxFutureSlots is how many slots ahead of the current slot objects may be signed for.
+	MaxFutureSlots types.Slot
+	// AllowUnconfirmedExits allows voluntary exits to be signed without the confirmation of the user.
+	AllowUnconfirmedExits bool
+	// MaxRandaoRevealsPerEpoch is how many randao reveals may be signed for an epoch, 0 meaning no
+	// limit.
+	MaxRandaoRevealsPerEpoch uint64
+}
+
+// DefaultPolicy is the policy of validators with no settings of their own. Selection proofs are
+// signed up to the end of the next epoch, so objects may be signed up to two epochs ahead.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MaxFutureSlots: 2 * params.BeaconConfig().SlotsPerEpoch,
+	}
+}
+
+// Settings holds the policy of each validator, keyed by public key, along with the default policy
+// of validators which have none of their own.
+type Settings struct {
+	PolicyConfig  map[[fieldparams.BLSPubkeyLength]byte]*Policy
+	DefaultConfig *Policy
+}
+
+// filePolicy and filePayload are the representation of the settings in a file. Fields which are
+// not set keep their default value.
+type filePolicy struct {
+	MaxFutureSlots           *uint64 `yaml:"max_future_slots"`
+	AllowUnconfirmedExits    *bool   `yaml:"allow_unconfirmed_exits"`
+	MaxRandaoRevealsPerEpoch *uint64 `yaml:"max_randao_reveals_per_epoch"`
+}
+
+type filePayload struct {
+	PolicyConfig  map[string]*filePolicy `yaml:"policy_config"`
+	DefaultConfig *filePolicy            `yaml:"default_config"`
+}
+
+// ParseFile reads the signing policy settings from a JSON or YAML file of the form
+//
+//	{
+//	  "policy_config": {
+//	    "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a": {
+//	      "max_randao_reveals_per_epoch": 1
+//	    }
+//	  },
+//	  "default_config": {
+//	    "max_future_slots": 64,
+//	    "allow_unconfirmed_exits": false
+//	  }
+//	}
+//
+// The fields missing from the policy of a validator are taken from the default config, and the
+// fields missing from the default config from the default policy.
+func ParseFile(path string) (*Settings, error) {
+	enc, err := ioutil.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read signing policy file")
+	}
+	payload := &filePayload{}
+	if err := yaml.UnmarshalStrict(enc, payload); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal signing policy file")
+	}
+	settings := &Settings{
+		PolicyConfig:  make(map[[fieldparams.BLSPubkeyLength]byte]*Policy, len(payload.PolicyConfig)),
+		DefaultConfig: mergePolicy(DefaultPolicy(), payload.DefaultConfig),
+	}
+	for key, policy := range payload.PolicyConfig {
+		pubKey, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("public key %s has length %d, expected %d", key, len(pubKey), fieldparams.BLSPubkeyLength)
+		}
+		settings.PolicyConfig[bytesutil.ToBytes48(pubKey)] = mergePolicy(settings.DefaultConfig, policy)
+	}
+	return settings, nil
+}
+
+// mergePolicy returns a copy of the base policy with the fields set in the file policy replaced.
+func mergePolicy(base *Policy, policy *filePolicy) *Policy {
+	merged := *base
+	if policy == nil {
+		return &merged
+	}
+	if policy.MaxFutureSlots != nil {
+		merged.MaxFutureSlots = types.Slot(*policy.MaxFutureSlots)
+	}
+	if policy.AllowUnconfirmedExits != nil {
+		merged.AllowUnconfirmedExits = *policy.AllowUnconfirmedExits
+	}
+	if policy.MaxRandaoRevealsPerEpoch != nil {
+		merged.MaxRandaoRevealsPerEpoch = *policy.MaxRandaoRevealsPerEpoch
+	}
+	return &merged
+}
+
+// PolicyFor returns the policy of a validator from its own settings, or else from the default
+// settings, or else the default policy.
+func (s *Settings) PolicyFor(pubKey [fieldparams.BLSPubkeyLength]byte) *Policy {
+	if s == nil {
+		return DefaultPolicy()
+	}
+	if policy, ok := s.PolicyConfig[pubKey]; ok && policy != nil {
+		return policy
+	}
+	if s.DefaultConfig != nil {
+		return s.DefaultConfig
+	}
+	return DefaultPolicy()
+}
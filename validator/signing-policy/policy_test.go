@@ -0,0 +1,123 @@
+package signing_policy
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/config/params"
+	"github.com/prysmaticlabs/prysm/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/testing/assert"
+	"github.com/prysmaticlabs/prysm/testing/require"
+)
+
+const pubKeyHex = "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a"
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestParseFile(t *testing.T) {
+	pubKey := bytesutil.ToBytes48(hexutil.MustDecode(pubKeyHex))
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "json",
+			file: "policy.json",
+			content: `{
+  "policy_config": {
+    "` + pubKeyHex + `": {"max_randao_reveals_per_epoch": 1}
+  },
+  "default_config": {"max_future_slots": 4, "allow_unconfirmed_exits": true}
+}`,
+		},
+		{
+			name: "yaml",
+			file: "policy.yaml",
+			content: `policy_config:
+  "` + pubKeyHex + `":
+    max_randao_reveals_per_epoch: 1
+default_config:
+  max_future_slots: 4
+  allow_unconfirmed_exits: true
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := ParseFile(writeFile(t, tt.file, tt.content))
+			require.NoError(t, err)
+			// The policy of the key keeps the fields of the default config it does not set.
+			assert.DeepEqual(t, &Policy{
+				MaxFutureSlots:           4,
+				AllowUnconfirmedExits:    true,
+				MaxRandaoRevealsPerEpoch: 1,
+			}, settings.PolicyFor(pubKey))
+			assert.DeepEqual(t, &Policy{
+				MaxFutureSlots:        4,
+				AllowUnconfirmedExits: true,
+			}, settings.PolicyFor([fieldparams.BLSPubkeyLength]byte{}))
+		})
+	}
+}
+
+func TestParseFile_Defaults(t *testing.T) {
+	pubKey := bytesutil.ToBytes48(hexutil.MustDecode(pubKeyHex))
+	settings, err := ParseFile(writeFile(t, "policy.yaml", `policy_config:
+  "`+pubKeyHex+`":
+    allow_unconfirmed_exits: true
+`))
+	require.NoError(t, err)
+	assert.DeepEqual(t, &Policy{
+		MaxFutureSlots:        2 * params.BeaconConfig().SlotsPerEpoch,
+		AllowUnconfirmedExits: true,
+	}, settings.PolicyFor(pubKey))
+	assert.DeepEqual(t, DefaultPolicy(), settings.PolicyFor([fieldparams.BLSPubkeyLength]byte{}))
+
+	var nilSettings *Settings
+	assert.DeepEqual(t, DefaultPolicy(), nilSettings.PolicyFor(pubKey))
+}
+
+func TestParseFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			content: `default_config: {max_future_epochs: 1}`,
+			wantErr: "could not unmarshal signing policy file",
+		},
+		{
+			name:    "invalid public key",
+			content: `policy_config: {"0xzz": {max_future_slots: 1}}`,
+			wantErr: "could not decode public key 0xzz",
+		},
+		{
+			name:    "short public key",
+			content: `policy_config: {"0xa057": {max_future_slots: 1}}`,
+			wantErr: "public key 0xa057 has length 2, expected 48",
+		},
+		{
+			name:    "negative slots",
+			content: `default_config: {max_future_slots: -1}`,
+			wantErr: "could not unmarshal signing policy file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFile(writeFile(t, "policy.yaml", tt.content))
+			require.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+	_, err := ParseFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, "could not read signing policy file", err)
+}